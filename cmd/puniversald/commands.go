@@ -2,13 +2,17 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 	cosmosevmcmd "github.com/cosmos/evm/client"
 	uvconfig "github.com/pushchain/push-chain-node/universalClient/config"
 	"github.com/pushchain/push-chain-node/universalClient/core"
+	"github.com/pushchain/push-chain-node/universalClient/pidfile"
 	"github.com/spf13/cobra"
 )
 
@@ -20,9 +24,26 @@ func InitRootCmd(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(versionCmd())
 	rootCmd.AddCommand(startCmd())
 	rootCmd.AddCommand(initCmd())
+	rootCmd.AddCommand(configCmd())
+	rootCmd.AddCommand(verifySignatureCmd())
+	rootCmd.AddCommand(simulateCmd())
+	rootCmd.AddCommand(svmGatewayConfigCmd())
+	rootCmd.AddCommand(refreshChainsCmd())
+	rootCmd.AddCommand(heldOutboundsCmd())
+	rootCmd.AddCommand(tssCmd())
 	rootCmd.AddCommand(cosmosevmcmd.KeyCommands(uvconfig.DefaultNodeHome(), true))
 }
 
+// lockHome takes the home's PID file so only one process runs the node (or
+// changes its files) at a time. Release the lock when done.
+func lockHome(home string) (*pidfile.Lock, error) {
+	lock, err := pidfile.Acquire(filepath.Join(home, uvconfig.PIDFileName))
+	if errors.Is(err, pidfile.ErrLocked) {
+		return nil, fmt.Errorf("another puniversald is running against %s: %w", home, err)
+	}
+	return lock, err
+}
+
 // getHome reads the --home flag, falling back to DefaultNodeHome.
 func getHome(cmd *cobra.Command) string {
 	home, _ := cmd.Flags().GetString(flagHome)
@@ -72,14 +93,45 @@ Use --home to specify a different directory.`,
 	}
 }
 
+// Flags of start (and config show) that override the config file and PUNIVERSAL_* env vars.
+const (
+	flagLogLevel          = "log-level"
+	flagLogFormat         = "log-format"
+	flagDryRun            = "dry-run"
+	flagPushChainGRPCURLs = "push-chain-grpc-urls"
+	flagQueryServerPort   = "query-server-port"
+	flagKeyringBackend    = "keyring-backend"
+	flagDatabaseDriver    = "database-driver"
+	flagDatabaseDSN       = "database-dsn"
+	flagTSSP2PListen      = "tss-p2p-listen"
+	flagRPCURLs           = "rpc-urls"
+)
+
 func startCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "start",
 		Short: "Start the universal validator",
+		Long: `Start the universal validator.
+
+Config is layered, each layer overriding the one before:
+  1. <home>/config/pushuv_config.json (built-in defaults if missing)
+  2. PUNIVERSAL_* environment variables, e.g. PUNIVERSAL_LOG_LEVEL or
+     PUNIVERSAL_RPC_URLS_EIP155_1 for the eip155:1 RPC pool
+  3. flags`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			home := getHome(cmd)
 
-			loadedCfg, err := uvconfig.Load(home)
+			lock, err := lockHome(home)
+			if err != nil {
+				return err
+			}
+			defer lock.Release()
+
+			overrides, err := configOverrides(cmd)
+			if err != nil {
+				return err
+			}
+			loadedCfg, err := uvconfig.LoadLayered(home, os.LookupEnv, overrides)
 			if err != nil {
 				return fmt.Errorf("failed to load config: %w", err)
 			}
@@ -92,5 +144,74 @@ func startCmd() *cobra.Command {
 			return client.Start()
 		},
 	}
+
+	addOverrideFlags(cmd)
+	return cmd
 }
 
+// addOverrideFlags registers the flags that override the config file and env.
+func addOverrideFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flagLogLevel, 0, "log level (0-5)")
+	cmd.Flags().String(flagLogFormat, "", "log format: json or console")
+	cmd.Flags().Bool(flagDryRun, false, "build and sign outbound txs without broadcasting them")
+	cmd.Flags().StringSlice(flagPushChainGRPCURLs, nil, "Push Chain gRPC endpoints")
+	cmd.Flags().Int(flagQueryServerPort, 0, "query server port")
+	cmd.Flags().String(flagKeyringBackend, "", "keyring backend: file or test")
+	cmd.Flags().String(flagDatabaseDriver, "", "database driver: sqlite or postgres")
+	cmd.Flags().String(flagDatabaseDSN, "", "postgres connection string")
+	cmd.Flags().String(flagTSSP2PListen, "", "TSS p2p listen multiaddr")
+	cmd.Flags().StringArray(flagRPCURLs, nil, "chain RPC pool as <chain-id>=<url>[,<url>...]; repeat per chain")
+}
+
+// configOverrides collects the override flags that were explicitly given.
+func configOverrides(cmd *cobra.Command) (*uvconfig.Overrides, error) {
+	flags := cmd.Flags()
+	o := &uvconfig.Overrides{}
+	if flags.Changed(flagLogLevel) {
+		v, _ := flags.GetInt(flagLogLevel)
+		o.LogLevel = &v
+	}
+	if flags.Changed(flagLogFormat) {
+		v, _ := flags.GetString(flagLogFormat)
+		o.LogFormat = &v
+	}
+	if flags.Changed(flagDryRun) {
+		v, _ := flags.GetBool(flagDryRun)
+		o.DryRun = &v
+	}
+	if flags.Changed(flagPushChainGRPCURLs) {
+		o.PushChainGRPCURLs, _ = flags.GetStringSlice(flagPushChainGRPCURLs)
+	}
+	if flags.Changed(flagQueryServerPort) {
+		v, _ := flags.GetInt(flagQueryServerPort)
+		o.QueryServerPort = &v
+	}
+	if flags.Changed(flagKeyringBackend) {
+		v, _ := flags.GetString(flagKeyringBackend)
+		o.KeyringBackend = &v
+	}
+	if flags.Changed(flagDatabaseDriver) {
+		v, _ := flags.GetString(flagDatabaseDriver)
+		o.DatabaseDriver = &v
+	}
+	if flags.Changed(flagDatabaseDSN) {
+		v, _ := flags.GetString(flagDatabaseDSN)
+		o.DatabaseDSN = &v
+	}
+	if flags.Changed(flagTSSP2PListen) {
+		v, _ := flags.GetString(flagTSSP2PListen)
+		o.TSSP2PListen = &v
+	}
+	if flags.Changed(flagRPCURLs) {
+		pools, _ := flags.GetStringArray(flagRPCURLs)
+		o.ChainRPCURLs = make(map[string][]string, len(pools))
+		for _, pool := range pools {
+			chainID, urls, ok := strings.Cut(pool, "=")
+			if !ok || chainID == "" || urls == "" {
+				return nil, fmt.Errorf("invalid --%s %q: want <chain-id>=<url>[,<url>...]", flagRPCURLs, pool)
+			}
+			o.ChainRPCURLs[chainID] = strings.Split(urls, ",")
+		}
+	}
+	return o, nil
+}
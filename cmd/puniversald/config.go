@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	uvconfig "github.com/pushchain/push-chain-node/universalClient/config"
+)
+
+const flagJSON = "json"
+
+func configCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect or edit the universal validator config",
+	}
+	cmd.AddCommand(configShowCmd())
+	cmd.AddCommand(configSetCmd())
+	return cmd
+}
+
+func configShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Print the effective config",
+		Long: `Print the config start would run with: the file under --home, overridden by
+PUNIVERSAL_* env vars, overridden by the flags below. Passwords, keys and the
+database DSN are masked; RPC URLs are cut to scheme://host.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			overrides, err := configOverrides(cmd)
+			if err != nil {
+				return err
+			}
+			cfg, err := uvconfig.LoadLayered(getHome(cmd), os.LookupEnv, overrides)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+			cfg = uvconfig.Redacted(cfg)
+
+			out := cmd.OutOrStdout()
+			if asJSON, _ := cmd.Flags().GetBool(flagJSON); asJSON {
+				data, err := json.MarshalIndent(cfg, "", "  ")
+				if err != nil {
+					return fmt.Errorf("failed to marshal config: %w", err)
+				}
+				fmt.Fprintln(out, string(data))
+				return nil
+			}
+
+			entries, err := uvconfig.Entries(cfg)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				fmt.Fprintf(out, "%s = %s\n", e.Key, e.Value)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool(flagJSON, false, "print as JSON")
+	addOverrideFlags(cmd)
+	return cmd
+}
+
+func configSetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <key> <value>",
+		Short: "Persist a setting to the config file",
+		Long: `Write one setting to the config file under --home. Keys are those printed by
+config show, e.g. log_level or chain_configs.eip155:1.rpc_urls. Values are
+read as JSON where they fit the setting (3, true, ["https://a","https://b"])
+and as plain strings otherwise. The change applies on the next start.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home := getHome(cmd)
+
+			cfg, err := uvconfig.Load(home)
+			if err != nil {
+				return fmt.Errorf("failed to load config (run init first?): %w", err)
+			}
+			if err := uvconfig.SetValue(&cfg, args[0], args[1]); err != nil {
+				return err
+			}
+			if err := uvconfig.Save(&cfg, home); err != nil {
+				return fmt.Errorf("failed to save config: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Set %s in %s\n", args[0], filepath.Join(home, uvconfig.ConfigSubdir, uvconfig.ConfigFileName))
+			return nil
+		},
+	}
+}
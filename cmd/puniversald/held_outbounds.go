@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	uetypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+)
+
+func heldOutboundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "held-outbounds",
+		Short: "Review outbounds held above their asset's max outbound amount",
+		Long: `Outbounds moving more of an asset than its max_outbound_amounts entry are
+held instead of signed. Release one to sign it, or reject it to vote it failed
+so Push Chain reverts it once a quorum of validators has rejected it. Held
+outbounds nobody reviews are voted failed when their lifetime runs out.
+
+The requests go to the running node's query server on localhost.`,
+	}
+	cmd.AddCommand(heldOutboundsListCmd())
+	cmd.AddCommand(heldOutboundReviewCmd("release", "Sign a held outbound"))
+	cmd.AddCommand(heldOutboundReviewCmd("reject", "Vote a held outbound failed so Push Chain reverts it"))
+	return cmd
+}
+
+func heldOutboundsListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List outbounds held for review",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			body, err := adminRequest(cmd, http.MethodGet, "/admin/outbounds/held")
+			if err != nil {
+				return fmt.Errorf("list failed: %w", err)
+			}
+			var held []uetypes.OutboundCreatedEvent
+			if err := json.Unmarshal(body, &held); err != nil {
+				return fmt.Errorf("invalid response: %w", err)
+			}
+			if len(held) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no held outbounds")
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tUTX\tCHAIN\tASSET\tAMOUNT")
+			for _, ob := range held {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", ob.TxID, ob.UniversalTxId, ob.DestinationChain, ob.AssetAddr, ob.Amount)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().Int(flagQueryServerPort, 0, "query server port")
+	return cmd
+}
+
+// heldOutboundReviewCmd builds the release and reject subcommands.
+func heldOutboundReviewCmd(action, short string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   action + " <outbound-id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := fmt.Sprintf("/admin/outbounds/held/%s/%s", url.PathEscape(args[0]), action)
+			if _, err := adminRequest(cmd, http.MethodPost, path); err != nil {
+				return fmt.Errorf("%s failed: %w", action, err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "outbound %s: %sd\n", args[0], action)
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagQueryServerPort, 0, "query server port")
+	return cmd
+}
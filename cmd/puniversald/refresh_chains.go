@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	uvconfig "github.com/pushchain/push-chain-node/universalClient/config"
+)
+
+func refreshChainsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh-chains",
+		Short: "Make the running universal validator re-fetch chain configs now",
+		Long: `Ask the running puniversald to fetch chain configs from Push Chain and
+apply them immediately instead of at its next config poll, e.g. right after a
+gateway rotation. The request goes to the node's query server on localhost.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := adminRequest(cmd, http.MethodPost, "/admin/chains/refresh"); err != nil {
+				return fmt.Errorf("refresh failed: %w", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "chain configs refreshed")
+			return nil
+		},
+	}
+	cmd.Flags().Int(flagQueryServerPort, 0, "query server port")
+	return cmd
+}
+
+// adminRequest sends an admin request to the running node's query server on
+// localhost and returns the response body.
+func adminRequest(cmd *cobra.Command, method, path string) ([]byte, error) {
+	overrides, err := configOverrides(cmd)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := uvconfig.LoadLayered(getHome(cmd), os.LookupEnv, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), 15*time.Second)
+	defer cancel()
+
+	url := fmt.Sprintf("http://127.0.0.1:%d%s", cfg.QueryServerPort, path)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach query server (is puniversald running?): %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
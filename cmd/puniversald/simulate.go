@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/chains/evm"
+	"github.com/pushchain/push-chain-node/universalClient/chains/svm"
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+)
+
+func simulateCmd() *cobra.Command {
+	var (
+		chainID   string
+		rpcURL    string
+		gateway   string
+		vault     string
+		eventPath string
+		from      string
+		sigHex    string
+	)
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Dry-run an outbound against its destination chain without broadcasting",
+		Long: `Build an outbound's transaction with the destination chain's tx builder and
+simulate it, printing whether it would succeed, the gas or compute units it
+uses, and any logs.
+
+--event is a JSON-encoded OutboundCreatedEvent. The RPC endpoint defaults to
+the chain's first rpc_urls entry in the config under --home.
+
+EVM chains run eth_call and eth_estimateGas from --from (the TSS address), so
+no signature is needed. SVM chains assemble the full finalize tx, which the
+gateway only accepts with a valid TSS signature: pass it as --signature. Run
+without --signature to print the signing hash. The relayer keypair under
+--home pays for the simulated tx.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			event, err := readOutboundEvent(eventPath)
+			if err != nil {
+				return err
+			}
+			vm, err := chainVM(chainID)
+			if err != nil {
+				return err
+			}
+
+			home := getHome(cmd)
+			chainConfig := standaloneChainConfig(home, chainID)
+			if rpcURL == "" {
+				if chainConfig == nil || len(chainConfig.RPCURLs) == 0 {
+					return fmt.Errorf("no --rpc-url given and no rpc_urls configured for %s", chainID)
+				}
+				rpcURL = chainConfig.RPCURLs[0]
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), 60*time.Second)
+			defer cancel()
+
+			builder, err := newStandaloneTxBuilder(ctx, chainID, rpcURL, gateway, vault, home, chainConfig)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			var result *common.SimulationResult
+			switch vm {
+			case uregistrytypes.VmType_EVM:
+				if !ethcommon.IsHexAddress(from) {
+					return fmt.Errorf("EVM simulation needs --from, the TSS address the vault call is made from")
+				}
+				result, err = builder.(*evm.TxBuilder).SimulateOutbound(ctx, event, ethcommon.HexToAddress(from))
+			default:
+				req, reqErr := builder.GetOutboundSigningRequest(ctx, event, 0)
+				if reqErr != nil {
+					return fmt.Errorf("failed to build signing request: %w", reqErr)
+				}
+				if sigHex == "" {
+					fmt.Fprintf(out, "Signing hash: 0x%x\n", req.SigningHash)
+					return fmt.Errorf("SVM simulation needs --signature: a TSS signature over the signing hash above")
+				}
+				signature, decErr := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+				if decErr != nil {
+					return fmt.Errorf("invalid signature hex: %w", decErr)
+				}
+				result, err = builder.(*svm.TxBuilder).SimulateOutbound(ctx, req, event, signature)
+			}
+			if err != nil {
+				return err
+			}
+
+			printSimulation(out, vm, result)
+			if !result.Success {
+				return fmt.Errorf("simulation failed")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&chainID, "chain", "", "destination chain in CAIP-2 form (eip155:<id> or solana:<genesis>)")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "destination chain RPC endpoint (defaults to the configured rpc_urls)")
+	cmd.Flags().StringVar(&gateway, "gateway", "", "gateway contract / program address")
+	cmd.Flags().StringVar(&vault, "vault", "", "EVM vault address (fetched from the gateway if omitted)")
+	cmd.Flags().StringVar(&eventPath, "event", "", "path to the OutboundCreatedEvent JSON")
+	cmd.Flags().StringVar(&from, "from", "", "EVM: TSS address to simulate the vault call from")
+	cmd.Flags().StringVar(&sigHex, "signature", "", "SVM: hex TSS signature over the signing hash, r||s||v")
+	for _, name := range []string{"chain", "gateway", "event"} {
+		_ = cmd.MarkFlagRequired(name)
+	}
+	return cmd
+}
+
+// readOutboundEvent reads a JSON-encoded OutboundCreatedEvent from path.
+func readOutboundEvent(path string) (*uexecutortypes.OutboundCreatedEvent, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event: %w", err)
+	}
+	var event uexecutortypes.OutboundCreatedEvent
+	if err := json.Unmarshal(raw, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse event: %w", err)
+	}
+	return &event, nil
+}
+
+func printSimulation(out io.Writer, vm uregistrytypes.VmType, result *common.SimulationResult) {
+	if result.Success {
+		fmt.Fprintln(out, "Result:        success")
+	} else {
+		fmt.Fprintln(out, "Result:        failed")
+		fmt.Fprintf(out, "Error:         %s\n", result.Err)
+	}
+	if vm == uregistrytypes.VmType_EVM {
+		if result.Success {
+			fmt.Fprintf(out, "Gas estimate:  %d\n", result.Units)
+		}
+	} else {
+		fmt.Fprintf(out, "Compute units: %d\n", result.Units)
+	}
+	if len(result.Logs) > 0 {
+		fmt.Fprintln(out, "Logs:")
+		for _, line := range result.Logs {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	}
+}
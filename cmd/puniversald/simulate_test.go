@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+)
+
+func TestChainVM(t *testing.T) {
+	tests := []struct {
+		chainID string
+		want    uregistrytypes.VmType
+		wantErr bool
+	}{
+		{chainID: "eip155:1", want: uregistrytypes.VmType_EVM},
+		{chainID: "eip155:97", want: uregistrytypes.VmType_EVM},
+		{chainID: "solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1wcaWoxPkrZBG", want: uregistrytypes.VmType_SVM},
+		{chainID: "cosmos:push_42101-1", wantErr: true},
+		{chainID: "eip155", wantErr: true},
+		{chainID: "", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.chainID, func(t *testing.T) {
+			vm, err := chainVM(tc.chainID)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, vm)
+		})
+	}
+}
+
+func TestSimulateCmd_RejectsUnsupportedChainBeforeDialing(t *testing.T) {
+	cmd := simulateCmd()
+	cmd.Flags().String(flagHome, t.TempDir(), "")
+	path := t.TempDir() + "/event.json"
+	require.NoError(t, os.WriteFile(path, []byte(`{}`), 0o600))
+	cmd.SetArgs([]string{"--chain", "cosmos:foo", "--gateway", "x", "--event", path, "--rpc-url", "http://127.0.0.1:1"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "unsupported chain")
+}
+
+func TestPrintSimulation(t *testing.T) {
+	t.Run("EVM success shows gas estimate", func(t *testing.T) {
+		var out bytes.Buffer
+		printSimulation(&out, uregistrytypes.VmType_EVM, &common.SimulationResult{Success: true, Units: 90000})
+		assert.Contains(t, out.String(), "success")
+		assert.Contains(t, out.String(), "Gas estimate:  90000")
+	})
+
+	t.Run("SVM failure shows error, compute units and logs", func(t *testing.T) {
+		var out bytes.Buffer
+		printSimulation(&out, uregistrytypes.VmType_SVM, &common.SimulationResult{
+			Err:   "custom program error: 0x1771",
+			Units: 23000,
+			Logs:  []string{"Program log: deadline passed"},
+		})
+		assert.Contains(t, out.String(), "failed")
+		assert.Contains(t, out.String(), "0x1771")
+		assert.Contains(t, out.String(), "Compute units: 23000")
+		assert.Contains(t, out.String(), "deadline passed")
+	})
+}
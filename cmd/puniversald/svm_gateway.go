@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/svm"
+)
+
+func svmGatewayConfigCmd() *cobra.Command {
+	var (
+		rpcURL  string
+		gateway string
+		asJSON  bool
+	)
+	cmd := &cobra.Command{
+		Use:   "svm-gateway-config",
+		Short: "Print the SVM gateway's on-chain Config account",
+		Long: `Fetch the SVM gateway program's Config PDA (seed "config") and print its
+admin, TSS, and pauser authorities, paused state, and per-tx USD caps and
+price-feed parameters.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gatewayKey, err := solana.PublicKeyFromBase58(gateway)
+			if err != nil {
+				return fmt.Errorf("invalid gateway address: %w", err)
+			}
+
+			ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+			defer cancel()
+
+			rpcClient, err := svm.NewRPCClient([]string{rpcURL}, "", zerolog.Nop())
+			if err != nil {
+				return fmt.Errorf("failed to connect to RPC: %w", err)
+			}
+			defer rpcClient.Close()
+
+			cfg, err := svm.FetchGatewayConfig(ctx, rpcClient, gatewayKey)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if asJSON {
+				enc := json.NewEncoder(out)
+				enc.SetIndent("", "  ")
+				return enc.Encode(cfg)
+			}
+			fmt.Fprintf(out, "Config PDA:            %s\n", cfg.Address)
+			fmt.Fprintf(out, "Admin:                 %s\n", cfg.Admin)
+			fmt.Fprintf(out, "TSS address:           %s\n", cfg.TSSAddress)
+			fmt.Fprintf(out, "Pauser:                %s\n", cfg.Pauser)
+			fmt.Fprintf(out, "Paused:                %t\n", cfg.Paused)
+			fmt.Fprintf(out, "Min cap (USD, 1e18):   %s\n", cfg.MinCapUniversalTxUSD)
+			fmt.Fprintf(out, "Max cap (USD, 1e18):   %s\n", cfg.MaxCapUniversalTxUSD)
+			fmt.Fprintf(out, "Pyth price feed:       %s\n", cfg.PythPriceFeed)
+			fmt.Fprintf(out, "Pyth conf. threshold:  %d\n", cfg.PythConfidenceThreshold)
+			fmt.Fprintf(out, "Bumps (config/vault):  %d/%d\n", cfg.Bump, cfg.VaultBump)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "Solana RPC endpoint")
+	cmd.Flags().StringVar(&gateway, "gateway", "", "gateway program address")
+	cmd.Flags().BoolVar(&asJSON, "json", false, "print as JSON")
+	for _, name := range []string{"rpc-url", "gateway"} {
+		_ = cmd.MarkFlagRequired(name)
+	}
+	return cmd
+}
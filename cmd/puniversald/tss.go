@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-chain-node/universalClient/tss"
+)
+
+const (
+	flagWait = "wait"
+
+	// leaveStatusPollInterval is how often leave-status --wait polls.
+	leaveStatusPollInterval = 10 * time.Second
+)
+
+func tssCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tss",
+		Short: "TSS node maintenance commands",
+	}
+	cmd.AddCommand(rotateNetKeyCmd())
+	cmd.AddCommand(leaveStatusCmd())
+	return cmd
+}
+
+func leaveStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave-status",
+		Short: "Show the running node's graceful leave progress",
+		Long: `Print the running node's graceful leave state: "none", "pending" (the
+validator is PENDING_LEAVE and the node keeps signing until the quorum change
+that excludes it finalizes) or "complete" (the node no longer holds a share of
+the TSS key and can be shut down). With --wait, poll until the leave completes.
+
+The request goes to the node's query server on localhost.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wait, err := cmd.Flags().GetBool(flagWait)
+			if err != nil {
+				return err
+			}
+			for {
+				body, err := adminRequest(cmd, http.MethodGet, "/admin/tss/leave")
+				if err != nil {
+					return fmt.Errorf("leave status failed: %w", err)
+				}
+				state := strings.TrimSpace(string(body))
+				if !wait || state == tss.LeaveComplete.String() {
+					fmt.Fprintln(cmd.OutOrStdout(), state)
+					return nil
+				}
+				select {
+				case <-cmd.Context().Done():
+					return cmd.Context().Err()
+				case <-time.After(leaveStatusPollInterval):
+				}
+			}
+		},
+	}
+	cmd.Flags().Int(flagQueryServerPort, 0, "query server port")
+	cmd.Flags().Bool(flagWait, false, "poll until the leave completes")
+	return cmd
+}
+
+func rotateNetKeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate-netkey",
+		Short: "Replace the TSS node's libp2p network key",
+		Long: `Generate a new libp2p network key, write it where the node reads it from
+and print the new peer ID. The key goes to tss_p2p_private_key_file when that
+is set and to tss_p2p_private_key_hex in the config under --home otherwise. A
+key supplied through PUNIVERSAL_TSS_PRIVATE_KEY must be rotated there.
+
+DKLS keyshares are left untouched. Stop the node first (this refuses to run
+while it holds the home's PID file), then re-register the
+new peer ID on chain before restarting: until the registration lands, peers
+will reject connections from the new identity.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			home := getHome(cmd)
+			lock, err := lockHome(home)
+			if err != nil {
+				return err
+			}
+			defer lock.Release()
+
+			oldPeerID, newPeerID, err := tss.RotateNetworkKey(home)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if oldPeerID != "" {
+				fmt.Fprintf(out, "Old peer ID: %s\n", oldPeerID)
+			}
+			fmt.Fprintf(out, "New peer ID: %s\n", newPeerID)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/chains/evm"
+	"github.com/pushchain/push-chain-node/universalClient/chains/svm"
+	"github.com/pushchain/push-chain-node/universalClient/config"
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+)
+
+func verifySignatureCmd() *cobra.Command {
+	var (
+		chainID    string
+		rpcURL     string
+		gateway    string
+		vault      string
+		eventPath  string
+		sigHex     string
+		tssAddress string
+		nonce      uint64
+	)
+	cmd := &cobra.Command{
+		Use:   "verify-signature",
+		Short: "Check an outbound TSS signature against the expected TSS address",
+		Long: `Rebuild an outbound's signing hash with the destination chain's tx builder
+and report whether the TSS signature recovers to the expected TSS ETH address,
+and under which recovery ID.
+
+--event is a JSON-encoded OutboundCreatedEvent. EVM chains need the signed
+--nonce and fetch the vault address from the gateway unless --vault is given;
+SVM chains read the chain ID from the gateway's TSS PDA.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			event, err := readOutboundEvent(eventPath)
+			if err != nil {
+				return err
+			}
+			signature, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+			if err != nil {
+				return fmt.Errorf("invalid signature hex: %w", err)
+			}
+			if !ethcommon.IsHexAddress(tssAddress) {
+				return fmt.Errorf("invalid TSS address: %s", tssAddress)
+			}
+
+			home := getHome(cmd)
+			ctx, cancel := context.WithTimeout(cmd.Context(), 30*time.Second)
+			defer cancel()
+
+			builder, err := newStandaloneTxBuilder(ctx, chainID, rpcURL, gateway, vault, home, standaloneChainConfig(home, chainID))
+			if err != nil {
+				return err
+			}
+			check, err := common.VerifyOutboundSignature(ctx, builder, event, nonce, signature, ethcommon.HexToAddress(tssAddress))
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Signing hash:  0x%x\n", check.SigningHash)
+			fmt.Fprintf(out, "Expected:      %s\n", ethcommon.HexToAddress(tssAddress).Hex())
+			for v, addr := range check.Recovered {
+				fmt.Fprintf(out, "Recovered v=%d: %s\n", v, addr)
+			}
+			if !check.Valid {
+				return fmt.Errorf("signature does not recover to the expected TSS address")
+			}
+			fmt.Fprintf(out, "Valid:         yes (recovery ID %d)\n", check.RecoveryID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&chainID, "chain", "", "destination chain in CAIP-2 form (eip155:<id> or solana:<genesis>)")
+	cmd.Flags().StringVar(&rpcURL, "rpc-url", "", "destination chain RPC endpoint")
+	cmd.Flags().StringVar(&gateway, "gateway", "", "gateway contract / program address")
+	cmd.Flags().StringVar(&vault, "vault", "", "EVM vault address (fetched from the gateway if omitted)")
+	cmd.Flags().StringVar(&eventPath, "event", "", "path to the OutboundCreatedEvent JSON")
+	cmd.Flags().StringVar(&sigHex, "signature", "", "hex TSS signature, r||s or r||s||v")
+	cmd.Flags().StringVar(&tssAddress, "tss-address", "", "expected TSS ETH address")
+	cmd.Flags().Uint64Var(&nonce, "nonce", 0, "signed nonce (EVM)")
+	for _, name := range []string{"chain", "rpc-url", "gateway", "event", "signature", "tss-address"} {
+		_ = cmd.MarkFlagRequired(name)
+	}
+	return cmd
+}
+
+// chainVM maps a CAIP-2 chain ID to the VM whose tx builder handles it.
+func chainVM(chainID string) (uregistrytypes.VmType, error) {
+	switch {
+	case strings.HasPrefix(chainID, "eip155:"):
+		return uregistrytypes.VmType_EVM, nil
+	case strings.HasPrefix(chainID, "solana:"):
+		return uregistrytypes.VmType_SVM, nil
+	default:
+		return uregistrytypes.VmType_UNKNOWN_VM, fmt.Errorf("unsupported chain %q: expected eip155:<id> or solana:<genesis>", chainID)
+	}
+}
+
+// standaloneChainConfig returns chainID's config from the node home, or nil
+// when there is no readable config. Standalone builders must apply the same
+// gas bounds and chain settings as the node, or their signing hashes differ.
+func standaloneChainConfig(home, chainID string) *config.ChainSpecificConfig {
+	cfg, err := config.Load(home)
+	if err != nil {
+		return nil
+	}
+	return cfg.GetChainConfig(chainID)
+}
+
+// newStandaloneTxBuilder builds a tx builder for chainID outside a running
+// node, for rebuilding signing hashes and simulating; it is never used to
+// broadcast. nodeHome locates the SVM relayer keypair and may be empty when
+// no tx is assembled.
+func newStandaloneTxBuilder(ctx context.Context, chainID, rpcURL, gateway, vault, nodeHome string, chainConfig *config.ChainSpecificConfig) (common.TxBuilder, error) {
+	logger := zerolog.Nop()
+	vm, err := chainVM(chainID)
+	if err != nil {
+		return nil, err
+	}
+	switch vm {
+	case uregistrytypes.VmType_EVM:
+		chainIDInt, err := strconv.ParseInt(strings.TrimPrefix(chainID, "eip155:"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EVM chain ID %q: %w", chainID, err)
+		}
+		rpcClient, err := evm.NewRPCClient([]string{rpcURL}, chainIDInt, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+		}
+		vaultAddr := ethcommon.HexToAddress(vault)
+		if vault == "" {
+			vaultAddr, err = evm.FetchVaultAddress(ctx, rpcClient, ethcommon.HexToAddress(gateway))
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch vault address: %w", err)
+			}
+		}
+		builder, err := evm.NewTxBuilder(rpcClient, chainID, chainIDInt, gateway, vaultAddr, logger)
+		if err != nil {
+			return nil, err
+		}
+		builder.ApplyChainConfig(chainConfig)
+		return builder, nil
+	default:
+		rpcClient, err := svm.NewRPCClient([]string{rpcURL}, "", logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to RPC: %w", err)
+		}
+		return svm.NewTxBuilder(rpcClient, chainID, gateway, nodeHome, logger, chainConfig)
+	}
+}
@@ -0,0 +1,73 @@
+#!/bin/bash
+
+###############################################
+# Push Chain Node File Checks
+#
+# Sourced by start.sh and start_cosmovisor.sh to
+# validate node files before pchaind starts:
+# - config/addrbook.json: a corrupt address book can
+#   be moved aside (pchaind rebuilds it from peers).
+#   Set REPAIR_ADDRBOOK=true to do so without asking.
+# - config/node_key.json: a missing or corrupt key makes
+#   pchaind generate a new one, changing the node ID.
+#   Refused unless REGENERATE_NODE_KEY=true or
+#   "regenerate" is typed at the prompt.
+###############################################
+
+# check_node_files <node-home>
+check_node_files() {
+  local config_dir="$1/config"
+  local addrbook="$config_dir/addrbook.json"
+  local node_key="$config_dir/node_key.json"
+  local stamp
+  stamp=$(date +%Y%m%d%H%M%S)
+
+  if ! command -v jq &> /dev/null; then
+    echo "⚠️  jq not found, skipping address book and node key validation"
+    return 0
+  fi
+
+  if [ -f "$addrbook" ] && ! jq -e '.addrs | type == "array"' "$addrbook" > /dev/null 2>&1; then
+    echo "⚠️  Address book is corrupt: $addrbook"
+    local repair="${REPAIR_ADDRBOOK:-}"
+    if [ -z "$repair" ] && [ -t 0 ]; then
+      read -r -p "Move it aside so the node rebuilds it from peers? [y/N] " answer
+      [[ "$answer" =~ ^[Yy]$ ]] && repair=true
+    fi
+    if [ "$repair" != "true" ]; then
+      echo "❌ Not starting. Re-run with REPAIR_ADDRBOOK=true to move it aside."
+      return 1
+    fi
+    mv "$addrbook" "$addrbook.corrupt.$stamp"
+    echo "✅ Moved corrupt address book to $addrbook.corrupt.$stamp"
+  fi
+
+  local key_problem=""
+  if [ ! -f "$node_key" ]; then
+    key_problem="missing"
+  elif ! jq -e '.priv_key.value | type == "string" and length > 0' "$node_key" > /dev/null 2>&1; then
+    key_problem="corrupt"
+  fi
+  if [ -n "$key_problem" ]; then
+    echo "⚠️  Node key is $key_problem: $node_key"
+    echo "   Starting now would generate a new node key and change this node's ID;"
+    echo "   peers that pin the current ID in persistent_peers would lose it."
+    echo "   Restore node_key.json from a backup if you have one."
+    local regenerate="${REGENERATE_NODE_KEY:-}"
+    if [ -z "$regenerate" ] && [ -t 0 ]; then
+      read -r -p "Type 'regenerate' to start with a new node key: " answer
+      [ "$answer" = "regenerate" ] && regenerate=true
+    fi
+    if [ "$regenerate" != "true" ]; then
+      echo "❌ Not starting. Re-run with REGENERATE_NODE_KEY=true to accept a new node ID."
+      return 1
+    fi
+    if [ -f "$node_key" ]; then
+      mv "$node_key" "$node_key.corrupt.$stamp"
+      echo "✅ Moved corrupt node key to $node_key.corrupt.$stamp"
+    fi
+    echo "🔑 pchaind will generate a new node key on start"
+  fi
+
+  return 0
+}
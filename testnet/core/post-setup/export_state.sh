@@ -0,0 +1,75 @@
+#!/bin/bash
+
+###############################################
+# Push Chain State Export
+#
+# Exports the application state to a genesis
+# JSON file with `pchaind export`, for upgrade
+# testing and debugging.
+#
+# The node must be stopped: export opens the
+# same database the running node holds. To keep
+# the node running, export from a copy of the
+# home directory (e.g. an extracted backup)
+# with --home.
+#
+# Usage: export_state.sh --out <file> [--height N] [--home <dir>]
+###############################################
+
+set -e
+
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+APP_DIR="$(cd "$SCRIPT_DIR/.." && pwd)"
+
+BINARY="$APP_DIR/binary/pchaind"
+NODE_HOME="$APP_DIR/.pchain"
+HEIGHT=""
+OUT=""
+
+usage() {
+  echo "Usage: $0 --out <file> [--height N] [--home <dir>]"
+  exit 1
+}
+
+while [ $# -gt 0 ]; do
+  case "$1" in
+    --out) OUT="$2"; shift 2 ;;
+    --height) HEIGHT="$2"; shift 2 ;;
+    --home) NODE_HOME="$2"; shift 2 ;;
+    *) usage ;;
+  esac
+done
+
+[ -n "$OUT" ] || usage
+
+if [ -n "$HEIGHT" ] && ! [[ "$HEIGHT" =~ ^[1-9][0-9]*$ ]]; then
+  echo "❌ --height must be a positive block height"
+  exit 1
+fi
+
+if [ ! -f "$BINARY" ]; then
+  echo "❌ Binary not found at: $BINARY"
+  exit 1
+fi
+
+if [ ! -d "$NODE_HOME/data" ]; then
+  echo "❌ No node data found at: $NODE_HOME/data"
+  exit 1
+fi
+
+# Refuse to export a home that a running node is using
+if pgrep -f "(pchaind|cosmovisor run) start.*--home[= ]$NODE_HOME( |$)" > /dev/null \
+  || { [ "$NODE_HOME" = "$APP_DIR/.pchain" ] && systemctl is-active --quiet pchaind 2> /dev/null; }; then
+  echo "❌ A node is running on $NODE_HOME. Stop it first:"
+  echo "   bash $SCRIPT_DIR/stop.sh   (or: bash $SCRIPT_DIR/node.sh stop)"
+  echo "   or export from a copy of the home directory with --home"
+  exit 1
+fi
+
+EXPORT_ARGS=(export --home "$NODE_HOME" --output-document "$OUT")
+[ -n "$HEIGHT" ] && EXPORT_ARGS+=(--height "$HEIGHT")
+
+echo "📤 Exporting state from $NODE_HOME${HEIGHT:+ at height $HEIGHT}..."
+"$BINARY" "${EXPORT_ARGS[@]}"
+
+echo "✅ State exported to: $OUT"
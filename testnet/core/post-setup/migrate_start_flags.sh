@@ -0,0 +1,50 @@
+#!/bin/bash
+
+###############################################
+# Push Chain Start Flag Migration
+#
+# Sourced by start.sh and start_cosmovisor.sh, and
+# run by pchaind.service before the node starts.
+#
+# Older start scripts forced pruning, minimum gas
+# prices and the RPC listen address with flags. They
+# now come from app.toml and config.toml, so a home
+# set up before that gets the values the flags used
+# to pass written into its config files once:
+# - app.toml pruning = $PRUNING (default nothing)
+# - app.toml minimum-gas-prices = $MIN_GAS_PRICES
+#   (default 1000000000upc)
+# - config.toml [rpc] laddr = tcp://$RPC_BIND:$RPC
+#   (default tcp://0.0.0.0:26657); this also repairs
+#   the "claddr" key an older genesis setup wrote.
+# The setup scripts write these values themselves
+# and mark the home as migrated.
+###############################################
+
+START_FLAGS_MARKER=".start-flags-migrated"
+
+# migrate_start_flags <node-home>
+migrate_start_flags() {
+  local config_dir="$1/config"
+  local marker="$config_dir/$START_FLAGS_MARKER"
+  local app_toml="$config_dir/app.toml"
+  local config_toml="$config_dir/config.toml"
+
+  [ -f "$marker" ] && return 0
+  if [ ! -f "$app_toml" ] || [ ! -f "$config_toml" ]; then
+    echo "⚠️  $config_dir has no app.toml/config.toml, skipping start flag migration"
+    return 0
+  fi
+
+  local pruning="${PRUNING:-nothing}"
+  local min_gas_prices="${MIN_GAS_PRICES:-1000000000upc}"
+  local rpc_laddr="tcp://${RPC_BIND:-0.0.0.0}:${RPC:-26657}"
+
+  sed -i -e 's|^pruning = .*|pruning = "'"$pruning"'"|' "$app_toml"
+  sed -i -e 's|^minimum-gas-prices = .*|minimum-gas-prices = "'"$min_gas_prices"'"|' "$app_toml"
+  # Only the [rpc] section's laddr is the RPC listen address.
+  sed -i -e '/^\[rpc\]/,/^\[/ s|^c\?laddr = .*|laddr = "'"$rpc_laddr"'"|' "$config_toml"
+
+  touch "$marker"
+  echo "✅ Moved start flags into config: pruning=$pruning, minimum-gas-prices=$min_gas_prices, rpc laddr=$rpc_laddr"
+}
@@ -110,7 +110,8 @@ do_status() {
 # Show logs
 do_logs() {
   if [ -f "$LOG_FILE" ]; then
-    tail -f "$LOG_FILE"
+    # -F reopens the file after log rotation or truncation
+    tail -F "$LOG_FILE"
   else
     echo "❌ Log file not found: $LOG_FILE"
     echo "Try: journalctl -u $SERVICE_NAME -f"
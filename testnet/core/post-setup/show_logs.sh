@@ -1,13 +1,65 @@
 #!/bin/bash
 set -e
 
+###############################################
+# Tail the pchaind log.
+#
+# Usage: show_logs.sh [--level debug|info|warn|error] [--grep <regex>]
+#
+# --level keeps lines at or above that level; both
+# console (INF/WRN/ERR) and JSON ("level":"warn") logs
+# are understood. --grep keeps lines matching an
+# extended regex. Color codes are stripped when filtering.
+###############################################
+
 SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
 APP_DIR="$(cd "$SCRIPT_DIR/.." && pwd)"
 LOG_FILE="$APP_DIR/logs/pchaind.log"
 
+LEVEL=""
+PATTERN=""
+while [ $# -gt 0 ]; do
+  case "$1" in
+    --level) LEVEL="$2"; shift 2 ;;
+    --grep)  PATTERN="$2"; shift 2 ;;
+    *)
+      echo "Usage: $0 [--level debug|info|warn|error] [--grep <regex>]"
+      exit 1
+      ;;
+  esac
+done
+
+case "$LEVEL" in
+  ""|debug) LEVEL_RE="" ;;
+  info)     LEVEL_RE='"level":"(info|warn|error|fatal|panic)"|\b(INF|WRN|ERR|FTL|PNC)\b' ;;
+  warn)     LEVEL_RE='"level":"(warn|error|fatal|panic)"|\b(WRN|ERR|FTL|PNC)\b' ;;
+  error)    LEVEL_RE='"level":"(error|fatal|panic)"|\b(ERR|FTL|PNC)\b' ;;
+  *)
+    echo "❌ Unknown level: $LEVEL (use debug, info, warn or error)"
+    exit 1
+    ;;
+esac
+
+if [ -n "$PATTERN" ]; then
+  # Reject a bad regex up front rather than failing silently mid-stream.
+  echo | grep -E -- "$PATTERN" > /dev/null 2>&1 || [ $? -eq 1 ] || {
+    echo "❌ Invalid --grep regex: $PATTERN"
+    exit 1
+  }
+fi
+
 if [ ! -f "$LOG_FILE" ]; then
   echo "❌ Log file not found at: $LOG_FILE"
   exit 1
 fi
 
-tail -f "$LOG_FILE"
+# -F follows the file by name, so tailing survives log rotation and truncation
+if [ -z "$LEVEL_RE" ] && [ -z "$PATTERN" ]; then
+  tail -F "$LOG_FILE"
+  exit 0
+fi
+
+tail -F "$LOG_FILE" \
+  | sed -u 's/\x1b\[[0-9;]*m//g' \
+  | grep -E --line-buffered -- "${LEVEL_RE:-.}" \
+  | grep -E --line-buffered -- "${PATTERN:-.}"
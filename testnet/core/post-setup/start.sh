@@ -18,8 +18,9 @@ LOG_FILE="$LOG_DIR/pchaind.log"
 
 # Optional: make these configurable with defaults
 CHAIN_ID="push_42101-1"
-DENOM="upc"
-RPC="26657"
+# Pruning, minimum gas prices and the RPC listen address are read from
+# app.toml and config.toml, where the setup scripts write them. Homes set
+# up before that get them written once by migrate_start_flags.
 
 mkdir -p "$LOG_DIR"
 
@@ -28,6 +29,14 @@ if [ ! -f "$BINARY" ]; then
   exit 1
 fi
 
+# Move the settings older start scripts passed as flags into the config files
+source "$SCRIPT_DIR/migrate_start_flags.sh"
+migrate_start_flags "$NODE_HOME" || exit 1
+
+# Validate address book and node key before starting
+source "$SCRIPT_DIR/check_node_files.sh"
+check_node_files "$NODE_HOME" || exit 1
+
 echo "🚀 Starting node from: $NODE_HOME"
-"$BINARY" start --pruning=nothing  --minimum-gas-prices=1000000000$DENOM --rpc.laddr="tcp://0.0.0.0:$RPC"  --json-rpc.address="0.0.0.0:8545" --json-rpc.ws-address="0.0.0.0:8546"  --json-rpc.api=eth,txpool,personal,net,debug,web3 --chain-id="$CHAIN_ID" --home="$NODE_HOME" > "$LOG_FILE" 2>&1 &
+"$BINARY" start --json-rpc.address="0.0.0.0:8545" --json-rpc.ws-address="0.0.0.0:8546"  --json-rpc.api=eth,txpool,personal,net,debug,web3 --chain-id="$CHAIN_ID" --home="$NODE_HOME" > "$LOG_FILE" 2>&1 &
 echo "✅ Node started. Logging to: $LOG_FILE"
\ No newline at end of file
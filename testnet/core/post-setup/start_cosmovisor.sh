@@ -36,8 +36,9 @@ export UNSAFE_SKIP_BACKUP=false
 
 # Chain config (can be overridden by .env)
 CHAIN_ID="${CHAIN_ID:-push_42101-1}"
-DENOM="${DENOM:-upc}"
-RPC="${RPC:-26657}"
+# Pruning, minimum gas prices and the RPC listen address are read from
+# app.toml and config.toml, where the setup scripts write them. Homes set
+# up before that get them written once by migrate_start_flags.
 
 mkdir -p "$LOG_DIR"
 
@@ -69,11 +70,16 @@ if ! command -v cosmovisor &> /dev/null; then
   exit 1
 fi
 
+# Move the settings older start scripts passed as flags into the config files
+source "$SCRIPT_DIR/migrate_start_flags.sh"
+migrate_start_flags "$NODE_HOME" || exit 1
+
+# Validate address book and node key before starting
+source "$SCRIPT_DIR/check_node_files.sh"
+check_node_files "$NODE_HOME" || exit 1
+
 echo "🚀 Starting node with Cosmovisor from: $NODE_HOME"
 cosmovisor run start \
-  --pruning=nothing \
-  --minimum-gas-prices=1000000000$DENOM \
-  --rpc.laddr="tcp://0.0.0.0:$RPC" \
   --json-rpc.address="0.0.0.0:8545" \
   --json-rpc.ws-address="0.0.0.0:8546" \
   --json-rpc.api=eth,txpool,personal,net,debug,web3 \
@@ -12,17 +12,56 @@
 # - Peers connected
 # - App version
 #
+# Usage: sync_status.sh [--json] [--full]
+#
+# --json prints one JSON object instead of the summary.
+# --full adds latest block time, peer count, and this
+# node's validator voting power and jailed state (the
+# latter from the staking REST API on REST_URL), and
+# checks the EVM JSON-RPC on EVM_RPC_URL: reachability,
+# block number and that eth_chainId matches the EVM
+# chain id in the chain id (42101 for push_42101-1,
+# or EVM_CHAIN_ID if set).
+#
+# The RPC address is RPC_URL if set, otherwise the
+# [rpc] laddr in config.toml (0.0.0.0 probed as 127.0.0.1).
+#
 # Requires:
 # - jq
-# - Local node running on port 26657
+# - Local node running (default port 26657)
 ###############################################
 
-RPC_URL="http://localhost:26657"
+SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+APP_DIR="$(cd "$SCRIPT_DIR/.." && pwd)"
+CONFIG_TOML="$APP_DIR/.pchain/config/config.toml"
+
+if [ -z "${RPC_URL:-}" ] && [ -f "$CONFIG_TOML" ]; then
+  RPC_LADDR=$(sed -n '/^\[rpc\]/,/^\[/ s|^laddr *= *"tcp://\(.*\)"|\1|p' "$CONFIG_TOML" | head -n1)
+  if [ -n "$RPC_LADDR" ]; then
+    RPC_URL="http://${RPC_LADDR/#0.0.0.0:/127.0.0.1:}"
+  fi
+fi
+RPC_URL="${RPC_URL:-http://localhost:26657}"
+REST_URL="${REST_URL:-http://localhost:1317}"
+EVM_RPC_URL="${EVM_RPC_URL:-http://localhost:8545}"
 STATUS_URL="$RPC_URL/status"
 NET_INFO_URL="$RPC_URL/net_info"
 ABCI_INFO_URL="$RPC_URL/abci_info"
 
-echo "🔍 Checking Push Chain node status..."
+JSON=false
+FULL=false
+for arg in "$@"; do
+  case "$arg" in
+    --json) JSON=true ;;
+    --full) FULL=true ;;
+    *)
+      echo "Usage: $0 [--json] [--full]"
+      exit 1
+      ;;
+  esac
+done
+
+[ "$JSON" = "true" ] || echo "🔍 Checking Push Chain node status..."
 
 # Ensure jq is installed
 if ! command -v jq &> /dev/null; then
@@ -32,12 +71,19 @@ fi
 
 # Get status response
 STATUS=$(curl -s "$STATUS_URL")
-NET_INFO=$(curl -s "$NET_INFO_URL")
 ABCI_INFO=$(curl -s "$ABCI_INFO_URL")
+# JSON output only pays for net_info with --full
+if [ "$JSON" = "false" ] || [ "$FULL" = "true" ]; then
+  NET_INFO=$(curl -s "$NET_INFO_URL")
+fi
 
 # Validate status
 if [ -z "$STATUS" ]; then
-  echo "❌ Unable to connect to local RPC at $RPC_URL"
+  if [ "$JSON" = "true" ]; then
+    jq -n --arg rpc "$RPC_URL" '{rpc_reachable: false, rpc_url: $rpc}'
+  else
+    echo "❌ Unable to connect to local RPC at $RPC_URL"
+  fi
   exit 1
 fi
 
@@ -47,9 +93,82 @@ MONIKER=$(echo "$STATUS" | jq -r '.result.node_info.moniker')
 NODE_ID=$(echo "$STATUS" | jq -r '.result.node_info.id')
 HEIGHT=$(echo "$STATUS" | jq -r '.result.sync_info.latest_block_height')
 CATCHING_UP=$(echo "$STATUS" | jq -r '.result.sync_info.catching_up')
-PEERS=$(echo "$NET_INFO" | jq -r '.result.n_peers')
+PEERS=$(echo "${NET_INFO:-}" | jq -r '.result.n_peers')
 APP_VERSION=$(echo "$ABCI_INFO" | jq -r '.result.response.version')
 
+# EVM JSON-RPC check (--full only)
+evm_call() {
+  curl -s --max-time 5 -X POST -H "Content-Type: application/json" \
+    --data '{"jsonrpc":"2.0","id":1,"method":"'"$1"'","params":[]}' "$EVM_RPC_URL" \
+    | jq -r '.result // empty' 2>/dev/null
+}
+
+if [ "$FULL" = "true" ]; then
+  EXPECTED_EVM_CHAIN_ID="${EVM_CHAIN_ID:-}"
+  if [ -z "$EXPECTED_EVM_CHAIN_ID" ] && [[ "$CHAIN_ID" =~ _([0-9]+)- ]]; then
+    EXPECTED_EVM_CHAIN_ID="${BASH_REMATCH[1]}"
+  fi
+  EVM_CHAIN_ID_HEX=$(evm_call eth_chainId)
+  EVM_BLOCK_HEX=$(evm_call eth_blockNumber)
+  EVM_REACHABLE=false
+  EVM_CHAIN_ID_DEC=""
+  EVM_BLOCK=""
+  if [[ "$EVM_CHAIN_ID_HEX" =~ ^0x[0-9a-fA-F]+$ ]]; then
+    EVM_REACHABLE=true
+    EVM_CHAIN_ID_DEC=$((EVM_CHAIN_ID_HEX))
+  fi
+  if [[ "$EVM_BLOCK_HEX" =~ ^0x[0-9a-fA-F]+$ ]]; then
+    EVM_BLOCK=$((EVM_BLOCK_HEX))
+  fi
+  EVM_CHAIN_ID_MATCH=false
+  if [ "$EVM_REACHABLE" = "true" ] && [ "$EVM_CHAIN_ID_DEC" = "$EXPECTED_EVM_CHAIN_ID" ]; then
+    EVM_CHAIN_ID_MATCH=true
+  fi
+fi
+
+if [ "$JSON" = "true" ]; then
+  BASE=$(jq -n \
+    --arg chain_id "$CHAIN_ID" --arg moniker "$MONIKER" --arg node_id "$NODE_ID" \
+    --arg app_version "$APP_VERSION" --arg height "$HEIGHT" --arg catching_up "$CATCHING_UP" \
+    '{rpc_reachable: true, chain_id: $chain_id, moniker: $moniker, node_id: $node_id,
+      app_version: $app_version, latest_block_height: ($height | tonumber? // null),
+      catching_up: ($catching_up == "true")}')
+  if [ "$FULL" = "false" ]; then
+    echo "$BASE"
+    exit 0
+  fi
+
+  # This node is a validator if the staking set has its consensus pubkey.
+  CONS_PUBKEY=$(echo "$STATUS" | jq -r '.result.validator_info.pub_key.value // empty')
+  VALIDATOR="null"
+  if [ -n "$CONS_PUBKEY" ]; then
+    VALIDATOR=$(curl -s "$REST_URL/cosmos/staking/v1beta1/validators?pagination.limit=1000" \
+      | jq -c --arg key "$CONS_PUBKEY" \
+        'first(.validators[]? | select(.consensus_pubkey.key == $key)
+          | {operator_address, jailed, status}) // null' 2>/dev/null || echo null)
+  fi
+
+  EVM=$(jq -n \
+    --arg url "$EVM_RPC_URL" --argjson reachable "$EVM_REACHABLE" \
+    --arg chain_id "$EVM_CHAIN_ID_DEC" --arg expected "$EXPECTED_EVM_CHAIN_ID" \
+    --argjson match "$EVM_CHAIN_ID_MATCH" --arg block "$EVM_BLOCK" \
+    '{rpc_url: $url, reachable: $reachable, chain_id: ($chain_id | tonumber? // null),
+      expected_chain_id: ($expected | tonumber? // null), chain_id_match: $match,
+      block_number: ($block | tonumber? // null)}')
+
+  echo "$BASE" | jq \
+    --argjson status "$STATUS" --arg peers "$PEERS" --argjson validator "${VALIDATOR:-null}" \
+    --argjson evm "$EVM" \
+    '. + {latest_block_time: $status.result.sync_info.latest_block_time,
+          peers: ($peers | tonumber? // null),
+          voting_power: ($status.result.validator_info.voting_power | tonumber? // 0),
+          is_validator: ($validator != null),
+          jailed: (if $validator == null then null else $validator.jailed end),
+          validator: $validator,
+          evm: $evm}'
+  exit 0
+fi
+
 # Output
 echo ""
 echo "🔗 Chain ID       : $CHAIN_ID"
@@ -60,6 +179,19 @@ echo "🔢 Block Height   : $HEIGHT"
 echo "📡 Peers Connected: $PEERS"
 echo "🧭 Catching Up    : $CATCHING_UP"
 
+if [ "$FULL" = "true" ]; then
+  if [ "$EVM_REACHABLE" = "true" ]; then
+    echo "🦊 EVM RPC        : $EVM_RPC_URL (block ${EVM_BLOCK:-unknown})"
+    if [ "$EVM_CHAIN_ID_MATCH" = "true" ]; then
+      echo "🧾 EVM Chain ID   : $EVM_CHAIN_ID_DEC"
+    else
+      echo "🧾 EVM Chain ID   : $EVM_CHAIN_ID_DEC ⚠️  expected ${EXPECTED_EVM_CHAIN_ID:-unknown}"
+    fi
+  else
+    echo "🦊 EVM RPC        : ❌ unreachable at $EVM_RPC_URL"
+  fi
+fi
+
 # Health summary
 if [[ "$CATCHING_UP" == "false" ]]; then
   echo -e "\n✅ Node is fully synced and healthy."
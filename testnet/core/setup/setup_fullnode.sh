@@ -9,6 +9,11 @@ shopt -s expand_aliases
 if [ $# -lt 1 ]; then
   echo "❌ Usage: bash setup_fullnode.sh <genesis-node-domain>"
   echo "   Example: bash setup_fullnode.sh node1.push.org"
+  echo "   The genesis node's peers are added too (up to MAX_PEERS, default 10); set DISCOVER_PEERS=false to skip"
+  echo "   Set STATE_SYNC=true to state sync from a block TRUST_OFFSET (default 2000) below the genesis node's tip"
+  echo "   Set RPC_BIND, P2P_BIND and GRPC_BIND to listen on a specific address (default 0.0.0.0)"
+  echo "   Set PRUNING=default|nothing|everything|custom (default nothing; custom needs PRUNING_KEEP_RECENT and PRUNING_INTERVAL)"
+  echo "   Set MIN_GAS_PRICES=<amount>upc to change the minimum gas price (default 1000000000upc)"
   exit 1
 fi
 
@@ -40,6 +45,56 @@ P2P=${P2P:-26656}
 ROSETTA=${ROSETTA:-8080}
 BLOCK_TIME=${BLOCK_TIME:-"1s"}
 
+# Bind addresses (e.g. 127.0.0.1 or a specific interface IP)
+RPC_BIND=${RPC_BIND:-0.0.0.0}
+P2P_BIND=${P2P_BIND:-0.0.0.0}
+GRPC_BIND=${GRPC_BIND:-0.0.0.0}
+
+# Pruning: default|nothing|everything|custom (custom takes keep-recent/interval)
+PRUNING=${PRUNING:-nothing}
+PRUNING_KEEP_RECENT=${PRUNING_KEEP_RECENT:-}
+PRUNING_INTERVAL=${PRUNING_INTERVAL:-}
+
+case "$PRUNING" in
+  default|nothing|everything)
+    if [ -n "$PRUNING_KEEP_RECENT" ] || [ -n "$PRUNING_INTERVAL" ]; then
+      echo "❌ PRUNING_KEEP_RECENT/PRUNING_INTERVAL only apply to PRUNING=custom (got PRUNING=$PRUNING)"
+      exit 1
+    fi
+    ;;
+  custom)
+    if ! [[ "$PRUNING_KEEP_RECENT" =~ ^[0-9]+$ ]] || ! [[ "$PRUNING_INTERVAL" =~ ^[1-9][0-9]*$ ]]; then
+      echo "❌ PRUNING=custom needs PRUNING_KEEP_RECENT (>= 0) and PRUNING_INTERVAL (>= 1)"
+      exit 1
+    fi
+    ;;
+  *)
+    echo "❌ Unknown PRUNING=$PRUNING (expected default, nothing, everything or custom)"
+    exit 1
+    ;;
+esac
+
+# Minimum gas prices: <amount><denom>, denom must be the chain denom
+MIN_GAS_PRICES=${MIN_GAS_PRICES:-"1000000000$DENOM"}
+
+if ! [[ "$MIN_GAS_PRICES" =~ ^[0-9]+(\.[0-9]+)?([a-zA-Z][a-zA-Z0-9/:._-]*)$ ]]; then
+  echo "❌ Invalid MIN_GAS_PRICES=$MIN_GAS_PRICES (expected <amount><denom>, e.g. 1000000000$DENOM)"
+  exit 1
+fi
+if [ "${BASH_REMATCH[2]}" != "$DENOM" ]; then
+  echo "❌ MIN_GAS_PRICES denom '${BASH_REMATCH[2]}' does not match chain denom '$DENOM'"
+  exit 1
+fi
+
+# Peer discovery: also add the genesis node's own peers (from /net_info).
+# On by default; a failed discovery falls back to the genesis node alone.
+DISCOVER_PEERS=${DISCOVER_PEERS:-true}
+MAX_PEERS=${MAX_PEERS:-10}
+
+# State sync: bootstrap from a recent snapshot trusted via the genesis node
+STATE_SYNC=${STATE_SYNC:-false}
+TRUST_OFFSET=${TRUST_OFFSET:-2000}
+
 # ---------------------------
 # === CLEAN START ===
 # ---------------------------
@@ -67,15 +122,60 @@ echo "🔍 Resolving $GENESIS_DOMAIN to IP"
 VALIDATOR_IP=$(dig +short "$GENESIS_DOMAIN" | tail -n1)
 
 PERSISTENT_PEER="$VALIDATOR_NODE_ID@$VALIDATOR_IP:$P2P"
+
+if [ "$DISCOVER_PEERS" = "true" ]; then
+  echo "🔍 Discovering peers from $GENESIS_RPC/net_info"
+  # id@ip:port for each peer, port taken from its advertised listen_addr
+  DISCOVERED=$(curl -s --max-time 10 "$GENESIS_RPC/net_info" | jq -r '
+    .result.peers[]?
+    | select(.remote_ip != "" and .node_info.id != "")
+    | "\(.node_info.id)@\(.remote_ip):\(.node_info.listen_addr | split(":") | last)"' 2>/dev/null \
+    | grep -v "^$VALIDATOR_NODE_ID@" | sort -u | head -n "$MAX_PEERS" | paste -sd, - || true)
+  if [ -n "$DISCOVERED" ]; then
+    echo "🔗 Discovered $(echo "$DISCOVERED" | tr ',' '\n' | wc -l) peer(s)"
+    PERSISTENT_PEER="$PERSISTENT_PEER,$DISCOVERED"
+  else
+    echo "⚠️  No peers discovered, using the genesis node only"
+  fi
+fi
+
 echo "🔗 persistent_peers = $PERSISTENT_PEER"
 sed -i -e "s/^persistent_peers *=.*/persistent_peers = \"$PERSISTENT_PEER\"/" "$HOME_DIR/config/config.toml"
 
+# ---------------------------
+# === STATE SYNC (optional)
+# ---------------------------
+
+if [ "$STATE_SYNC" = "true" ]; then
+  echo "🔍 Fetching trusted height/hash from $GENESIS_RPC"
+  LATEST_HEIGHT=$(curl -s --max-time 10 "$GENESIS_RPC/block" | jq -r '.result.block.header.height // empty')
+  if [ -z "$LATEST_HEIGHT" ] || [ "$LATEST_HEIGHT" -le "$TRUST_OFFSET" ]; then
+    echo "❌ Cannot state sync: latest height '${LATEST_HEIGHT:-unknown}' from $GENESIS_RPC is not above TRUST_OFFSET=$TRUST_OFFSET"
+    exit 1
+  fi
+  TRUST_HEIGHT=$((LATEST_HEIGHT - TRUST_OFFSET))
+  TRUST_HASH=$(curl -s --max-time 10 "$GENESIS_RPC/block?height=$TRUST_HEIGHT" | jq -r '.result.block_id.hash // empty')
+  if ! [[ "$TRUST_HASH" =~ ^[0-9A-F]{64}$ ]]; then
+    echo "❌ Cannot state sync: no valid block hash at height $TRUST_HEIGHT from $GENESIS_RPC"
+    exit 1
+  fi
+  echo "🔐 trust_height = $TRUST_HEIGHT, trust_hash = $TRUST_HASH"
+
+  # Only the [statesync] section is touched.
+  sed -i -e '/^\[statesync\]/,/^\[/ {
+    s|^enable *=.*|enable = true|
+    s|^rpc_servers *=.*|rpc_servers = "'"$GENESIS_RPC,$GENESIS_RPC"'"|
+    s|^trust_height *=.*|trust_height = '"$TRUST_HEIGHT"'|
+    s|^trust_hash *=.*|trust_hash = "'"$TRUST_HASH"'"|
+  }' "$HOME_DIR/config/config.toml"
+fi
+
 # ---------------------------
 # === CONFIG PATCHING ===
 # ---------------------------
 
 # RPC
-sed -i -e 's/laddr = "tcp:\/\/127.0.0.1:26657"/laddr = "tcp:\/\/0.0.0.0:'$RPC'"/g' $HOME_DIR/config/config.toml
+sed -i -e 's/laddr = "tcp:\/\/127.0.0.1:26657"/laddr = "tcp:\/\/'$RPC_BIND':'$RPC'"/g' $HOME_DIR/config/config.toml
 sed -i -e 's/cors_allowed_origins = \[\]/cors_allowed_origins = \["\*"\]/g' $HOME_DIR/config/config.toml
 
 # REST
@@ -85,15 +185,28 @@ sed -i -e 's/enabled-unsafe-cors = false/enabled-unsafe-cors = true/g' $HOME_DIR
 
 # P2P & profiling
 sed -i -e 's/pprof_laddr = "localhost:6060"/pprof_laddr = "localhost:'$PROFF'"/g' $HOME_DIR/config/config.toml
-sed -i -e 's/laddr = "tcp:\/\/0.0.0.0:26656"/laddr = "tcp:\/\/0.0.0.0:'$P2P'"/g' $HOME_DIR/config/config.toml
+sed -i -e 's/laddr = "tcp:\/\/0.0.0.0:26656"/laddr = "tcp:\/\/'$P2P_BIND':'$P2P'"/g' $HOME_DIR/config/config.toml
 
 # gRPC
-sed -i -e 's/address = "localhost:9090"/address = "0.0.0.0:'$GRPC'"/g' $HOME_DIR/config/app.toml
-sed -i -e 's/address = "localhost:9091"/address = "0.0.0.0:'$GRPC_WEB'"/g' $HOME_DIR/config/app.toml
+sed -i -e 's/address = "localhost:9090"/address = "'$GRPC_BIND':'$GRPC'"/g' $HOME_DIR/config/app.toml
+sed -i -e 's/address = "localhost:9091"/address = "'$GRPC_BIND':'$GRPC_WEB'"/g' $HOME_DIR/config/app.toml
 
 # Rosetta
 sed -i -e 's/address = ":8080"/address = "0.0.0.0:'$ROSETTA'"/g' $HOME_DIR/config/app.toml
 
+# Pruning
+sed -i -e 's/^pruning = .*/pruning = "'$PRUNING'"/' $HOME_DIR/config/app.toml
+if [ "$PRUNING" = "custom" ]; then
+  sed -i -e 's/^pruning-keep-recent = .*/pruning-keep-recent = "'$PRUNING_KEEP_RECENT'"/' $HOME_DIR/config/app.toml
+  sed -i -e 's/^pruning-interval = .*/pruning-interval = "'$PRUNING_INTERVAL'"/' $HOME_DIR/config/app.toml
+fi
+
+# Minimum gas prices
+sed -i -e 's/^minimum-gas-prices = .*/minimum-gas-prices = "'$MIN_GAS_PRICES'"/' $HOME_DIR/config/app.toml
+
+# The values above are final; the start scripts must not migrate over them
+touch $HOME_DIR/config/.start-flags-migrated
+
 # Faster blocks
 sed -i -e 's/timeout_commit = "5s"/timeout_commit = "'$BLOCK_TIME'"/g' $HOME_DIR/config/config.toml
 
@@ -179,7 +179,7 @@ echo "🛠️ Updating genesis parameters..."
 # ---------------------------
 
 # Opens the RPC endpoint to outside connections
-sed -i -e 's/laddr = "tcp:\/\/127.0.0.1:26657"/c\laddr = "tcp:\/\/0.0.0.0:'$RPC'"/g' $HOME_DIR/config/config.toml
+sed -i -e 's/laddr = "tcp:\/\/127.0.0.1:26657"/laddr = "tcp:\/\/0.0.0.0:'$RPC'"/g' $HOME_DIR/config/config.toml
 sed -i -e 's/cors_allowed_origins = \[\]/cors_allowed_origins = \["\*"\]/g' $HOME_DIR/config/config.toml
 
 # REST endpoint
@@ -198,6 +198,11 @@ sed -i -e 's/address = "localhost:9091"/address = "0.0.0.0:'$GRPC_WEB'"/g' $HOME
 # Rosetta Api
 sed -i -e 's/address = ":8080"/address = "0.0.0.0:'$ROSETTA'"/g' $HOME_DIR/config/app.toml
 
+# Pruning and minimum gas prices (the start scripts pass no overrides)
+sed -i -e 's/^pruning = .*/pruning = "nothing"/' $HOME_DIR/config/app.toml
+sed -i -e 's/^minimum-gas-prices = .*/minimum-gas-prices = "1000000000'$DENOM'"/' $HOME_DIR/config/app.toml
+touch $HOME_DIR/config/.start-flags-migrated
+
 # Faster blocks
 sed -i -e 's/timeout_commit = "5s"/timeout_commit = "'$BLOCK_TIME'"/g' $HOME_DIR/config/config.toml
 
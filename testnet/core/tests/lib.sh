@@ -0,0 +1,100 @@
+#!/bin/bash
+
+###############################################
+# Helpers for the node script tests.
+#
+# Each test file sources this, defines test_*
+# functions and ends with run_tests. Every test
+# runs in a subshell with its own APP_DIR: a copy
+# of setup/ and post-setup/ next to a stub
+# binary/pchaind, with the stubs in tests/stubs
+# first on PATH.
+#
+# The curl stub answers from fixture files and
+# logs each request to $CURL_LOG; a URL without a
+# fixture fails like an unreachable host. The
+# pgrep and systemctl stubs see only what
+# $RUNNING_PROCESSES and $ACTIVE_UNITS list.
+###############################################
+
+TESTS_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+CORE_DIR="$(cd "$TESTS_DIR/.." && pwd)"
+
+FAILURES=0
+
+fail() {
+  echo "    ✗ $*"
+  FAILURES=$((FAILURES + 1))
+}
+
+assert_eq() {
+  [ "$1" = "$2" ] || fail "${3:-values differ}: expected '$1', got '$2'"
+}
+
+assert_contains() {
+  [[ "$1" == *"$2"* ]] || fail "${3:-missing output}: '$2' not in: $1"
+}
+
+assert_not_contains() {
+  [[ "$1" != *"$2"* ]] || fail "${3:-unexpected output}: '$2' in: $1"
+}
+
+assert_file_contains() {
+  grep -qE -- "$2" "$1" 2>/dev/null || fail "${3:-missing line}: /$2/ not in $1"
+}
+
+assert_file_not_contains() {
+  ! grep -qE -- "$2" "$1" 2>/dev/null || fail "${3:-unexpected line}: /$2/ in $1"
+}
+
+# fixture <url> [json-rpc-method] <body> makes the curl stub answer url
+# (and, for JSON-RPC POSTs, that method) with body.
+fixture() {
+  local url="$1" method="" body
+  if [ $# -eq 3 ]; then
+    method="$2"
+    body="$3"
+  else
+    body="$2"
+  fi
+  printf '%s' "$body" > "$FIXTURES/$(printf '%s' "${url#*://}${method:+#$method}" | tr -c 'A-Za-z0-9' '_')"
+}
+
+# run <script> [args...] runs a script from APP_DIR with stdin closed, so
+# prompts fall through to their non-interactive defaults. Sets OUTPUT and
+# STATUS.
+run() {
+  local script="$1"
+  shift
+  STATUS=0
+  OUTPUT=$(bash "$APP_DIR/$script" "$@" 2>&1 < /dev/null) || STATUS=$?
+}
+
+setup_app_dir() {
+  APP_DIR=$(mktemp -d)
+  cp -r "$CORE_DIR/setup" "$CORE_DIR/post-setup" "$APP_DIR/"
+  mkdir -p "$APP_DIR/binary"
+  cp "$TESTS_DIR/stubs/pchaind" "$APP_DIR/binary/pchaind"
+  FIXTURES="$APP_DIR/fixtures"
+  CURL_LOG="$APP_DIR/curl.log"
+  PCHAIND_LOG="$APP_DIR/pchaind.log"
+  mkdir -p "$FIXTURES"
+  : > "$CURL_LOG"
+  export APP_DIR FIXTURES CURL_LOG PCHAIND_LOG
+  export PATH="$TESTS_DIR/stubs:$PATH"
+}
+
+run_tests() {
+  local failed=0 name before
+  for name in $(declare -F | awk '{print $3}' | grep '^test_'); do
+    echo "  $name"
+    (
+      setup_app_dir
+      trap 'rm -rf "$APP_DIR"' EXIT
+      before=$FAILURES
+      "$name"
+      [ "$FAILURES" -eq "$before" ]
+    ) || failed=$((failed + 1))
+  done
+  [ "$failed" -eq 0 ]
+}
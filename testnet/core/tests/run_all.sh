@@ -0,0 +1,24 @@
+#!/bin/bash
+
+###############################################
+# Runs the node script tests: every test_*.sh in
+# this directory, or the files given as arguments.
+###############################################
+
+TESTS_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
+
+if [ $# -eq 0 ]; then
+  set -- "$TESTS_DIR"/test_*.sh
+fi
+
+failed=0
+for file in "$@"; do
+  echo "▶ $(basename "$file")"
+  bash "$TESTS_DIR/$(basename "$file")" || failed=$((failed + 1))
+done
+
+if [ "$failed" -ne 0 ]; then
+  echo "❌ $failed test file(s) failed"
+  exit 1
+fi
+echo "✅ All node script tests passed"
@@ -0,0 +1,119 @@
+#!/bin/bash
+# Tests for post-setup/check_node_files.sh and its use in start.sh.
+
+source "$(dirname "${BASH_SOURCE[0]}")/lib.sh"
+
+VALID_NODE_KEY='{"priv_key":{"type":"tendermint/PrivKeyEd25519","value":"c2VjcmV0"}}'
+
+# node_home writes a home with a valid address book and node key.
+node_home() {
+  mkdir -p "$APP_DIR/.pchain/config" "$APP_DIR/.pchain/data"
+  echo '{"key":"abc","addrs":[]}' > "$(addrbook)"
+  echo "$VALID_NODE_KEY" > "$(node_key)"
+}
+
+addrbook() {
+  echo "$APP_DIR/.pchain/config/addrbook.json"
+}
+
+node_key() {
+  echo "$APP_DIR/.pchain/config/node_key.json"
+}
+
+check_node_files() {
+  STATUS=0
+  OUTPUT=$(bash -c 'source "$1/post-setup/check_node_files.sh" && check_node_files "$1/.pchain"' _ "$APP_DIR" 2>&1 < /dev/null) \
+    || STATUS=$?
+}
+
+test_valid_files_pass() {
+  node_home
+
+  check_node_files
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq "" "$(ls "$APP_DIR/.pchain/config" | grep corrupt)" "nothing is moved aside"
+}
+
+test_missing_addrbook_passes() {
+  node_home
+  rm "$(addrbook)"
+
+  check_node_files
+  assert_eq 0 "$STATUS" "$OUTPUT"
+}
+
+test_corrupt_addrbook_is_refused_without_confirmation() {
+  node_home
+  echo '{"addrs": [' > "$(addrbook)"
+
+  check_node_files
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Address book is corrupt"
+  assert_contains "$OUTPUT" "REPAIR_ADDRBOOK=true"
+  assert_eq '{"addrs": [' "$(cat "$(addrbook)")" "the address book is left in place"
+}
+
+test_corrupt_addrbook_is_moved_aside_when_repair_is_allowed() {
+  node_home
+  echo 'garbage' > "$(addrbook)"
+
+  REPAIR_ADDRBOOK=true check_node_files
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  [ ! -f "$(addrbook)" ] || fail "corrupt address book still in place"
+  assert_eq "garbage" "$(cat "$(addrbook)".corrupt.*)" "corrupt address book is kept aside"
+}
+
+test_missing_node_key_is_refused() {
+  node_home
+  rm "$(node_key)"
+
+  check_node_files
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Node key is missing"
+  assert_contains "$OUTPUT" "change this node's ID"
+  assert_contains "$OUTPUT" "REGENERATE_NODE_KEY=true"
+}
+
+test_corrupt_node_key_is_refused() {
+  node_home
+  echo '{"priv_key":{}}' > "$(node_key)"
+
+  check_node_files
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Node key is corrupt"
+  assert_eq '{"priv_key":{}}' "$(cat "$(node_key)")" "the node key is left in place"
+}
+
+test_node_key_gate_needs_exact_confirmation() {
+  node_home
+  rm "$(node_key)"
+
+  REGENERATE_NODE_KEY=yes check_node_files
+  assert_eq 1 "$STATUS" "only REGENERATE_NODE_KEY=true confirms"
+
+  REPAIR_ADDRBOOK=true check_node_files
+  assert_eq 1 "$STATUS" "REPAIR_ADDRBOOK does not confirm a new node key"
+}
+
+test_corrupt_node_key_is_moved_aside_when_confirmed() {
+  node_home
+  echo 'garbage' > "$(node_key)"
+
+  REGENERATE_NODE_KEY=true check_node_files
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_contains "$OUTPUT" "will generate a new node key"
+  [ ! -f "$(node_key)" ] || fail "corrupt node key still in place"
+  assert_eq "garbage" "$(cat "$(node_key)".corrupt.*)" "corrupt node key is kept aside"
+}
+
+test_start_does_not_launch_with_a_missing_node_key() {
+  node_home
+  rm "$(node_key)"
+
+  run post-setup/start.sh
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Node key is missing"
+  assert_file_not_contains "$PCHAIND_LOG" "^start" "pchaind must not start"
+}
+
+run_tests
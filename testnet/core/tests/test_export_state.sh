@@ -0,0 +1,85 @@
+#!/bin/bash
+# Tests for post-setup/export_state.sh.
+
+source "$(dirname "${BASH_SOURCE[0]}")/lib.sh"
+
+node_home() {
+  mkdir -p "$APP_DIR/.pchain/config" "$APP_DIR/.pchain/data"
+}
+
+test_exports_the_node_home() {
+  node_home
+
+  run post-setup/export_state.sh --out "$APP_DIR/genesis.json"
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq "export --home $APP_DIR/.pchain --output-document $APP_DIR/genesis.json" "$(cat "$PCHAIND_LOG")"
+  assert_file_contains "$APP_DIR/genesis.json" '"chain_id":"push_42101-1"'
+  assert_contains "$OUTPUT" "State exported to: $APP_DIR/genesis.json"
+}
+
+test_exports_at_a_height_from_another_home() {
+  mkdir -p "$APP_DIR/backup/data"
+
+  run post-setup/export_state.sh --out "$APP_DIR/genesis.json" --height 1200 --home "$APP_DIR/backup"
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq "export --home $APP_DIR/backup --output-document $APP_DIR/genesis.json --height 1200" "$(cat "$PCHAIND_LOG")"
+}
+
+test_refuses_a_home_a_node_is_running_on() {
+  node_home
+
+  RUNNING_PROCESSES="$APP_DIR/binary/pchaind start --home $APP_DIR/.pchain" \
+    run post-setup/export_state.sh --out "$APP_DIR/genesis.json"
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "A node is running on $APP_DIR/.pchain"
+  [ ! -e "$PCHAIND_LOG" ] || fail "export must not run: $(cat "$PCHAIND_LOG")"
+
+  RUNNING_PROCESSES="cosmovisor run start --home=$APP_DIR/.pchain --pruning=nothing" \
+    run post-setup/export_state.sh --out "$APP_DIR/genesis.json"
+  assert_eq 1 "$STATUS" "a cosmovisor node must be detected too"
+}
+
+test_refuses_while_the_service_is_active() {
+  node_home
+
+  ACTIVE_UNITS=pchaind run post-setup/export_state.sh --out "$APP_DIR/genesis.json"
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "A node is running"
+}
+
+test_exports_a_copy_while_the_node_runs() {
+  node_home
+  mkdir -p "$APP_DIR/backup/data"
+
+  RUNNING_PROCESSES="$APP_DIR/binary/pchaind start --home $APP_DIR/.pchain" ACTIVE_UNITS=pchaind \
+    run post-setup/export_state.sh --out "$APP_DIR/genesis.json" --home "$APP_DIR/backup"
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_file_contains "$APP_DIR/genesis.json" '"app_state"'
+}
+
+test_invalid_height_is_refused() {
+  node_home
+  local height
+  for height in 0 -5 12a latest; do
+    run post-setup/export_state.sh --out "$APP_DIR/genesis.json" --height "$height"
+    assert_eq 1 "$STATUS" "--height $height must be refused"
+    assert_contains "$OUTPUT" "--height must be a positive block height"
+  done
+  [ ! -e "$PCHAIND_LOG" ] || fail "export must not run: $(cat "$PCHAIND_LOG")"
+}
+
+test_missing_data_dir_is_refused() {
+  run post-setup/export_state.sh --out "$APP_DIR/genesis.json"
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "No node data found at: $APP_DIR/.pchain/data"
+}
+
+test_out_is_required() {
+  node_home
+
+  run post-setup/export_state.sh --height 10
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Usage:"
+}
+
+run_tests
@@ -0,0 +1,285 @@
+#!/bin/bash
+# Tests for setup/setup_fullnode.sh.
+
+source "$(dirname "${BASH_SOURCE[0]}")/lib.sh"
+
+GENESIS_NODE="https://node1.push.org"
+GENESIS_NODE_ID="aaaa000000000000000000000000000000000000"
+
+# genesis_node serves the /genesis and /status the setup always fetches.
+genesis_node() {
+  fixture "$GENESIS_NODE/genesis" '{"result":{"genesis":{"chain_id":"push_42101-1"}}}'
+  fixture "$GENESIS_NODE/status" '{"result":{"node_info":{"id":"'"$GENESIS_NODE_ID"'"}}}'
+}
+
+setup_fullnode() {
+  run setup/setup_fullnode.sh node1.push.org
+}
+
+config_toml() {
+  echo "$APP_DIR/.pchain/config/config.toml"
+}
+
+app_toml() {
+  echo "$APP_DIR/.pchain/config/app.toml"
+}
+
+test_discovered_peers_are_written() {
+  genesis_node
+  fixture "$GENESIS_NODE/net_info" '{"result":{"peers":[
+    {"remote_ip":"1.2.3.4","node_info":{"id":"bbbb","listen_addr":"tcp://0.0.0.0:26656"}},
+    {"remote_ip":"5.6.7.8","node_info":{"id":"cccc","listen_addr":"tcp://0.0.0.0:36656"}},
+    {"remote_ip":"10.0.0.1","node_info":{"id":"'"$GENESIS_NODE_ID"'","listen_addr":"tcp://0.0.0.0:26656"}},
+    {"remote_ip":"","node_info":{"id":"dddd","listen_addr":"tcp://0.0.0.0:26656"}}]}}'
+
+  setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(config_toml)" \
+    "^persistent_peers = \"$GENESIS_NODE_ID@10.0.0.1:26656,bbbb@1.2.3.4:26656,cccc@5.6.7.8:36656\"$"
+  assert_contains "$OUTPUT" "Discovered 2 peer(s)"
+}
+
+test_discovery_is_capped_at_max_peers() {
+  genesis_node
+  fixture "$GENESIS_NODE/net_info" '{"result":{"peers":[
+    {"remote_ip":"1.2.3.4","node_info":{"id":"bbbb","listen_addr":"tcp://0.0.0.0:26656"}},
+    {"remote_ip":"5.6.7.8","node_info":{"id":"cccc","listen_addr":"tcp://0.0.0.0:26656"}}]}}'
+
+  MAX_PEERS=1 setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(config_toml)" "^persistent_peers = \"$GENESIS_NODE_ID@10.0.0.1:26656,bbbb@1.2.3.4:26656\"$"
+}
+
+test_unreachable_net_info_does_not_abort() {
+  genesis_node
+
+  setup_fullnode
+  assert_eq 0 "$STATUS" "a failed discovery must not abort setup: $OUTPUT"
+  assert_contains "$OUTPUT" "No peers discovered"
+  assert_file_contains "$(config_toml)" "^persistent_peers = \"$GENESIS_NODE_ID@10.0.0.1:26656\"$"
+  assert_contains "$OUTPUT" "Full node setup complete"
+}
+
+test_malformed_net_info_does_not_abort() {
+  genesis_node
+  fixture "$GENESIS_NODE/net_info" '<html>502 Bad Gateway</html>'
+
+  setup_fullnode
+  assert_eq 0 "$STATUS" "a failed discovery must not abort setup: $OUTPUT"
+  assert_file_contains "$(config_toml)" "^persistent_peers = \"$GENESIS_NODE_ID@10.0.0.1:26656\"$"
+}
+
+test_discovery_can_be_disabled() {
+  genesis_node
+  fixture "$GENESIS_NODE/net_info" '{"result":{"peers":[
+    {"remote_ip":"1.2.3.4","node_info":{"id":"bbbb","listen_addr":"tcp://0.0.0.0:26656"}}]}}'
+
+  DISCOVER_PEERS=false setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(config_toml)" "^persistent_peers = \"$GENESIS_NODE_ID@10.0.0.1:26656\"$"
+  assert_file_not_contains "$CURL_LOG" "/net_info" "DISCOVER_PEERS=false must not query net_info"
+}
+
+test_state_sync_uses_trusted_block_below_tip() {
+  genesis_node
+  fixture "$GENESIS_NODE/block" '{"result":{"block":{"header":{"height":"12000"}}}}'
+  fixture "$GENESIS_NODE/block?height=10000" \
+    '{"result":{"block_id":{"hash":"0A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F9"}}}'
+
+  STATE_SYNC=true setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  local statesync
+  statesync=$(sed -n '/^\[statesync\]/,/^\[/p' "$(config_toml)")
+  assert_contains "$statesync" 'enable = true'
+  assert_contains "$statesync" "rpc_servers = \"$GENESIS_NODE,$GENESIS_NODE\""
+  assert_contains "$statesync" 'trust_height = 10000'
+  assert_contains "$statesync" 'trust_hash = "0A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F9"'
+  assert_contains "$statesync" 'trust_period = "168h0m0s"' "other state sync keys are kept"
+}
+
+test_state_sync_honors_trust_offset() {
+  genesis_node
+  fixture "$GENESIS_NODE/block" '{"result":{"block":{"header":{"height":"12000"}}}}'
+  fixture "$GENESIS_NODE/block?height=11500" \
+    '{"result":{"block_id":{"hash":"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF"}}}'
+
+  STATE_SYNC=true TRUST_OFFSET=500 setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(config_toml)" '^trust_height = 11500$'
+}
+
+test_state_sync_is_off_by_default() {
+  genesis_node
+
+  setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(config_toml)" '^enable = false$'
+  assert_file_not_contains "$CURL_LOG" "/block"
+}
+
+test_state_sync_refuses_chain_below_trust_offset() {
+  genesis_node
+  fixture "$GENESIS_NODE/block" '{"result":{"block":{"header":{"height":"1500"}}}}'
+
+  STATE_SYNC=true setup_fullnode
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "latest height '1500'"
+}
+
+test_state_sync_refuses_unreachable_block_endpoint() {
+  genesis_node
+
+  STATE_SYNC=true setup_fullnode
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "latest height 'unknown'"
+}
+
+test_state_sync_refuses_invalid_trust_hash() {
+  genesis_node
+  fixture "$GENESIS_NODE/block" '{"result":{"block":{"header":{"height":"12000"}}}}'
+  fixture "$GENESIS_NODE/block?height=10000" '{"result":{"block_id":{"hash":"not-a-hash"}}}'
+
+  STATE_SYNC=true setup_fullnode
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "no valid block hash at height 10000"
+  assert_file_contains "$(config_toml)" '^enable = false$' "nothing is written on failure"
+}
+
+test_bind_addresses_are_written() {
+  genesis_node
+
+  RPC_BIND=127.0.0.1 RPC=26757 P2P_BIND=10.0.0.5 P2P=26756 GRPC_BIND=127.0.0.1 GRPC=9190 GRPC_WEB=9191 setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_eq 'laddr = "tcp://127.0.0.1:26757"' "$(sed -n '/^\[rpc\]/,/^\[/ s/^laddr = /&/p' "$(config_toml)")"
+  assert_eq 'laddr = "tcp://10.0.0.5:26756"' "$(sed -n '/^\[p2p\]/,/^\[/ s/^laddr = /&/p' "$(config_toml)")"
+  assert_file_contains "$(app_toml)" '^address = "127.0.0.1:9190"$'
+  assert_file_contains "$(app_toml)" '^address = "127.0.0.1:9191"$'
+}
+
+test_bind_addresses_default_to_all_interfaces() {
+  genesis_node
+
+  setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_eq 'laddr = "tcp://0.0.0.0:26657"' "$(sed -n '/^\[rpc\]/,/^\[/ s/^laddr = /&/p' "$(config_toml)")"
+  assert_eq 'laddr = "tcp://0.0.0.0:26656"' "$(sed -n '/^\[p2p\]/,/^\[/ s/^laddr = /&/p' "$(config_toml)")"
+  assert_file_contains "$(app_toml)" '^address = "0.0.0.0:9090"$'
+}
+
+test_setup_marks_the_home_as_migrated() {
+  genesis_node
+
+  PRUNING=default setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  [ -f "$APP_DIR/.pchain/config/.start-flags-migrated" ] || fail "setup must write the start flag marker"
+
+  # A later start must keep what setup wrote, whatever .env says.
+  PRUNING=everything bash -c 'source "$1/post-setup/migrate_start_flags.sh" && migrate_start_flags "$1/.pchain"' _ "$APP_DIR" > /dev/null
+  assert_file_contains "$(app_toml)" '^pruning = "default"$'
+}
+
+test_pruning_defaults_to_nothing() {
+  genesis_node
+
+  setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(app_toml)" '^pruning = "nothing"$'
+}
+
+test_pruning_modes_are_written() {
+  local mode before
+  for mode in default nothing everything; do
+    before=$FAILURES
+    (
+      rm -rf "$APP_DIR/.pchain"
+      genesis_node
+      PRUNING=$mode setup_fullnode
+      assert_eq 0 "$STATUS" "PRUNING=$mode failed: $OUTPUT"
+      assert_file_contains "$(app_toml)" "^pruning = \"$mode\"$"
+      assert_file_contains "$(app_toml)" '^pruning-keep-recent = "0"$'
+      [ "$FAILURES" -eq "$before" ]
+    ) || fail "PRUNING=$mode"
+  done
+}
+
+test_custom_pruning_writes_its_settings() {
+  genesis_node
+
+  PRUNING=custom PRUNING_KEEP_RECENT=100 PRUNING_INTERVAL=10 setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(app_toml)" '^pruning = "custom"$'
+  assert_file_contains "$(app_toml)" '^pruning-keep-recent = "100"$'
+  assert_file_contains "$(app_toml)" '^pruning-interval = "10"$'
+}
+
+test_custom_pruning_needs_its_settings() {
+  genesis_node
+
+  PRUNING=custom PRUNING_KEEP_RECENT=100 setup_fullnode
+  assert_eq 1 "$STATUS" "a missing interval must be refused"
+  assert_contains "$OUTPUT" "PRUNING=custom needs PRUNING_KEEP_RECENT"
+
+  PRUNING=custom PRUNING_KEEP_RECENT=100 PRUNING_INTERVAL=0 setup_fullnode
+  assert_eq 1 "$STATUS" "a zero interval must be refused"
+
+  PRUNING=custom PRUNING_KEEP_RECENT=-1 PRUNING_INTERVAL=10 setup_fullnode
+  assert_eq 1 "$STATUS" "a negative keep-recent must be refused"
+  [ ! -e "$(app_toml)" ] || fail "a refused setup must not initialize the home"
+}
+
+test_pruning_settings_need_custom_mode() {
+  genesis_node
+
+  PRUNING=everything PRUNING_KEEP_RECENT=100 setup_fullnode
+  assert_eq 1 "$STATUS" "keep-recent without custom must be refused"
+  assert_contains "$OUTPUT" "only apply to PRUNING=custom"
+
+  PRUNING_INTERVAL=10 setup_fullnode
+  assert_eq 1 "$STATUS" "an interval with the default mode must be refused"
+}
+
+test_unknown_pruning_mode_is_refused() {
+  genesis_node
+
+  PRUNING=sometimes setup_fullnode
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Unknown PRUNING=sometimes"
+  [ ! -e "$(app_toml)" ] || fail "a refused setup must not initialize the home"
+}
+
+test_min_gas_prices_default_is_written() {
+  genesis_node
+
+  setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(app_toml)" '^minimum-gas-prices = "1000000000upc"$'
+}
+
+test_custom_min_gas_prices_are_written() {
+  genesis_node
+
+  MIN_GAS_PRICES=2500000000.5upc setup_fullnode
+  assert_eq 0 "$STATUS" "setup failed: $OUTPUT"
+  assert_file_contains "$(app_toml)" '^minimum-gas-prices = "2500000000.5upc"$'
+}
+
+test_min_gas_prices_in_another_denom_are_refused() {
+  genesis_node
+
+  MIN_GAS_PRICES=1000uatom setup_fullnode
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "denom 'uatom' does not match chain denom 'upc'"
+  [ ! -e "$(app_toml)" ] || fail "a refused setup must not initialize the home"
+}
+
+test_malformed_min_gas_prices_are_refused() {
+  genesis_node
+  local value
+  for value in upc 1000 "1,000upc" "-5upc" "1.upc"; do
+    MIN_GAS_PRICES=$value setup_fullnode
+    assert_eq 1 "$STATUS" "MIN_GAS_PRICES=$value must be refused"
+    assert_contains "$OUTPUT" "Invalid MIN_GAS_PRICES=$value"
+  done
+}
+
+run_tests
@@ -0,0 +1,173 @@
+#!/bin/bash
+# Tests for post-setup/show_logs.sh.
+
+source "$(dirname "${BASH_SOURCE[0]}")/lib.sh"
+
+log_file() {
+  echo "$APP_DIR/logs/pchaind.log"
+}
+
+follow_out() {
+  echo "$APP_DIR/follow.out"
+}
+
+# follow_logs [args...] starts show_logs.sh in the background. tail's own
+# notices go to stderr and are kept out of follow_out.
+follow_logs() {
+  mkdir -p "$APP_DIR/logs"
+  touch "$(log_file)"
+  timeout 20 bash "$APP_DIR/post-setup/show_logs.sh" "$@" > "$(follow_out)" 2> "$APP_DIR/follow.err" < /dev/null &
+  FOLLOW_PID=$!
+}
+
+stop_following() {
+  kill "$FOLLOW_PID" 2>/dev/null
+  wait "$FOLLOW_PID" 2>/dev/null
+}
+
+# wait_for_line <text> waits up to 10s for show_logs.sh to print text; tail
+# falls back to polling once a second where inotify is unavailable.
+wait_for_line() {
+  local i
+  for i in $(seq 100); do
+    grep -qF -- "$1" "$(follow_out)" && return 0
+    sleep 0.1
+  done
+  fail "show_logs.sh did not print '$1'; got: $(cat "$(follow_out)")"
+}
+
+test_follows_appended_lines() {
+  follow_logs
+  echo "INF first" >> "$(log_file)"
+  wait_for_line "INF first"
+  echo "INF second" >> "$(log_file)"
+  wait_for_line "INF second"
+  stop_following
+}
+
+test_follows_across_rotation() {
+  follow_logs
+  echo "INF before rotation" >> "$(log_file)"
+  wait_for_line "INF before rotation"
+
+  mv "$(log_file)" "$(log_file).1"
+  echo "INF after rotation" > "$(log_file)"
+  wait_for_line "INF after rotation"
+  echo "INF still following" >> "$(log_file)"
+  wait_for_line "INF still following"
+  stop_following
+}
+
+test_follows_across_truncation() {
+  follow_logs
+  printf 'INF a long line written before the log is truncated\nINF another one\n' >> "$(log_file)"
+  wait_for_line "INF another one"
+
+  : > "$(log_file)"
+  sleep 0.5
+  echo "INF short" >> "$(log_file)"
+  wait_for_line "INF short"
+  stop_following
+}
+
+test_filtered_output_follows_rotation() {
+  follow_logs --level error
+  echo "ERR before rotation" >> "$(log_file)"
+  wait_for_line "ERR before rotation"
+
+  mv "$(log_file)" "$(log_file).1"
+  printf 'INF dropped\nERR after rotation\n' > "$(log_file)"
+  wait_for_line "ERR after rotation"
+  stop_following
+  assert_file_not_contains "$(follow_out)" "INF dropped"
+}
+
+# sample_log writes console and JSON lines at every level, one of them
+# colored as pchaind prints to a terminal.
+sample_log() {
+  mkdir -p "$APP_DIR/logs"
+  printf '%b\n' \
+    '3:04PM DBG dialing peer module=p2p' \
+    '3:04PM INF committed state height=10' \
+    '3:04PM WRN peer timeout module=p2p' \
+    '3:04PM ERR failed to sign err=timeout' \
+    '\e[90m3:04PM\e[0m \e[31mERR\e[0m colored error' \
+    '{"level":"debug","message":"json debug"}' \
+    '{"level":"info","message":"json info peer"}' \
+    '{"level":"warn","message":"json warn"}' \
+    '{"level":"error","message":"json error"}' > "$(log_file)"
+}
+
+# show_filtered [args...] runs show_logs.sh over sample_log and sets
+# FILTERED to what it printed.
+show_filtered() {
+  sample_log
+  follow_logs "$@"
+  sleep 1
+  stop_following
+  FILTERED=$(cat "$(follow_out)")
+}
+
+test_level_warn_keeps_warnings_and_errors() {
+  show_filtered --level warn
+  assert_contains "$FILTERED" "WRN peer timeout"
+  assert_contains "$FILTERED" "ERR failed to sign"
+  assert_contains "$FILTERED" "ERR colored error"
+  assert_contains "$FILTERED" '"message":"json warn"'
+  assert_contains "$FILTERED" '"message":"json error"'
+  assert_not_contains "$FILTERED" "INF committed"
+  assert_not_contains "$FILTERED" "DBG dialing"
+  assert_not_contains "$FILTERED" "json info"
+  assert_not_contains "$FILTERED" "json debug"
+}
+
+test_level_error_keeps_errors_only() {
+  show_filtered --level error
+  assert_eq 3 "$(echo "$FILTERED" | grep -c .)" "expected 3 error lines, got: $FILTERED"
+  assert_contains "$FILTERED" "ERR failed to sign"
+  assert_contains "$FILTERED" "ERR colored error"
+  assert_contains "$FILTERED" '"message":"json error"'
+}
+
+test_level_info_drops_debug() {
+  show_filtered --level info
+  assert_contains "$FILTERED" "INF committed"
+  assert_contains "$FILTERED" "json info"
+  assert_not_contains "$FILTERED" "DBG dialing"
+  assert_not_contains "$FILTERED" "json debug"
+}
+
+test_grep_keeps_matching_lines() {
+  show_filtered --grep 'peer|timeout'
+  assert_eq 4 "$(echo "$FILTERED" | grep -c .)" "expected 4 matching lines, got: $FILTERED"
+  assert_contains "$FILTERED" "DBG dialing peer"
+  assert_contains "$FILTERED" "WRN peer timeout"
+  assert_contains "$FILTERED" "err=timeout"
+  assert_contains "$FILTERED" "json info peer"
+}
+
+test_level_and_grep_combine() {
+  show_filtered --level warn --grep peer
+  assert_eq "3:04PM WRN peer timeout module=p2p" "$FILTERED"
+}
+
+test_filtering_strips_color_codes() {
+  show_filtered --grep colored
+  assert_eq "3:04PM ERR colored error" "$FILTERED"
+}
+
+test_unknown_level_is_rejected() {
+  sample_log
+  run post-setup/show_logs.sh --level loud
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Unknown level: loud"
+}
+
+test_invalid_regex_is_rejected() {
+  sample_log
+  run post-setup/show_logs.sh --grep '(peer'
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Invalid --grep regex"
+}
+
+run_tests
@@ -0,0 +1,72 @@
+#!/bin/bash
+# Tests for post-setup/start.sh and post-setup/migrate_start_flags.sh.
+
+source "$(dirname "${BASH_SOURCE[0]}")/lib.sh"
+
+# old_home writes a home set up before the start flags moved into the
+# config files: default pruning, no gas price, and the "claddr" key the old
+# genesis setup left in [rpc].
+old_home() {
+  local config="$APP_DIR/.pchain/config"
+  mkdir -p "$config" "$APP_DIR/.pchain/data"
+  printf '[rpc]\ncladdr = "tcp://0.0.0.0:26657"\npprof_laddr = "localhost:6060"\n\n[p2p]\nladdr = "tcp://0.0.0.0:26656"\n' \
+    > "$config/config.toml"
+  printf 'minimum-gas-prices = ""\npruning = "default"\npruning-keep-recent = "0"\n' > "$config/app.toml"
+  echo '{"priv_key":{"value":"c2VjcmV0"}}' > "$config/node_key.json"
+}
+
+migrate() {
+  bash -c 'source "$1/post-setup/migrate_start_flags.sh" && migrate_start_flags "$1/.pchain"' _ "$APP_DIR" > /dev/null
+}
+
+rpc_laddr() {
+  sed -n '/^\[rpc\]/,/^\[/ s/^c\?laddr = //p' "$APP_DIR/.pchain/config/config.toml"
+}
+
+test_old_home_gets_the_old_flag_values() {
+  old_home
+
+  migrate
+  assert_file_contains "$APP_DIR/.pchain/config/app.toml" '^pruning = "nothing"$'
+  assert_file_contains "$APP_DIR/.pchain/config/app.toml" '^minimum-gas-prices = "1000000000upc"$'
+  assert_eq '"tcp://0.0.0.0:26657"' "$(rpc_laddr)"
+  assert_file_not_contains "$APP_DIR/.pchain/config/config.toml" '^claddr' "the broken key is repaired"
+  assert_file_contains "$APP_DIR/.pchain/config/config.toml" '^pprof_laddr = "localhost:6060"$'
+  assert_file_contains "$APP_DIR/.pchain/config/config.toml" '^laddr = "tcp://0.0.0.0:26656"$'
+}
+
+test_old_home_takes_values_from_the_environment() {
+  old_home
+
+  PRUNING=everything MIN_GAS_PRICES=5upc RPC_BIND=127.0.0.1 RPC=26757 migrate
+  assert_file_contains "$APP_DIR/.pchain/config/app.toml" '^pruning = "everything"$'
+  assert_file_contains "$APP_DIR/.pchain/config/app.toml" '^minimum-gas-prices = "5upc"$'
+  assert_eq '"tcp://127.0.0.1:26757"' "$(rpc_laddr)"
+}
+
+test_migration_runs_once() {
+  old_home
+  migrate
+  sed -i 's/^pruning = .*/pruning = "default"/' "$APP_DIR/.pchain/config/app.toml"
+
+  PRUNING=everything migrate
+  assert_file_contains "$APP_DIR/.pchain/config/app.toml" '^pruning = "default"$' "edits after the migration are kept"
+}
+
+test_start_migrates_and_passes_no_config_flags() {
+  old_home
+  echo "PRUNING=everything" > "$APP_DIR/.env"
+
+  run post-setup/start.sh
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_file_contains "$APP_DIR/.pchain/config/app.toml" '^pruning = "everything"$'
+  local i
+  for i in $(seq 50); do
+    grep -q '^start' "$PCHAIND_LOG" 2>/dev/null && break
+    sleep 0.1
+  done
+  assert_file_contains "$PCHAIND_LOG" "^start .*--home $APP_DIR/.pchain|^start .*--home=$APP_DIR/.pchain"
+  assert_file_not_contains "$PCHAIND_LOG" "--pruning|--minimum-gas-prices|--rpc.laddr"
+}
+
+run_tests
@@ -0,0 +1,233 @@
+#!/bin/bash
+# Tests for post-setup/sync_status.sh.
+
+source "$(dirname "${BASH_SOURCE[0]}")/lib.sh"
+
+RPC="http://localhost:26657"
+REST="http://localhost:1317"
+CONS_PUBKEY="Q29uc2Vuc3VzS2V5"
+
+# local_node serves /status, /abci_info and /net_info for a synced node;
+# consensus_key is its validator pubkey (defaults to CONS_PUBKEY).
+local_node() {
+  local rpc="${1:-$RPC}" consensus_key="${2:-$CONS_PUBKEY}"
+  fixture "$rpc/status" '{"result":{
+    "node_info":{"network":"push_42101-1","moniker":"node","id":"aaaa"},
+    "sync_info":{"latest_block_height":"12345","latest_block_time":"2026-10-17T03:04:05Z","catching_up":false},
+    "validator_info":{"pub_key":{"value":"'"$consensus_key"'"},"voting_power":"100"}}}'
+  fixture "$rpc/abci_info" '{"result":{"response":{"version":"v1.2.3"}}}'
+  fixture "$rpc/net_info" '{"result":{"n_peers":"7"}}'
+}
+
+# staking_set serves a validator set containing CONS_PUBKEY.
+staking_set() {
+  fixture "$REST/cosmos/staking/v1beta1/validators?pagination.limit=1000" '{"validators":[
+    {"operator_address":"pushvaloper1other","jailed":true,"status":"BOND_STATUS_UNBONDED","consensus_pubkey":{"key":"T3RoZXI="}},
+    {"operator_address":"pushvaloper1node","jailed":false,"status":"BOND_STATUS_BONDED","consensus_pubkey":{"key":"'"$CONS_PUBKEY"'"}}]}'
+}
+
+EVM="http://localhost:8545"
+
+# evm_node serves eth_chainId (default 0xa475, i.e. 42101) and
+# eth_blockNumber (0x3039, i.e. 12345) on the EVM JSON-RPC.
+evm_node() {
+  fixture "$EVM" eth_chainId '{"jsonrpc":"2.0","id":1,"result":"'"${1:-0xa475}"'"}'
+  fixture "$EVM" eth_blockNumber '{"jsonrpc":"2.0","id":1,"result":"0x3039"}'
+}
+
+# field <jq filter> reads a field from the JSON in OUTPUT.
+field() {
+  echo "$OUTPUT" | jq -c "$1"
+}
+
+test_json_reports_the_basic_status() {
+  local_node
+
+  run post-setup/sync_status.sh --json
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq true "$(field .rpc_reachable)"
+  assert_eq '"push_42101-1"' "$(field .chain_id)"
+  assert_eq '"node"' "$(field .moniker)"
+  assert_eq '"aaaa"' "$(field .node_id)"
+  assert_eq '"v1.2.3"' "$(field .app_version)"
+  assert_eq 12345 "$(field .latest_block_height)"
+  assert_eq false "$(field .catching_up)"
+}
+
+test_json_omits_full_fields_and_their_queries() {
+  local_node
+  staking_set
+
+  run post-setup/sync_status.sh --json
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq '[]' "$(field '[keys[] | select(IN("latest_block_time","peers","voting_power","is_validator","jailed","validator","evm"))]')"
+  assert_file_not_contains "$CURL_LOG" "/net_info" "--json alone must not query net_info"
+  assert_file_not_contains "$CURL_LOG" "/cosmos/staking" "--json alone must not query staking"
+  assert_file_not_contains "$CURL_LOG" ":8545" "--json alone must not query the EVM RPC"
+}
+
+test_full_json_adds_peers_block_time_and_validator_state() {
+  local_node
+  staking_set
+
+  run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq '"2026-10-17T03:04:05Z"' "$(field .latest_block_time)"
+  assert_eq 7 "$(field .peers)"
+  assert_eq 100 "$(field .voting_power)"
+  assert_eq true "$(field .is_validator)"
+  assert_eq false "$(field .jailed)"
+  assert_eq '{"operator_address":"pushvaloper1node","jailed":false,"status":"BOND_STATUS_BONDED"}' "$(field .validator)"
+}
+
+test_full_json_reports_a_jailed_validator() {
+  local_node "$RPC" "T3RoZXI="
+  staking_set
+
+  run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq true "$(field .is_validator)"
+  assert_eq true "$(field .jailed)"
+  assert_eq '"pushvaloper1other"' "$(field .validator.operator_address)"
+}
+
+test_full_json_for_a_node_outside_the_validator_set() {
+  local_node "$RPC" "Tm90QVZhbGlkYXRvcg=="
+  staking_set
+
+  run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq false "$(field .is_validator)"
+  assert_eq null "$(field .jailed)"
+  assert_eq null "$(field .validator)"
+}
+
+test_full_json_without_staking_api() {
+  local_node
+
+  run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq 7 "$(field .peers)"
+  assert_eq false "$(field .is_validator)"
+}
+
+test_json_reports_an_unreachable_rpc() {
+  run post-setup/sync_status.sh --json
+  assert_eq 1 "$STATUS"
+  assert_eq false "$(field .rpc_reachable)"
+  assert_eq "\"$RPC\"" "$(field .rpc_url)"
+}
+
+test_unknown_flag_is_rejected() {
+  run post-setup/sync_status.sh --yaml
+  assert_eq 1 "$STATUS"
+  assert_contains "$OUTPUT" "Usage:"
+}
+
+# rpc_laddr writes a config.toml whose [rpc] section listens on addr.
+rpc_laddr() {
+  mkdir -p "$APP_DIR/.pchain/config"
+  printf '[rpc]\nladdr = "tcp://%s"\n\n[p2p]\nladdr = "tcp://0.0.0.0:26656"\n' "$1" > "$APP_DIR/.pchain/config/config.toml"
+}
+
+test_probes_the_configured_rpc_address() {
+  rpc_laddr 127.0.0.1:26757
+  local_node http://127.0.0.1:26757
+
+  run post-setup/sync_status.sh --json
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq 12345 "$(field .latest_block_height)"
+  assert_file_contains "$CURL_LOG" '^http://127.0.0.1:26757/status$'
+  assert_file_not_contains "$CURL_LOG" ':26657/'
+}
+
+test_probes_loopback_for_an_all_interfaces_bind() {
+  rpc_laddr 0.0.0.0:26758
+  local_node http://127.0.0.1:26758
+
+  run post-setup/sync_status.sh --json
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_file_contains "$CURL_LOG" '^http://127.0.0.1:26758/status$'
+}
+
+test_rpc_url_overrides_the_config() {
+  rpc_laddr 127.0.0.1:26757
+  local_node http://10.0.0.9:26657
+
+  RPC_URL=http://10.0.0.9:26657 run post-setup/sync_status.sh --json
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_file_not_contains "$CURL_LOG" ':26757/'
+}
+
+test_full_json_reports_a_matching_evm_rpc() {
+  local_node
+  evm_node
+
+  run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq '{"rpc_url":"http://localhost:8545","reachable":true,"chain_id":42101,"expected_chain_id":42101,"chain_id_match":true,"block_number":12345}' "$(field .evm)"
+  assert_file_contains "$CURL_LOG" "^$EVM eth_chainId$"
+  assert_file_contains "$CURL_LOG" "^$EVM eth_blockNumber$"
+}
+
+test_full_json_reports_an_evm_chain_id_mismatch() {
+  local_node
+  evm_node 0x1
+
+  run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq true "$(field .evm.reachable)"
+  assert_eq 1 "$(field .evm.chain_id)"
+  assert_eq 42101 "$(field .evm.expected_chain_id)"
+  assert_eq false "$(field .evm.chain_id_match)"
+}
+
+test_full_json_reports_an_unreachable_evm_rpc() {
+  local_node
+
+  run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq '{"rpc_url":"http://localhost:8545","reachable":false,"chain_id":null,"expected_chain_id":42101,"chain_id_match":false,"block_number":null}' "$(field .evm)"
+}
+
+test_evm_chain_id_and_url_overrides() {
+  local_node
+  EVM="http://10.0.0.9:8545" evm_node 0x1
+
+  EVM_RPC_URL=http://10.0.0.9:8545 EVM_CHAIN_ID=1 run post-setup/sync_status.sh --json --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_eq '"http://10.0.0.9:8545"' "$(field .evm.rpc_url)"
+  assert_eq 1 "$(field .evm.expected_chain_id)"
+  assert_eq true "$(field .evm.chain_id_match)"
+}
+
+test_full_text_output_reports_the_evm_rpc() {
+  local_node
+  evm_node
+
+  run post-setup/sync_status.sh --full
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_contains "$OUTPUT" "EVM RPC        : http://localhost:8545 (block 12345)"
+  assert_contains "$OUTPUT" "EVM Chain ID   : 42101"
+  assert_not_contains "$OUTPUT" "expected"
+
+  evm_node 0x1
+  run post-setup/sync_status.sh --full
+  assert_contains "$OUTPUT" "EVM Chain ID   : 1 ⚠️  expected 42101"
+
+  rm "$FIXTURES"/*8545*
+  run post-setup/sync_status.sh --full
+  assert_contains "$OUTPUT" "EVM RPC        : ❌ unreachable at http://localhost:8545"
+}
+
+test_text_output_skips_the_evm_rpc_without_full() {
+  local_node
+  evm_node
+
+  run post-setup/sync_status.sh
+  assert_eq 0 "$STATUS" "$OUTPUT"
+  assert_not_contains "$OUTPUT" "EVM"
+  assert_file_not_contains "$CURL_LOG" ":8545"
+}
+
+run_tests
@@ -1,6 +1,23 @@
 package api
 
-import "net/http"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
+)
+
+// refreshChainsTimeout bounds a forced chain refresh so the response is
+// written within the server's WriteTimeout.
+const refreshChainsTimeout = 8 * time.Second
+
+// rejectHeldTimeout bounds the failure vote of a rejected held outbound the
+// same way.
+const rejectHeldTimeout = 8 * time.Second
 
 // handleHealth handles GET /health
 func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
@@ -10,3 +27,114 @@ func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
 		s.logger.Error().Err(err).Msg("Failed to write health response")
 	}
 }
+
+// loopbackOnly rejects requests that do not come from the local host. The
+// server listens on all interfaces for /health and /metrics; admin routes
+// are only for operators on the node itself.
+func (s *Server) loopbackOnly(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if ip := net.ParseIP(host); err != nil || ip == nil || !ip.IsLoopback() {
+			s.logger.Warn().Str("remote_addr", r.RemoteAddr).Str("path", r.URL.Path).
+				Msg("Rejected non-local admin request")
+			http.Error(w, "admin endpoints are only served to localhost", http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// handleRefreshChains handles POST /admin/chains/refresh
+func (s *Server) handleRefreshChains(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	if s.refreshChains == nil {
+		http.Error(w, "chain refresh not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), refreshChainsTimeout)
+	defer cancel()
+	if err := s.refreshChains(ctx); err != nil {
+		s.logger.Warn().Err(err).Msg("Forced chain refresh failed")
+		http.Error(w, "chain refresh failed: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte("OK")); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to write refresh response")
+	}
+}
+
+// handleListHeldOutbounds handles GET /admin/outbounds/held
+func (s *Server) handleListHeldOutbounds(w http.ResponseWriter, r *http.Request) {
+	if s.heldOutbounds == nil {
+		http.Error(w, "held outbound review not available", http.StatusServiceUnavailable)
+		return
+	}
+	held, err := s.heldOutbounds.HeldOutbounds()
+	if err != nil {
+		http.Error(w, "failed to list held outbounds: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(held); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to write held outbounds response")
+	}
+}
+
+// handleReleaseHeldOutbound handles POST /admin/outbounds/held/{id}/release
+func (s *Server) handleReleaseHeldOutbound(w http.ResponseWriter, r *http.Request) {
+	s.reviewHeldOutbound(w, r, "release", func(_ context.Context, id string) error {
+		return s.heldOutbounds.ReleaseHeldOutbound(id)
+	})
+}
+
+// handleRejectHeldOutbound handles POST /admin/outbounds/held/{id}/reject
+func (s *Server) handleRejectHeldOutbound(w http.ResponseWriter, r *http.Request) {
+	s.reviewHeldOutbound(w, r, "reject", func(ctx context.Context, id string) error {
+		return s.heldOutbounds.RejectHeldOutbound(ctx, id)
+	})
+}
+
+// reviewHeldOutbound applies a release or reject decision to the held
+// outbound named in the path.
+func (s *Server) reviewHeldOutbound(w http.ResponseWriter, r *http.Request, action string, apply func(ctx context.Context, id string) error) {
+	w.Header().Set("Content-Type", "text/plain")
+	if s.heldOutbounds == nil {
+		http.Error(w, "held outbound review not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	id := r.PathValue("id")
+	ctx, cancel := context.WithTimeout(r.Context(), rejectHeldTimeout)
+	defer cancel()
+	if err := apply(ctx, id); err != nil {
+		if errors.Is(err, eventstore.ErrNotHeld) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		s.logger.Warn().Err(err).Str("event_id", id).Msg("Failed to " + action + " held outbound")
+		http.Error(w, action+" failed: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.logger.Info().Str("event_id", id).Str("action", action).Msg("Held outbound reviewed")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte("OK")); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to write review response")
+	}
+}
+
+// handleLeaveState handles GET /admin/tss/leave
+func (s *Server) handleLeaveState(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	if s.leaveState == nil {
+		http.Error(w, "TSS leave state not available", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(s.leaveState())); err != nil {
+		s.logger.Error().Err(err).Msg("Failed to write leave state response")
+	}
+}
@@ -1,12 +1,20 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 )
 
 func TestHandleHealth(t *testing.T) {
@@ -26,3 +34,115 @@ func TestHandleHealth(t *testing.T) {
 		assert.Equal(t, "text/plain", w.Header().Get("Content-Type"))
 	})
 }
+
+func TestHandleRefreshChains(t *testing.T) {
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+
+	t.Run("Refresh runs and returns OK", func(t *testing.T) {
+		server := &Server{logger: logger}
+		calls := 0
+		server.SetChainRefresher(func(ctx context.Context) error {
+			calls++
+			return nil
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/chains/refresh", nil)
+		w := httptest.NewRecorder()
+		server.handleRefreshChains(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "OK", w.Body.String())
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Refresh failure returns 500", func(t *testing.T) {
+		server := &Server{logger: logger}
+		server.SetChainRefresher(func(ctx context.Context) error {
+			return errors.New("registry unreachable")
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/chains/refresh", nil)
+		w := httptest.NewRecorder()
+		server.handleRefreshChains(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), "registry unreachable")
+	})
+
+	t.Run("No refresher returns 503", func(t *testing.T) {
+		server := &Server{logger: logger}
+
+		req := httptest.NewRequest(http.MethodPost, "/admin/chains/refresh", nil)
+		w := httptest.NewRecorder()
+		server.handleRefreshChains(w, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+}
+
+// fakeHeldOutbounds keeps held outbounds in memory; a reject error fails
+// every reject.
+type fakeHeldOutbounds struct {
+	held      map[string]bool
+	rejectErr error
+}
+
+func (f *fakeHeldOutbounds) HeldOutbounds() ([]uexecutortypes.OutboundCreatedEvent, error) {
+	var out []uexecutortypes.OutboundCreatedEvent
+	for id := range f.held {
+		out = append(out, uexecutortypes.OutboundCreatedEvent{TxID: id})
+	}
+	return out, nil
+}
+
+func (f *fakeHeldOutbounds) ReleaseHeldOutbound(id string) error {
+	if !f.held[id] {
+		return fmt.Errorf("event %s: %w", id, eventstore.ErrNotHeld)
+	}
+	delete(f.held, id)
+	return nil
+}
+
+func (f *fakeHeldOutbounds) RejectHeldOutbound(ctx context.Context, id string) error {
+	if f.rejectErr != nil {
+		return f.rejectErr
+	}
+	return f.ReleaseHeldOutbound(id)
+}
+
+func TestHeldOutboundRoutes(t *testing.T) {
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+	do := func(mux *http.ServeMux, method, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = "127.0.0.1:40000"
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, req)
+		return w
+	}
+
+	t.Run("lists, releases and rejects held outbounds", func(t *testing.T) {
+		server := &Server{logger: logger}
+		server.SetHeldOutbounds(&fakeHeldOutbounds{held: map[string]bool{"0xa": true, "0xb": true}})
+		mux := server.setupRoutes()
+
+		w := do(mux, http.MethodGet, "/admin/outbounds/held")
+		require.Equal(t, http.StatusOK, w.Code)
+		var held []uexecutortypes.OutboundCreatedEvent
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &held))
+		assert.Len(t, held, 2)
+
+		assert.Equal(t, http.StatusOK, do(mux, http.MethodPost, "/admin/outbounds/held/0xa/release").Code)
+		assert.Equal(t, http.StatusOK, do(mux, http.MethodPost, "/admin/outbounds/held/0xb/reject").Code)
+		assert.Equal(t, http.StatusNotFound, do(mux, http.MethodPost, "/admin/outbounds/held/0xa/release").Code, "no longer held")
+	})
+
+	t.Run("failed reject vote returns 500", func(t *testing.T) {
+		server := &Server{logger: logger}
+		server.SetHeldOutbounds(&fakeHeldOutbounds{held: map[string]bool{"0xa": true}, rejectErr: errors.New("vote failed")})
+		mux := server.setupRoutes()
+
+		w := do(mux, http.MethodPost, "/admin/outbounds/held/0xa/reject")
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Contains(t, w.Body.String(), "vote failed")
+	})
+}
@@ -1,6 +1,11 @@
 package api
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
 
 // setupRoutes configures all HTTP routes for the API server
 func (s *Server) setupRoutes() *http.ServeMux {
@@ -9,5 +14,23 @@ func (s *Server) setupRoutes() *http.ServeMux {
 	// Health check endpoint — GET only; other methods return 405 Method Not Allowed.
 	mux.HandleFunc("GET /health", s.handleHealth)
 
+	// Admin: re-fetch chain configs from Push Chain now instead of at the next poll.
+	// Admin routes only answer requests from the local host.
+	mux.HandleFunc("POST /admin/chains/refresh", s.loopbackOnly(s.handleRefreshChains))
+
+	// Admin: review outbounds held above their asset's max outbound amount.
+	mux.HandleFunc("GET /admin/outbounds/held", s.loopbackOnly(s.handleListHeldOutbounds))
+	mux.HandleFunc("POST /admin/outbounds/held/{id}/release", s.loopbackOnly(s.handleReleaseHeldOutbound))
+	mux.HandleFunc("POST /admin/outbounds/held/{id}/reject", s.loopbackOnly(s.handleRejectHeldOutbound))
+
+	// Admin: graceful leave progress, to know when the node can be shut down.
+	mux.HandleFunc("GET /admin/tss/leave", s.loopbackOnly(s.handleLeaveState))
+
+	// Prometheus metrics registered via Register.
+	if s.registry == nil {
+		s.registry = prometheus.NewRegistry()
+	}
+	mux.Handle("GET /metrics", promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{}))
+
 	return mux
 }
@@ -21,6 +21,7 @@ func TestSetupRoutes(t *testing.T) {
 		name           string
 		method         string
 		path           string
+		remoteAddr     string
 		expectedStatus int
 	}{
 		{
@@ -53,6 +54,73 @@ func TestSetupRoutes(t *testing.T) {
 			path:           "/health",
 			expectedStatus: http.StatusMethodNotAllowed,
 		},
+		{
+			name:           "GET /metrics is allowed",
+			method:         http.MethodGet,
+			path:           "/metrics",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "POST /metrics is rejected",
+			method:         http.MethodPost,
+			path:           "/metrics",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "POST /admin/chains/refresh without a refresher is unavailable",
+			method:         http.MethodPost,
+			path:           "/admin/chains/refresh",
+			remoteAddr:     "127.0.0.1:40000",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "POST /admin/chains/refresh over IPv6 loopback is served",
+			method:         http.MethodPost,
+			path:           "/admin/chains/refresh",
+			remoteAddr:     "[::1]:40000",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "POST /admin/chains/refresh from a remote host is forbidden",
+			method:         http.MethodPost,
+			path:           "/admin/chains/refresh",
+			remoteAddr:     "203.0.113.7:40000",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "GET /admin/chains/refresh is rejected",
+			method:         http.MethodGet,
+			path:           "/admin/chains/refresh",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:           "POST /admin/outbounds/held/{id}/release from a remote host is forbidden",
+			method:         http.MethodPost,
+			path:           "/admin/outbounds/held/0xabc/release",
+			remoteAddr:     "203.0.113.7:40000",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "GET /admin/outbounds/held without a reviewer is unavailable",
+			method:         http.MethodGet,
+			path:           "/admin/outbounds/held",
+			remoteAddr:     "127.0.0.1:40000",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "GET /admin/tss/leave without a TSS node is unavailable",
+			method:         http.MethodGet,
+			path:           "/admin/tss/leave",
+			remoteAddr:     "127.0.0.1:40000",
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "GET /admin/tss/leave from a remote host is forbidden",
+			method:         http.MethodGet,
+			path:           "/admin/tss/leave",
+			remoteAddr:     "203.0.113.7:40000",
+			expectedStatus: http.StatusForbidden,
+		},
 		{
 			name:           "Non-existent endpoint returns 404",
 			method:         http.MethodGet,
@@ -64,6 +132,9 @@ func TestSetupRoutes(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.remoteAddr != "" {
+				req.RemoteAddr = tc.remoteAddr
+			}
 			w := httptest.NewRecorder()
 
 			mux.ServeHTTP(w, req)
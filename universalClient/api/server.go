@@ -7,7 +7,10 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
+
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 )
 
 // Server provides HTTP endpoints
@@ -15,12 +18,26 @@ type Server struct {
 	logger   zerolog.Logger
 	server   *http.Server
 	listener net.Listener
+	registry *prometheus.Registry // gauges served on /metrics
+
+	refreshChains func(ctx context.Context) error // backs POST /admin/chains/refresh
+	heldOutbounds HeldOutbounds                   // backs /admin/outbounds/held
+	leaveState    func() string                   // backs GET /admin/tss/leave
+}
+
+// HeldOutbounds lists, releases and rejects outbounds held for manual review
+// above their asset's max outbound amount (*tss.Node).
+type HeldOutbounds interface {
+	HeldOutbounds() ([]uexecutortypes.OutboundCreatedEvent, error)
+	ReleaseHeldOutbound(eventID string) error
+	RejectHeldOutbound(ctx context.Context, eventID string) error
 }
 
 // NewServer creates a new Server instance
 func NewServer(logger zerolog.Logger, port int) *Server {
 	s := &Server{
-		logger: logger,
+		logger:   logger,
+		registry: prometheus.NewRegistry(),
 	}
 
 	mux := s.setupRoutes()
@@ -37,6 +54,34 @@ func NewServer(logger zerolog.Logger, port int) *Server {
 	return s
 }
 
+// Register adds collectors to those served on /metrics.
+func (s *Server) Register(collectors ...prometheus.Collector) error {
+	for _, c := range collectors {
+		if err := s.registry.Register(c); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+	return nil
+}
+
+// SetChainRefresher sets the function POST /admin/chains/refresh calls to
+// re-fetch and apply chain configs. Until it is set the endpoint returns 503.
+func (s *Server) SetChainRefresher(refresh func(ctx context.Context) error) {
+	s.refreshChains = refresh
+}
+
+// SetHeldOutbounds sets the reviewer behind /admin/outbounds/held. Until it
+// is set, e.g. on a node without TSS, those endpoints return 503.
+func (s *Server) SetHeldOutbounds(held HeldOutbounds) {
+	s.heldOutbounds = held
+}
+
+// SetLeaveState sets the function GET /admin/tss/leave reports the TSS
+// node's graceful leave state from. Until it is set the endpoint returns 503.
+func (s *Server) SetLeaveState(state func() string) {
+	s.leaveState = state
+}
+
 // Start starts the HTTP server
 func (s *Server) Start() error {
 	if s.server == nil {
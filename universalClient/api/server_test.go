@@ -3,9 +3,11 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -119,3 +121,16 @@ func TestServerHasTimeoutsConfigured(t *testing.T) {
 	assert.Greater(t, server.server.IdleTimeout, time.Duration(0), "IdleTimeout must be set")
 }
 
+func TestRegister_ServesMetrics(t *testing.T) {
+	server := NewServer(zerolog.Nop(), 0)
+	gauge := prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: "test_backlog", Help: "test"}, func() float64 { return 7 })
+	require.NoError(t, server.Register(gauge))
+	assert.Error(t, server.Register(gauge), "duplicate registration")
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	w := httptest.NewRecorder()
+	server.server.Handler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "test_backlog 7")
+}
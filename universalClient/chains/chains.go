@@ -2,11 +2,15 @@ package chains
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
 	"github.com/pushchain/push-chain-node/universalClient/chains/evm"
 	"github.com/pushchain/push-chain-node/universalClient/chains/push"
@@ -22,6 +26,7 @@ import (
 // Chains manages chain clients by fetching chain configs periodically and adding/removing clients accordingly
 type Chains struct {
 	pushCore   *pushcore.Client
+	configs    chainConfigSource
 	pushSigner *pushsigner.Signer
 	config     *config.Config
 	logger     zerolog.Logger
@@ -29,16 +34,28 @@ type Chains struct {
 	// Chain client management
 	chains       map[string]common.ChainClient          // key: CAIP-2 chain ID
 	chainConfigs map[string]*uregistrytypes.ChainConfig // key: CAIP-2 chain ID
+	stopped      map[string]bool                        // chains stopped via StopChain; key: CAIP-2 chain ID
 	chainsMu     sync.RWMutex
-	pushChainID  string // Push chain ID (always present)
+	chainOpMu    sync.Mutex // serializes StartChain/StopChain
+	pushChainID  string     // Push chain ID (always present)
+
+	// reportTurn elects the validators that vote outbound results; see
+	// SetOutboundReportTurn
+	reportTurn common.ReportTurnFunc
 
 	// Background control
 	muRunning sync.Mutex
 	running   bool
 	stopCh    chan struct{}
+	refreshCh chan chan error // forced syncs requested via Refresh
 	wg        sync.WaitGroup
 }
 
+// chainConfigSource fetches the registry's chain configs (*pushcore.Client).
+type chainConfigSource interface {
+	GetAllChainConfigs(ctx context.Context) ([]*uregistrytypes.ChainConfig, error)
+}
+
 const (
 	// perSyncTimeout is the timeout for each sync operation
 	perSyncTimeout = 30 * time.Second
@@ -51,15 +68,29 @@ func NewChains(
 	cfg *config.Config,
 	logger zerolog.Logger,
 ) *Chains {
-	return &Chains{
+	c := &Chains{
 		pushCore:     pushCore,
 		pushSigner:   pushSigner,
 		config:       cfg,
 		logger:       logger.With().Str("component", "chains").Logger(),
 		chains:       make(map[string]common.ChainClient),
 		chainConfigs: make(map[string]*uregistrytypes.ChainConfig),
+		stopped:      make(map[string]bool),
 		pushChainID:  cfg.PushChainID,
+		refreshCh:    make(chan chan error),
 	}
+	// Keep configs a nil interface when no pushCore is given.
+	if pushCore != nil {
+		c.configs = pushCore
+	}
+	return c
+}
+
+// SetOutboundReportTurn makes the chain clients vote outbound results only
+// when reportTurn says it is this validator's turn, instead of every
+// validator voting every result. Call it before Start.
+func (c *Chains) SetOutboundReportTurn(reportTurn common.ReportTurnFunc) {
+	c.reportTurn = reportTurn
 }
 
 // Start begins fetching chains and managing chain clients
@@ -137,10 +168,46 @@ func (c *Chains) run(parent context.Context) {
 			if err := c.fetchAndUpdate(parent); err != nil {
 				c.logger.Warn().Err(err).Msg("periodic chain fetch failed; keeping previous chains")
 			}
+		case done := <-c.refreshCh:
+			c.logger.Info().Msg("forced chain config refresh")
+			err := c.fetchAndUpdate(parent)
+			if err != nil {
+				c.logger.Warn().Err(err).Msg("forced chain fetch failed; keeping previous chains")
+			}
+			done <- err
+			ticker.Reset(interval)
 		}
 	}
 }
 
+// Refresh fetches chain configs from Push Chain and applies them now instead
+// of at the next poll, e.g. after a gateway rotation. It runs on the sync
+// loop, so it never overlaps a periodic sync, and restarts the poll interval.
+func (c *Chains) Refresh(ctx context.Context) error {
+	c.muRunning.Lock()
+	running, stopCh := c.running, c.stopCh
+	c.muRunning.Unlock()
+	if !running {
+		return fmt.Errorf("chains manager is not running")
+	}
+
+	done := make(chan error, 1)
+	select {
+	case c.refreshCh <- done:
+	case <-stopCh:
+		return fmt.Errorf("chains manager stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // fetchAndUpdate fetches chain configs and updates chain clients
 func (c *Chains) fetchAndUpdate(parent context.Context) error {
 	timeout := perSyncTimeout
@@ -153,7 +220,7 @@ func (c *Chains) fetchAndUpdate(parent context.Context) error {
 	defer cancel()
 
 	// Fetch chain configs from pushcore (does NOT return Push chain)
-	cfgs, err := c.pushCore.GetAllChainConfigs(ctx)
+	cfgs, err := c.configs.GetAllChainConfigs(ctx)
 	if err != nil {
 		return err
 	}
@@ -184,6 +251,11 @@ func (c *Chains) fetchAndUpdate(parent context.Context) error {
 			}
 
 		case chainActionUpdate:
+			if c.isStopped(chainID) {
+				// Picked up on the first sync after StartChain.
+				c.logger.Debug().Str("chain", chainID).Msg("chain config changed while stopped, deferring update")
+				continue
+			}
 			c.logger.Info().Str("chain", chainID).Msg("chain config changed, updating")
 			if err := c.removeChain(chainID); err != nil {
 				c.logger.Error().Err(err).Str("chain", chainID).Msg("failed to remove chain for update")
@@ -289,6 +361,18 @@ func (c *Chains) addChain(ctx context.Context, cfg *uregistrytypes.ChainConfig)
 		return fmt.Errorf("failed to create chain client: %w", err)
 	}
 
+	if c.config.DryRun {
+		if d, ok := client.(dryRunner); ok {
+			d.SetDryRun(true)
+		}
+	}
+	if f, ok := client.(feePayerSetter); ok && c.pushCore != nil {
+		f.SetFeePayer(c.tssEVMAddress)
+	}
+	if r, ok := client.(reportTurnSetter); ok && c.reportTurn != nil {
+		r.SetReportTurn(c.reportTurn)
+	}
+
 	// Start the chain client
 	if err := client.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start chain client: %w", err)
@@ -307,6 +391,97 @@ func (c *Chains) addChain(ctx context.Context, cfg *uregistrytypes.ChainConfig)
 	return nil
 }
 
+// dryRunner is implemented by chain clients that can build outbound txs
+// without broadcasting them (config dry_run).
+type dryRunner interface {
+	SetDryRun(enabled bool)
+}
+
+// feePayerSetter is implemented by chain clients whose outbound txs are paid
+// for by the TSS address (EVM).
+type feePayerSetter interface {
+	SetFeePayer(feePayer func(ctx context.Context) (string, error))
+}
+
+// reportTurnSetter is implemented by chain clients that vote the results of
+// outbounds observed on their chain.
+type reportTurnSetter interface {
+	SetReportTurn(reportTurn common.ReportTurnFunc)
+}
+
+// relayerBalanceReporter is implemented by chain clients that monitor the
+// balance of their outbound fee payer.
+type relayerBalanceReporter interface {
+	RelayerBalance() (common.RelayerBalance, bool)
+}
+
+// endpointStatsReporter is implemented by chain clients that track the state
+// of their RPC endpoints.
+type endpointStatsReporter interface {
+	EndpointStats() []common.EndpointStats
+}
+
+// tssEVMAddress returns the EVM address of the current TSS key.
+func (c *Chains) tssEVMAddress(ctx context.Context) (string, error) {
+	key, err := c.pushCore.GetCurrentKey(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get current TSS key: %w", err)
+	}
+	pubkey, err := hex.DecodeString(strings.TrimPrefix(key.TssPubkey, "0x"))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode TSS public key: %w", err)
+	}
+	pub, err := crypto.DecompressPubkey(pubkey)
+	if err != nil {
+		return "", fmt.Errorf("invalid TSS public key: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub).Hex(), nil
+}
+
+// RelayerBalances returns the last fee-payer balance read for each running
+// chain that monitors one.
+func (c *Chains) RelayerBalances() map[string]common.RelayerBalance {
+	c.chainsMu.RLock()
+	defer c.chainsMu.RUnlock()
+
+	balances := make(map[string]common.RelayerBalance)
+	for chainID, client := range c.chains {
+		if c.stopped[chainID] {
+			continue
+		}
+		r, ok := client.(relayerBalanceReporter)
+		if !ok {
+			continue
+		}
+		if b, ok := r.RelayerBalance(); ok {
+			balances[chainID] = b
+		}
+	}
+	return balances
+}
+
+// EndpointStats returns the RPC endpoint stats of every running chain, keyed
+// by chain ID.
+func (c *Chains) EndpointStats() map[string][]common.EndpointStats {
+	c.chainsMu.RLock()
+	defer c.chainsMu.RUnlock()
+
+	stats := make(map[string][]common.EndpointStats)
+	for chainID, client := range c.chains {
+		if c.stopped[chainID] {
+			continue
+		}
+		r, ok := client.(endpointStatsReporter)
+		if !ok {
+			continue
+		}
+		if s := r.EndpointStats(); len(s) > 0 {
+			stats[chainID] = s
+		}
+	}
+	return stats
+}
+
 // removeChain removes a chain client
 func (c *Chains) removeChain(chainID string) error {
 	c.chainsMu.Lock()
@@ -316,16 +491,19 @@ func (c *Chains) removeChain(chainID string) error {
 	if !exists {
 		return nil
 	}
-	// Stop the client
-	if err := client.Stop(); err != nil {
-		c.logger.Error().
-			Err(err).
-			Str("chain", chainID).
-			Msg("error stopping chain client during removal")
+	// Stop the client unless StopChain already did
+	if !c.stopped[chainID] {
+		if err := client.Stop(); err != nil {
+			c.logger.Error().
+				Err(err).
+				Str("chain", chainID).
+				Msg("error stopping chain client during removal")
+		}
 	}
 
 	delete(c.chains, chainID)
 	delete(c.chainConfigs, chainID)
+	delete(c.stopped, chainID)
 
 	c.logger.Info().
 		Str("chain", chainID).
@@ -342,6 +520,9 @@ func (c *Chains) StopAll() {
 	c.logger.Debug().Msg("stopping all chain clients")
 
 	for chainID, client := range c.chains {
+		if c.stopped[chainID] {
+			continue
+		}
 		if err := client.Stop(); err != nil {
 			c.logger.Error().
 				Err(err).
@@ -353,9 +534,23 @@ func (c *Chains) StopAll() {
 	// Clear the registry
 	c.chains = make(map[string]common.ChainClient)
 	c.chainConfigs = make(map[string]*uregistrytypes.ChainConfig)
+	c.stopped = make(map[string]bool)
 }
 
-// GetClient returns the chain client for the specified chain ID
+// PushEventStats returns the Push Chain event stream stats, or false until
+// the Push Chain client has been added.
+func (c *Chains) PushEventStats() (push.EventStats, bool) {
+	c.chainsMu.RLock()
+	client, ok := c.chains[c.pushChainID].(*push.Client)
+	c.chainsMu.RUnlock()
+	if !ok {
+		return push.EventStats{}, false
+	}
+	return client.EventStats(), true
+}
+
+// GetClient returns the chain client for the specified chain ID. Chains
+// stopped via StopChain are reported as not available.
 func (c *Chains) GetClient(chainID string) (common.ChainClient, error) {
 	c.chainsMu.RLock()
 	defer c.chainsMu.RUnlock()
@@ -364,6 +559,9 @@ func (c *Chains) GetClient(chainID string) (common.ChainClient, error) {
 	if !exists {
 		return nil, fmt.Errorf("chain client not found for chain %s", chainID)
 	}
+	if c.stopped[chainID] {
+		return nil, fmt.Errorf("chain client for chain %s is stopped", chainID)
+	}
 
 	return client, nil
 }
@@ -403,25 +601,66 @@ func (c *Chains) GetStandardConfirmations(chainID string) uint64 {
 	return 12
 }
 
+// OutboundMaxLifetimeBlocks returns how many Push Chain blocks an outbound to
+// chainID may stay unsigned before it is voted failed, from the local chain
+// config. Returns 0 (no limit) if not set.
+func (c *Chains) OutboundMaxLifetimeBlocks(chainID string) uint64 {
+	if c.config == nil {
+		return 0
+	}
+	if blocks := c.config.GetChainConfig(chainID).OutboundMaxLifetimeBlocks; blocks != nil && *blocks > 0 {
+		return uint64(*blocks)
+	}
+	return 0
+}
+
+// OutboundSignMarginBlocks is how many Push Chain blocks before an outbound's
+// lifetime ends signing stops, so a tx signed late can't be broadcast after
+// the outbound has been voted failed. Capped at half the lifetime.
+const OutboundSignMarginBlocks = 100
+
+// OutboundSigningOpen reports whether an outbound to chainID created at Push
+// Chain block createdAt may still be signed at currentBlock. It is always
+// open when the chain has no lifetime.
+func (c *Chains) OutboundSigningOpen(chainID string, createdAt, currentBlock uint64) bool {
+	lifetime := c.OutboundMaxLifetimeBlocks(chainID)
+	if lifetime == 0 {
+		return true
+	}
+	margin := min(uint64(OutboundSignMarginBlocks), lifetime/2)
+	return currentBlock < createdAt+lifetime-margin
+}
+
+// SQLiteOptions converts the configured SQLite overrides; unset fields keep
+// the db defaults.
+func SQLiteOptions(cfg config.SQLiteConfig) db.SQLiteOptions {
+	return db.SQLiteOptions{
+		JournalMode: strings.ToUpper(cfg.JournalMode),
+		BusyTimeout: time.Duration(cfg.BusyTimeoutMs) * time.Millisecond,
+		Synchronous: strings.ToUpper(cfg.Synchronous),
+		CacheSizeKB: cfg.CacheSizeKB,
+	}
+}
+
 // getChainDB returns a database instance for a specific chain
-func (c *Chains) getChainDB(chainID string) (*db.DB, error) {
-	// Create database file directly named after the chain's CAIP-2 format
-	// e.g., "eip155:1" -> "eip155_1.db"
+func (c *Chains) getChainDB(chainID string) (db.Store, error) {
+	// Namespace the database after the chain's CAIP-2 format
+	// e.g., "eip155:1" -> "eip155_1.db" (sqlite) or "eip155_1_" tables (postgres)
 	sanitizedChainID := sanitizeChainID(chainID)
-	dbFilename := sanitizedChainID + ".db"
 
 	// Derive database base directory from NodeHome
 	baseDir := filepath.Join(c.config.NodeHome, config.DatabasesSubdir)
 
-	database, err := db.OpenFileDB(baseDir, dbFilename, true)
+	database, err := db.OpenWithSQLiteOptions(db.Driver(c.config.DatabaseDriver), c.config.DatabaseDSN, baseDir, sanitizedChainID, true, SQLiteOptions(c.config.DatabaseSQLite))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create database for chain %s: %w", chainID, err)
 	}
 
 	c.logger.Debug().
 		Str("chain", chainID).
-		Str("db_path", filepath.Join(baseDir, dbFilename)).
-		Msg("created file database for chain")
+		Str("driver", string(database.Driver())).
+		Str("namespace", sanitizedChainID).
+		Msg("opened database for chain")
 
 	return database, nil
 }
@@ -473,6 +712,12 @@ func (c *Chains) ensurePushChain(ctx context.Context) error {
 		return fmt.Errorf("failed to create push chain client: %w", err)
 	}
 
+	ceilings, err := outboundCeilings(c.config)
+	if err != nil {
+		return err
+	}
+	client.SetOutboundCeilings(ceilings)
+
 	// Start the push chain client
 	if err := client.Start(ctx); err != nil {
 		return fmt.Errorf("failed to start push chain client: %w", err)
@@ -493,6 +738,19 @@ func (c *Chains) ensurePushChain(ctx context.Context) error {
 
 // Helper functions
 
+// outboundCeilings collects the max_outbound_amounts of every configured chain.
+func outboundCeilings(cfg *config.Config) (common.OutboundCeilings, error) {
+	ceilings := common.OutboundCeilings{}
+	for chainID, cc := range cfg.ChainConfigs {
+		for asset, max := range cc.MaxOutboundAmounts {
+			if err := ceilings.Set(chainID, asset, max); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ceilings, nil
+}
+
 // sanitizeChainID converts chain ID to filesystem-safe format
 // e.g., "eip155:1" -> "eip155_1"
 func sanitizeChainID(chainID string) string {
@@ -3,6 +3,7 @@ package chains
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -1739,3 +1740,98 @@ func TestDetermineChainAction_PushChainID(t *testing.T) {
 		assert.Equal(t, chainActionAdd, action)
 	})
 }
+
+// stubConfigSource serves whatever chain configs are currently set.
+type stubConfigSource struct {
+	mu    sync.Mutex
+	cfgs  []*uregistrytypes.ChainConfig
+	calls int
+}
+
+func (s *stubConfigSource) set(cfgs ...*uregistrytypes.ChainConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfgs = cfgs
+}
+
+func (s *stubConfigSource) GetAllChainConfigs(ctx context.Context) ([]*uregistrytypes.ChainConfig, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	return s.cfgs, nil
+}
+
+func (s *stubConfigSource) fetches() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls
+}
+
+func TestRefresh(t *testing.T) {
+	t.Run("applies a changed config without waiting for the poll", func(t *testing.T) {
+		c := NewChains(nil, nil, &config.Config{
+			PushChainID:                  "localchain_9000-1",
+			ConfigRefreshIntervalSeconds: 3600,
+		}, zerolog.Nop())
+		src := &stubConfigSource{}
+		c.configs = src
+
+		enabled := &uregistrytypes.ChainConfig{
+			Chain:   "eip155:1",
+			VmType:  uregistrytypes.VmType_EVM,
+			Enabled: &uregistrytypes.ChainEnabled{IsInboundEnabled: true, IsOutboundEnabled: true},
+		}
+		mock := &mockChainClient{}
+		c.chains["eip155:1"] = mock
+		c.chainConfigs["eip155:1"] = enabled
+		src.set(enabled)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c.running = true
+		c.stopCh = make(chan struct{})
+		c.wg.Add(1)
+		go c.run(ctx)
+		defer c.Stop()
+		require.Eventually(t, func() bool { return src.fetches() == 1 }, 5*time.Second, 10*time.Millisecond, "initial fetch")
+
+		// The chain is disabled on Push Chain; the hourly poll would not see it yet.
+		src.set(&uregistrytypes.ChainConfig{
+			Chain:   "eip155:1",
+			VmType:  uregistrytypes.VmType_EVM,
+			Enabled: &uregistrytypes.ChainEnabled{},
+		})
+		refreshCtx, refreshCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer refreshCancel()
+		require.NoError(t, c.Refresh(refreshCtx))
+
+		assert.True(t, mock.stopCalled)
+		_, err := c.GetClient("eip155:1")
+		assert.Error(t, err)
+	})
+
+	t.Run("fails when the manager is not running", func(t *testing.T) {
+		c := newTestChains()
+		err := c.Refresh(context.Background())
+		require.ErrorContains(t, err, "not running")
+	})
+}
+
+func TestOutboundSigningOpen(t *testing.T) {
+	long, short := 1000, 40
+	c := NewChains(nil, nil, &config.Config{ChainConfigs: map[string]config.ChainSpecificConfig{
+		"eip155:1":  {OutboundMaxLifetimeBlocks: &long},
+		"eip155:56": {OutboundMaxLifetimeBlocks: &short},
+	}}, zerolog.Nop())
+
+	// Signing closes OutboundSignMarginBlocks before the lifetime ends.
+	assert.True(t, c.OutboundSigningOpen("eip155:1", 100, 100+1000-OutboundSignMarginBlocks-1))
+	assert.False(t, c.OutboundSigningOpen("eip155:1", 100, 100+1000-OutboundSignMarginBlocks))
+
+	// A short lifetime keeps half of it for signing.
+	assert.True(t, c.OutboundSigningOpen("eip155:56", 100, 119))
+	assert.False(t, c.OutboundSigningOpen("eip155:56", 100, 120))
+
+	// No lifetime: always open.
+	assert.True(t, c.OutboundSigningOpen("eip155:137", 100, 1_000_000))
+}
@@ -0,0 +1,22 @@
+package common
+
+import "strings"
+
+// NativeAsset is the asset key of a chain's native gas token in per-asset
+// settings such as max_outbound_amounts.
+const NativeAsset = "native"
+
+// AssetKey normalizes an outbound's asset address for per-asset lookups.
+// Empty and all-zero addresses are the native token, 0x-prefixed hex
+// addresses are lower-cased, and anything else (e.g. an SPL mint) is kept
+// as is, since base58 is case-sensitive.
+func AssetKey(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if strings.EqualFold(addr, NativeAsset) || strings.Trim(strings.TrimPrefix(addr, "0x"), "0") == "" {
+		return NativeAsset
+	}
+	if strings.HasPrefix(addr, "0x") || strings.HasPrefix(addr, "0X") {
+		return strings.ToLower(addr)
+	}
+	return addr
+}
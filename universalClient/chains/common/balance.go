@@ -0,0 +1,21 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrInsufficientRelayerBalance is returned by a TxBuilder when the account
+// paying for a transaction (the EVM transactor or the Solana relayer) cannot
+// cover its estimated cost. The tx is not broadcast; callers retry later.
+var ErrInsufficientRelayerBalance = errors.New("insufficient relayer balance")
+
+// CheckRelayerBalance returns an error wrapping ErrInsufficientRelayerBalance
+// if balance is below required.
+func CheckRelayerBalance(account string, balance, required *big.Int) error {
+	if balance.Cmp(required) >= 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s has %s, needs %s", ErrInsufficientRelayerBalance, account, balance, required)
+}
@@ -0,0 +1,170 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+)
+
+// DefaultBalanceCheckInterval is how often a BalanceMonitor reads the balance
+// when no interval is configured.
+const DefaultBalanceCheckInterval = time.Minute
+
+// BalanceFetcher returns the account that pays for a chain's outbound txs
+// and its balance in the chain's base unit (wei, lamports).
+type BalanceFetcher func(ctx context.Context) (account string, balance *big.Int, err error)
+
+// RelayerBalance is the last fee-payer balance read by a BalanceMonitor.
+type RelayerBalance struct {
+	Account   string
+	Balance   *big.Int
+	Low       bool // below the configured threshold
+	CheckedAt time.Time
+}
+
+// BalanceMonitor periodically reads a chain's fee-payer balance and warns
+// while it is below a threshold, so a relayer running dry is noticed before
+// every outbound for the chain fails with ErrInsufficientRelayerBalance.
+type BalanceMonitor struct {
+	fetch     BalanceFetcher
+	interval  time.Duration
+	threshold *big.Int // nil never warns
+	logger    zerolog.Logger
+
+	mu   sync.Mutex
+	last RelayerBalance
+	ok   bool
+}
+
+// NewBalanceMonitor creates a monitor that reads the balance via fetch every
+// interval (DefaultBalanceCheckInterval if not positive) and warns while it
+// is below threshold. A nil threshold only records the balance.
+func NewBalanceMonitor(fetch BalanceFetcher, interval time.Duration, threshold *big.Int, logger zerolog.Logger) *BalanceMonitor {
+	if interval <= 0 {
+		interval = DefaultBalanceCheckInterval
+	}
+	return &BalanceMonitor{
+		fetch:     fetch,
+		interval:  interval,
+		threshold: threshold,
+		logger:    logger.With().Str("component", "relayer_balance_monitor").Logger(),
+	}
+}
+
+// ParseBalanceThreshold parses a decimal threshold in base units. An empty
+// threshold yields nil (no warning).
+func ParseBalanceThreshold(threshold string) (*big.Int, error) {
+	if threshold == "" {
+		return nil, nil
+	}
+	t, ok := new(big.Int).SetString(threshold, 10)
+	if !ok || t.Sign() < 0 {
+		return nil, fmt.Errorf("invalid relayer balance threshold: %q", threshold)
+	}
+	return t, nil
+}
+
+// Start checks the balance now and then every interval until ctx is done.
+func (m *BalanceMonitor) Start(ctx context.Context) {
+	go func() {
+		m.Check(ctx)
+
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.Check(ctx)
+			}
+		}
+	}()
+}
+
+// Check reads the balance once. A failed read keeps the last balance.
+func (m *BalanceMonitor) Check(ctx context.Context) {
+	account, balance, err := m.fetch(ctx)
+	if err != nil {
+		m.logger.Debug().Err(err).Msg("failed to read relayer balance")
+		return
+	}
+
+	low := m.threshold != nil && balance.Cmp(m.threshold) < 0
+	m.mu.Lock()
+	m.last = RelayerBalance{Account: account, Balance: balance, Low: low, CheckedAt: time.Now()}
+	m.ok = true
+	m.mu.Unlock()
+
+	if low {
+		m.logger.Warn().
+			Str("account", account).
+			Str("balance", balance.String()).
+			Str("threshold", m.threshold.String()).
+			Msg("relayer balance below threshold; top it up before outbounds start failing")
+	}
+}
+
+// Balance returns the last balance read, or false before the first
+// successful read.
+func (m *BalanceMonitor) Balance() (RelayerBalance, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.last, m.ok
+}
+
+var (
+	relayerBalanceDesc = prometheus.NewDesc(
+		"puniversal_relayer_balance",
+		"Balance of the account paying for a chain's outbound txs, in the chain's base unit.",
+		[]string{"chain", "account"}, nil,
+	)
+	relayerBalanceLowDesc = prometheus.NewDesc(
+		"puniversal_relayer_balance_low",
+		"1 while a chain's relayer balance is below its configured threshold.",
+		[]string{"chain"}, nil,
+	)
+)
+
+// relayerBalanceCollector exports the balances reported by a callback.
+type relayerBalanceCollector struct {
+	balances func() map[string]RelayerBalance
+}
+
+// RelayerBalanceCollector returns a collector for the metrics endpoint that
+// reports, on every scrape, the balances returned by balances (chain ID →
+// last balance read).
+func RelayerBalanceCollector(balances func() map[string]RelayerBalance) prometheus.Collector {
+	return relayerBalanceCollector{balances: balances}
+}
+
+func (c relayerBalanceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- relayerBalanceDesc
+	ch <- relayerBalanceLowDesc
+}
+
+func (c relayerBalanceCollector) Collect(ch chan<- prometheus.Metric) {
+	balances := c.balances()
+	chainIDs := make([]string, 0, len(balances))
+	for chainID := range balances {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+
+	for _, chainID := range chainIDs {
+		b := balances[chainID]
+		balance, _ := new(big.Float).SetInt(b.Balance).Float64()
+		ch <- prometheus.MustNewConstMetric(relayerBalanceDesc, prometheus.GaugeValue, balance, chainID, b.Account)
+		low := 0.0
+		if b.Low {
+			low = 1
+		}
+		ch <- prometheus.MustNewConstMetric(relayerBalanceLowDesc, prometheus.GaugeValue, low, chainID)
+	}
+}
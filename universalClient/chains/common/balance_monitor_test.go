@@ -0,0 +1,107 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceMonitor(t *testing.T) {
+	const relayer = "0x1111111111111111111111111111111111111111"
+	balance := big.NewInt(0)
+	fetch := func(context.Context) (string, *big.Int, error) { return relayer, new(big.Int).Set(balance), nil }
+
+	var logs bytes.Buffer
+	threshold, err := ParseBalanceThreshold("1000")
+	require.NoError(t, err)
+	monitor := NewBalanceMonitor(fetch, 0, threshold, zerolog.New(&logs))
+
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(RelayerBalanceCollector(func() map[string]RelayerBalance {
+		b, ok := monitor.Balance()
+		if !ok {
+			return nil
+		}
+		return map[string]RelayerBalance{"eip155:1": b}
+	})))
+	gauges := func() map[string]float64 {
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		out := map[string]float64{}
+		for _, f := range families {
+			for _, m := range f.GetMetric() {
+				out[f.GetName()] = m.GetGauge().GetValue()
+			}
+		}
+		return out
+	}
+
+	_, ok := monitor.Balance()
+	assert.False(t, ok)
+	assert.Empty(t, gauges(), "nothing exported before the first read")
+
+	t.Run("below threshold warns", func(t *testing.T) {
+		logs.Reset()
+		balance.SetInt64(999)
+		monitor.Check(context.Background())
+
+		b, ok := monitor.Balance()
+		require.True(t, ok)
+		assert.True(t, b.Low)
+		assert.Equal(t, relayer, b.Account)
+		assert.Equal(t, map[string]float64{
+			"puniversal_relayer_balance":     999,
+			"puniversal_relayer_balance_low": 1,
+		}, gauges())
+		assert.Contains(t, logs.String(), "relayer balance below threshold")
+	})
+
+	t.Run("at or above threshold does not", func(t *testing.T) {
+		logs.Reset()
+		balance.SetInt64(1000)
+		monitor.Check(context.Background())
+
+		b, _ := monitor.Balance()
+		assert.False(t, b.Low)
+		assert.Equal(t, map[string]float64{
+			"puniversal_relayer_balance":     1000,
+			"puniversal_relayer_balance_low": 0,
+		}, gauges())
+		assert.NotContains(t, logs.String(), "relayer balance below threshold")
+	})
+
+	t.Run("failed read keeps the last balance", func(t *testing.T) {
+		monitor.fetch = func(context.Context) (string, *big.Int, error) { return "", nil, errors.New("rpc down") }
+		monitor.Check(context.Background())
+		b, ok := monitor.Balance()
+		require.True(t, ok)
+		assert.Equal(t, int64(1000), b.Balance.Int64())
+	})
+}
+
+func TestParseBalanceThreshold(t *testing.T) {
+	threshold, err := ParseBalanceThreshold("")
+	require.NoError(t, err)
+	assert.Nil(t, threshold)
+
+	_, err = ParseBalanceThreshold("0.5")
+	assert.Error(t, err)
+	_, err = ParseBalanceThreshold("-1")
+	assert.Error(t, err)
+
+	// Without a threshold the balance is only recorded.
+	monitor := NewBalanceMonitor(func(context.Context) (string, *big.Int, error) {
+		return "relayer", big.NewInt(0), nil
+	}, 0, nil, zerolog.Nop())
+	monitor.Check(context.Background())
+	b, ok := monitor.Balance()
+	require.True(t, ok)
+	assert.False(t, b.Low)
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRelayerBalance(t *testing.T) {
+	assert.NoError(t, CheckRelayerBalance("payer", big.NewInt(100), big.NewInt(100)))
+
+	err := CheckRelayerBalance("payer", big.NewInt(99), big.NewInt(100))
+	assert.ErrorIs(t, err, ErrInsufficientRelayerBalance)
+	assert.Contains(t, err.Error(), "payer has 99, needs 100")
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/pushchain/push-chain-node/universalClient/db"
 	"github.com/pushchain/push-chain-node/universalClient/store"
@@ -12,11 +13,11 @@ import (
 
 // ChainStore provides database operations for chain state and events
 type ChainStore struct {
-	database *db.DB
+	database db.Store
 }
 
 // NewChainStore creates a new chain store
-func NewChainStore(database *db.DB) *ChainStore {
+func NewChainStore(database db.Store) *ChainStore {
 	return &ChainStore{
 		database: database,
 	}
@@ -60,6 +61,47 @@ func (cs *ChainStore) UpdateChainHeight(blockHeight uint64) error {
 	return nil
 }
 
+// GetEnableHeight returns the block height observation started from when the
+// chain was first enabled; ok is false if none has been recorded yet.
+func (cs *ChainStore) GetEnableHeight() (height uint64, ok bool, err error) {
+	if cs.database == nil {
+		return 0, false, fmt.Errorf("database is nil")
+	}
+
+	var state store.State
+	if err := cs.database.Client().FirstOrCreate(&state, store.State{}).Error; err != nil {
+		return 0, false, fmt.Errorf("failed to get or create chain state: %w", err)
+	}
+	if state.EnableHeight == nil {
+		return 0, false, nil
+	}
+
+	return *state.EnableHeight, true, nil
+}
+
+// SetEnableHeight records the block height observation starts from for a
+// newly enabled chain. A height already recorded is kept.
+func (cs *ChainStore) SetEnableHeight(height uint64) error {
+	if cs.database == nil {
+		return fmt.Errorf("database is nil")
+	}
+
+	var state store.State
+	if err := cs.database.Client().FirstOrCreate(&state, store.State{}).Error; err != nil {
+		return fmt.Errorf("failed to get or create chain state: %w", err)
+	}
+	if state.EnableHeight != nil {
+		return nil
+	}
+
+	state.EnableHeight = &height
+	if err := cs.database.Client().Save(&state).Error; err != nil {
+		return fmt.Errorf("failed to record enable height: %w", err)
+	}
+
+	return nil
+}
+
 // GetPendingEvents fetches pending events ordered by creation time
 func (cs *ChainStore) GetPendingEvents(limit int) ([]store.Event, error) {
 	if cs.database == nil {
@@ -96,6 +138,66 @@ func (cs *ChainStore) GetConfirmedEvents(limit int) ([]store.Event, error) {
 	return events, nil
 }
 
+// GetStandbyEvents fetches standby events ordered by last update, oldest first
+func (cs *ChainStore) GetStandbyEvents(limit int) ([]store.Event, error) {
+	if cs.database == nil {
+		return nil, fmt.Errorf("database is nil")
+	}
+
+	var events []store.Event
+	if err := cs.database.Client().
+		Where("status = ?", store.StatusStandby).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to query standby events: %w", err)
+	}
+
+	return events, nil
+}
+
+// CountEvents counts events of the given types in any of the given statuses
+func (cs *ChainStore) CountEvents(types, statuses []string) (int64, error) {
+	if cs.database == nil {
+		return 0, fmt.Errorf("database is nil")
+	}
+
+	var count int64
+	if err := cs.database.Client().
+		Model(&store.Event{}).
+		Where("type IN ? AND status IN ?", types, statuses).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count events: %w", err)
+	}
+
+	return count, nil
+}
+
+// BacklogStats counts events in any of the given statuses and returns the
+// lowest block height among them (0 when there are none).
+func (cs *ChainStore) BacklogStats(statuses []string) (count int64, oldestHeight uint64, err error) {
+	if cs.database == nil {
+		return 0, 0, fmt.Errorf("database is nil")
+	}
+
+	var row struct {
+		Count  int64
+		Oldest *uint64
+	}
+	if err := cs.database.Client().
+		Model(&store.Event{}).
+		Select("COUNT(*) AS count, MIN(block_height) AS oldest").
+		Where("status IN ?", statuses).
+		Scan(&row).Error; err != nil {
+		return 0, 0, fmt.Errorf("failed to query event backlog: %w", err)
+	}
+	if row.Oldest != nil {
+		oldestHeight = *row.Oldest
+	}
+
+	return row.Count, oldestHeight, nil
+}
+
 // UpdateEventStatus updates the status of an event by event ID
 func (cs *ChainStore) UpdateEventStatus(eventID string, oldStatus, newStatus string) (int64, error) {
 	if cs.database == nil {
@@ -172,7 +274,7 @@ func (cs *ChainStore) UpdateVoteTxHash(eventID string, voteTxHash string) error
 	return nil
 }
 
-// DeleteTerminalEvents deletes events in terminal states (COMPLETED, REVERTED, EXPIRED)
+// DeleteTerminalEvents deletes events in terminal states (COMPLETED, REORGED, REVERTED, DRY_RUN)
 // that were updated before the given time
 func (cs *ChainStore) DeleteTerminalEvents(updatedBefore any) (int64, error) {
 	if cs.database == nil {
@@ -183,7 +285,7 @@ func (cs *ChainStore) DeleteTerminalEvents(updatedBefore any) (int64, error) {
 	// delete (just sets deleted_at), which defeats the cleaner's purpose.
 	res := cs.database.Client().Unscoped().
 		Where("status IN ? AND updated_at < ?",
-			[]string{store.StatusCompleted, store.StatusReorged, store.StatusReverted}, updatedBefore).
+			[]string{store.StatusCompleted, store.StatusReorged, store.StatusReverted, store.StatusDryRun}, updatedBefore).
 		Delete(&store.Event{})
 
 	if res.Error != nil {
@@ -224,3 +326,64 @@ func (cs *ChainStore) InsertEventIfNotExists(event *store.Event) (bool, error) {
 
 	return true, nil
 }
+
+// InsertRawEvent stores the raw source-chain event behind an observed event.
+// A raw event already stored for the same EventID is left as is.
+func (cs *ChainStore) InsertRawEvent(raw *store.RawEvent) error {
+	if cs.database == nil {
+		return fmt.Errorf("database is nil")
+	}
+
+	res := cs.database.Client().Clauses(clause.OnConflict{DoNothing: true}).Create(raw)
+	if res.Error != nil {
+		return fmt.Errorf("failed to create raw event: %w", res.Error)
+	}
+	return nil
+}
+
+// SetRawEventUniversalTxID records the utx id an event was voted under on its
+// raw event. Returns rows affected (0 when no raw event was captured).
+func (cs *ChainStore) SetRawEventUniversalTxID(eventID, universalTxID string) (int64, error) {
+	if cs.database == nil {
+		return 0, fmt.Errorf("database is nil")
+	}
+
+	res := cs.database.Client().Model(&store.RawEvent{}).
+		Where("event_id = ?", eventID).
+		Update("universal_tx_id", universalTxID)
+	if res.Error != nil {
+		return 0, fmt.Errorf("failed to update raw event utx id: %w", res.Error)
+	}
+	return res.RowsAffected, nil
+}
+
+// GetRawEvents returns the raw events voted under a universal tx id, oldest first.
+func (cs *ChainStore) GetRawEvents(universalTxID string) ([]store.RawEvent, error) {
+	if cs.database == nil {
+		return nil, fmt.Errorf("database is nil")
+	}
+
+	var raws []store.RawEvent
+	if err := cs.database.Client().
+		Where("universal_tx_id = ?", universalTxID).
+		Order("block_height ASC, id ASC").
+		Find(&raws).Error; err != nil {
+		return nil, fmt.Errorf("failed to get raw events: %w", err)
+	}
+	return raws, nil
+}
+
+// DeleteRawEventsBefore hard-deletes raw events stored before the given time.
+func (cs *ChainStore) DeleteRawEventsBefore(createdBefore any) (int64, error) {
+	if cs.database == nil {
+		return 0, fmt.Errorf("database is nil")
+	}
+
+	res := cs.database.Client().Unscoped().
+		Where("created_at < ?", createdBefore).
+		Delete(&store.RawEvent{})
+	if res.Error != nil {
+		return 0, fmt.Errorf("failed to delete raw events: %w", res.Error)
+	}
+	return res.RowsAffected, nil
+}
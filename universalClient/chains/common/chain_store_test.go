@@ -3,6 +3,7 @@ package common
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -114,6 +115,32 @@ func TestChainStore_UpdateChainHeight(t *testing.T) {
 	})
 }
 
+func TestChainStore_EnableHeight(t *testing.T) {
+	cs := newTestChainStore(t)
+
+	t.Run("unset on a fresh chain", func(t *testing.T) {
+		_, ok, err := cs.GetEnableHeight()
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("first recorded height is kept", func(t *testing.T) {
+		require.NoError(t, cs.SetEnableHeight(0))
+		require.NoError(t, cs.SetEnableHeight(500)) // already recorded — ignored
+		height, ok, err := cs.GetEnableHeight()
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(0), height)
+	})
+
+	t.Run("independent of the processed height", func(t *testing.T) {
+		require.NoError(t, cs.UpdateChainHeight(900))
+		height, _, err := cs.GetEnableHeight()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), height)
+	})
+}
+
 func TestChainStore_InsertAndQuery(t *testing.T) {
 	cs := newTestChainStore(t)
 
@@ -184,6 +211,72 @@ func TestChainStore_UpdateEventStatus(t *testing.T) {
 	})
 }
 
+func TestChainStore_CountEvents(t *testing.T) {
+	cs := newTestChainStore(t)
+
+	for i, e := range []struct{ typ, status string }{
+		{storemodels.EventTypeSignOutbound, storemodels.StatusConfirmed},
+		{storemodels.EventTypeSignOutbound, storemodels.StatusInProgress},
+		{storemodels.EventTypeSignOutbound, storemodels.StatusCompleted},
+		{storemodels.EventTypeSignFundMigrate, storemodels.StatusInProgress},
+		{storemodels.EventTypeKeygen, storemodels.StatusInProgress},
+	} {
+		_, err := cs.InsertEventIfNotExists(&storemodels.Event{
+			EventID:          fmt.Sprintf("count-%d", i),
+			Type:             e.typ,
+			ConfirmationType: storemodels.ConfirmationInstant,
+			Status:           e.status,
+		})
+		require.NoError(t, err)
+	}
+
+	count, err := cs.CountEvents(
+		[]string{storemodels.EventTypeSignOutbound, storemodels.EventTypeSignFundMigrate},
+		[]string{storemodels.StatusConfirmed, storemodels.StatusInProgress},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	_, err = NewChainStore(nil).CountEvents(nil, nil)
+	assert.ErrorContains(t, err, "database is nil")
+}
+
+func TestChainStore_BacklogStats(t *testing.T) {
+	cs := newTestChainStore(t)
+	open := []string{storemodels.StatusConfirmed, storemodels.StatusInProgress}
+
+	count, oldest, err := cs.BacklogStats(open)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Zero(t, oldest)
+
+	for i, e := range []struct {
+		height uint64
+		status string
+	}{
+		{5, storemodels.StatusCompleted},
+		{8, storemodels.StatusInProgress},
+		{12, storemodels.StatusConfirmed},
+	} {
+		_, err := cs.InsertEventIfNotExists(&storemodels.Event{
+			EventID:          fmt.Sprintf("backlog-%d", i),
+			BlockHeight:      e.height,
+			Type:             storemodels.EventTypeSignOutbound,
+			ConfirmationType: storemodels.ConfirmationInstant,
+			Status:           e.status,
+		})
+		require.NoError(t, err)
+	}
+
+	count, oldest, err = cs.BacklogStats(open)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	assert.Equal(t, uint64(8), oldest)
+
+	_, _, err = NewChainStore(nil).BacklogStats(open)
+	assert.ErrorContains(t, err, "database is nil")
+}
+
 func TestChainStore_UpdateStatusAndVoteTxHash(t *testing.T) {
 	cs := newTestChainStore(t)
 
@@ -472,3 +565,37 @@ func TestChainStore_DeleteTerminalEvents_IsHardDelete(t *testing.T) {
 	assert.Equal(t, int64(0), rawCount,
 		"DeleteTerminalEvents must hard-delete; soft delete defeats the cleaner's purpose")
 }
+
+func TestChainStore_RawEvents(t *testing.T) {
+	cs := newTestChainStore(t)
+
+	require.NoError(t, cs.InsertRawEvent(&storemodels.RawEvent{EventID: "0xaa:1", BlockHeight: 10, Payload: []byte(`{"logIndex":"0x1"}`)}))
+	require.NoError(t, cs.InsertRawEvent(&storemodels.RawEvent{EventID: "0xbb:0", BlockHeight: 11, Payload: []byte(`{"logIndex":"0x0"}`)}))
+	// A re-observed event keeps its first payload.
+	require.NoError(t, cs.InsertRawEvent(&storemodels.RawEvent{EventID: "0xaa:1", BlockHeight: 10, Payload: []byte(`{}`)}))
+
+	rows, err := cs.SetRawEventUniversalTxID("0xaa:1", "utx-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), rows)
+	rows, err = cs.SetRawEventUniversalTxID("not-captured", "utx-2")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), rows)
+
+	raws, err := cs.GetRawEvents("utx-1")
+	require.NoError(t, err)
+	require.Len(t, raws, 1)
+	assert.Equal(t, "0xaa:1", raws[0].EventID)
+	assert.Equal(t, uint64(10), raws[0].BlockHeight)
+	assert.JSONEq(t, `{"logIndex":"0x1"}`, string(raws[0].Payload))
+
+	// Pruning is by age regardless of utx id, and is a hard delete.
+	deleted, err := cs.DeleteRawEventsBefore(time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	var count int64
+	require.NoError(t, cs.database.Client().Unscoped().Model(&storemodels.RawEvent{}).Count(&count).Error)
+	assert.Zero(t, count)
+
+	_, err = NewChainStore(nil).GetRawEvents("utx-1")
+	assert.ErrorContains(t, err, "database is nil")
+}
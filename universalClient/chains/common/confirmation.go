@@ -0,0 +1,50 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ValueConfirmationPolicy raises the confirmations an outbound tx needs before
+// it is treated as final when the outbound moves at least its asset's
+// threshold, in that asset's base units. The zero value never escalates.
+type ValueConfirmationPolicy struct {
+	Thresholds    map[string]*big.Int // by AssetKey; assets without one never escalate
+	Confirmations uint64              // required at or above the threshold
+}
+
+// NewValueConfirmationPolicy parses decimal thresholds keyed by asset address
+// ("native" for the gas token). No thresholds or zero confirmations yields
+// the disabled policy.
+func NewValueConfirmationPolicy(thresholds map[string]string, confirmations int) (ValueConfirmationPolicy, error) {
+	if len(thresholds) == 0 || confirmations <= 0 {
+		return ValueConfirmationPolicy{}, nil
+	}
+	p := ValueConfirmationPolicy{Thresholds: make(map[string]*big.Int, len(thresholds)), Confirmations: uint64(confirmations)}
+	for asset, threshold := range thresholds {
+		t, ok := new(big.Int).SetString(threshold, 10)
+		if !ok || t.Sign() < 0 {
+			return ValueConfirmationPolicy{}, fmt.Errorf("invalid high-value threshold for %s: %q", asset, threshold)
+		}
+		p.Thresholds[AssetKey(asset)] = t
+	}
+	return p, nil
+}
+
+// RequiredConfirmations returns the confirmations an outbound of amount of
+// asset needs: base, or p.Confirmations if that is higher and amount is at or
+// above the asset's threshold. An empty amount counts as zero; an unparseable
+// one counts as high value so a malformed event never gets the weaker check.
+func (p ValueConfirmationPolicy) RequiredConfirmations(asset, amount string, base uint64) uint64 {
+	threshold, ok := p.Thresholds[AssetKey(asset)]
+	if !ok || p.Confirmations <= base {
+		return base
+	}
+	if amount == "" {
+		amount = "0"
+	}
+	if v, ok := new(big.Int).SetString(amount, 10); ok && v.Cmp(threshold) < 0 {
+		return base
+	}
+	return p.Confirmations
+}
@@ -0,0 +1,56 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValueConfirmationPolicy(t *testing.T) {
+	const token = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+	policy, err := NewValueConfirmationPolicy(map[string]string{NativeAsset: "1000", token: "10"}, 32)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		asset  string
+		amount string
+		base   uint64
+		want   uint64
+	}{
+		{"below threshold uses base", "", "999", 12, 12},
+		{"at threshold escalates", "", "1000", 12, 32},
+		{"above threshold escalates", "", "1000000000000000000000", 12, 32},
+		{"empty amount counts as zero", "", "", 12, 12},
+		{"unparseable amount escalates", "", "lots", 12, 32},
+		{"base already deeper", "", "5000", 64, 64},
+		{"token uses its own threshold", token, "10", 12, 32},
+		{"token below its threshold uses base", token, "9", 12, 12},
+		{"asset without a threshold never escalates", "0x1111111111111111111111111111111111111111", "1000000", 12, 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, policy.RequiredConfirmations(tt.asset, tt.amount, tt.base))
+		})
+	}
+
+	t.Run("zero value never escalates", func(t *testing.T) {
+		assert.Equal(t, uint64(12), ValueConfirmationPolicy{}.RequiredConfirmations("", "1000000", 12))
+	})
+}
+
+func TestNewValueConfirmationPolicy(t *testing.T) {
+	p, err := NewValueConfirmationPolicy(nil, 32)
+	require.NoError(t, err)
+	assert.Nil(t, p.Thresholds, "no thresholds disables the policy")
+
+	p, err = NewValueConfirmationPolicy(map[string]string{NativeAsset: "1000"}, 0)
+	require.NoError(t, err)
+	assert.Nil(t, p.Thresholds, "zero confirmations disables the policy")
+
+	_, err = NewValueConfirmationPolicy(map[string]string{NativeAsset: "1e18"}, 32)
+	assert.Error(t, err)
+	_, err = NewValueConfirmationPolicy(map[string]string{NativeAsset: "-1"}, 32)
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultDrainTimeout bounds how long an RPC client's Close waits for calls
+// already in flight before tearing down its connections anyway.
+const DefaultDrainTimeout = 10 * time.Second
+
+// ErrRPCClientClosed is returned for calls started after the RPC client
+// began closing.
+var ErrRPCClientClosed = errors.New("rpc client closed")
+
+// DrainGate admits calls until Drain is called, then rejects new ones while
+// Drain waits for those already admitted. The zero value is ready to use.
+type DrainGate struct {
+	mu       sync.Mutex
+	draining bool
+	inflight sync.WaitGroup
+}
+
+// Enter admits one call, or returns ErrRPCClientClosed once draining has
+// begun. Every successful Enter must be paired with a Leave.
+func (g *DrainGate) Enter() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.draining {
+		return ErrRPCClientClosed
+	}
+	g.inflight.Add(1)
+	return nil
+}
+
+// Leave marks an admitted call as finished.
+func (g *DrainGate) Leave() {
+	g.inflight.Done()
+}
+
+// Drain stops admitting calls and waits up to timeout for admitted ones to
+// Leave. It reports whether they all finished in time; a non-positive
+// timeout waits indefinitely.
+func (g *DrainGate) Drain(timeout time.Duration) bool {
+	g.mu.Lock()
+	g.draining = true
+	g.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		g.inflight.Wait()
+		close(done)
+	}()
+
+	if timeout <= 0 {
+		<-done
+		return true
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
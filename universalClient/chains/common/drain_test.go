@@ -0,0 +1,39 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrainGate(t *testing.T) {
+	t.Run("drain waits for an admitted call", func(t *testing.T) {
+		var g DrainGate
+		require.NoError(t, g.Enter())
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			g.Leave()
+		}()
+
+		start := time.Now()
+		assert.True(t, g.Drain(time.Second))
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+
+	t.Run("calls after drain are rejected", func(t *testing.T) {
+		var g DrainGate
+		assert.True(t, g.Drain(time.Second))
+		assert.ErrorIs(t, g.Enter(), ErrRPCClientClosed)
+	})
+
+	t.Run("drain gives up after timeout", func(t *testing.T) {
+		var g DrainGate
+		require.NoError(t, g.Enter())
+		defer g.Leave()
+
+		assert.False(t, g.Drain(20*time.Millisecond))
+	})
+}
@@ -0,0 +1,8 @@
+package common
+
+import "errors"
+
+// ErrBroadcastSkipped is returned by an RPC client in dry-run mode instead of
+// sending a transaction. Builders still return the tx hash alongside it, so
+// the caller can record what would have been broadcast.
+var ErrBroadcastSkipped = errors.New("broadcast skipped: dry run")
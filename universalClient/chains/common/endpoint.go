@@ -0,0 +1,92 @@
+package common
+
+import (
+	"context"
+	"net/url"
+	"sync"
+)
+
+// EndpointRecorder collects which RPC endpoint served each operation made
+// under a context, so callers can attribute a multi-call flow (nonce fetch,
+// broadcast, verification) to the endpoints that actually answered.
+type EndpointRecorder struct {
+	mu     sync.Mutex
+	served map[string]string
+}
+
+type endpointRecorderKey struct{}
+
+// WithEndpointRecorder returns a child context carrying a fresh recorder.
+// RPC clients report to it through RecordEndpoint; a context without one
+// records nothing.
+func WithEndpointRecorder(ctx context.Context) (context.Context, *EndpointRecorder) {
+	rec := &EndpointRecorder{served: make(map[string]string)}
+	return context.WithValue(ctx, endpointRecorderKey{}, rec), rec
+}
+
+// RecordEndpoint notes that endpoint served operation, if ctx carries a
+// recorder. A later call for the same operation overwrites the earlier one.
+func RecordEndpoint(ctx context.Context, operation, endpoint string) {
+	if ctx == nil {
+		return
+	}
+	rec, ok := ctx.Value(endpointRecorderKey{}).(*EndpointRecorder)
+	if !ok {
+		return
+	}
+	rec.mu.Lock()
+	rec.served[operation] = endpoint
+	rec.mu.Unlock()
+}
+
+// Served returns a copy of the operation → endpoint map recorded so far.
+func (r *EndpointRecorder) Served() map[string]string {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make(map[string]string, len(r.served))
+	for op, endpoint := range r.served {
+		out[op] = endpoint
+	}
+	return out
+}
+
+// RedactEndpoint reduces an RPC URL to scheme://host, dropping the path,
+// query and user info where providers usually embed API keys. Unparseable
+// input is returned as "unknown" rather than leaked verbatim.
+func RedactEndpoint(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "unknown"
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// FilterEndpoints returns the rpcURLs eligible for selection: members of
+// allowlist when it is non-empty, minus any on blacklist. An entry matches a
+// URL verbatim or by its redacted scheme://host form. Order is preserved.
+func FilterEndpoints(rpcURLs, allowlist, blacklist []string) []string {
+	matches := func(list []string, rawURL string) bool {
+		redacted := RedactEndpoint(rawURL)
+		for _, entry := range list {
+			if entry == rawURL || entry == redacted {
+				return true
+			}
+		}
+		return false
+	}
+
+	eligible := make([]string, 0, len(rpcURLs))
+	for _, rawURL := range rpcURLs {
+		if len(allowlist) > 0 && !matches(allowlist, rawURL) {
+			continue
+		}
+		if matches(blacklist, rawURL) {
+			continue
+		}
+		eligible = append(eligible, rawURL)
+	}
+	return eligible
+}
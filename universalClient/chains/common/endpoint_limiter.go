@@ -0,0 +1,227 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"golang.org/x/time/rate"
+)
+
+// DefaultRateLimitCooldown is how long an endpoint is parked after it answers
+// with a rate-limit response. Short enough that a briefly throttled endpoint
+// rejoins the rotation within a couple of polling ticks.
+const DefaultRateLimitCooldown = 5 * time.Second
+
+// MaxRateLimitCooldown caps the backed-off cooldown of an endpoint that keeps
+// getting throttled right after each re-inclusion.
+const MaxRateLimitCooldown = 2 * time.Minute
+
+// DefaultRecoveryStreak is how many consecutive successful calls a degraded
+// endpoint needs before it counts as healthy again.
+const DefaultRecoveryStreak = 3
+
+// EndpointLimiter paces requests to a single RPC endpoint with a token bucket
+// and parks the endpoint for a cooldown after it signals rate limiting.
+// A nil *EndpointLimiter is valid: it never blocks and is never cooling down.
+//
+// Once a cooldown elapses the endpoint rejoins the rotation degraded: it
+// only counts as healthy after a streak of consecutive successes, and each
+// cooldown taken while degraded doubles the previous one, so an endpoint
+// whose provider is still throttling stays out longer instead of flapping.
+type EndpointLimiter struct {
+	limiter *rate.Limiter
+
+	mu             sync.Mutex
+	cooldownUntil  time.Time
+	degraded       bool
+	strikes        int // cooldowns since the endpoint was last healthy
+	streak         int // consecutive successes while degraded
+	recoveryStreak int // successes needed to leave degraded; <= 0 means DefaultRecoveryStreak
+}
+
+// NewEndpointLimiter creates a limiter allowing requestsPerSecond sustained
+// requests with bursts of up to burst. A non-positive burst defaults to the
+// rate rounded up, so a 0.5 RPS endpoint still admits one request at a time.
+// A non-positive rate yields a cooldown-only limiter that never paces.
+func NewEndpointLimiter(requestsPerSecond float64, burst int) *EndpointLimiter {
+	if requestsPerSecond <= 0 {
+		return &EndpointLimiter{}
+	}
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(requestsPerSecond)))
+	}
+	return &EndpointLimiter{limiter: rate.NewLimiter(rate.Limit(requestsPerSecond), burst)}
+}
+
+// Wait blocks until the endpoint's token bucket admits one request or ctx is done.
+func (l *EndpointLimiter) Wait(ctx context.Context) error {
+	if l == nil || l.limiter == nil {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return l.limiter.Wait(ctx)
+}
+
+// Cooldown parks the endpoint for d, doubled for every earlier cooldown
+// since it was last healthy and capped at MaxRateLimitCooldown. Calls made
+// while already cooling down don't add strikes; overlapping cooldowns keep
+// the later deadline.
+func (l *EndpointLimiter) Cooldown(d time.Duration) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if !now.Before(l.cooldownUntil) {
+		for i := 0; i < l.strikes && d < MaxRateLimitCooldown; i++ {
+			d *= 2
+		}
+		if d > MaxRateLimitCooldown {
+			d = MaxRateLimitCooldown
+		}
+		l.strikes++
+	}
+	l.degraded = true
+	l.streak = 0
+
+	if until := now.Add(d); until.After(l.cooldownUntil) {
+		l.cooldownUntil = until
+	}
+}
+
+// RecordSuccess counts a successful call towards a degraded endpoint's
+// recovery streak; once the streak is met the endpoint is healthy again and
+// its cooldown backoff resets.
+func (l *EndpointLimiter) RecordSuccess() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.degraded || time.Now().Before(l.cooldownUntil) {
+		return
+	}
+	l.streak++
+	need := l.recoveryStreak
+	if need <= 0 {
+		need = DefaultRecoveryStreak
+	}
+	if l.streak >= need {
+		l.degraded = false
+		l.strikes = 0
+		l.streak = 0
+	}
+}
+
+// SetRecoveryStreak sets how many consecutive successes a degraded endpoint
+// needs to count as healthy. A non-positive n uses DefaultRecoveryStreak.
+func (l *EndpointLimiter) SetRecoveryStreak(n int) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	l.recoveryStreak = n
+	l.mu.Unlock()
+}
+
+// Degraded reports whether the endpoint has been rate limited and has not
+// yet met its recovery streak. A cooling-down endpoint is always degraded.
+func (l *EndpointLimiter) Degraded() bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.degraded
+}
+
+// InCooldown reports whether the endpoint is still parked after a rate-limit response.
+func (l *EndpointLimiter) InCooldown() bool {
+	if l == nil {
+		return false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return time.Now().Before(l.cooldownUntil)
+}
+
+// evmRateLimitCode is EIP-1474's "limit exceeded", which hosted EVM RPCs
+// return when throttling. Solana uses -32005 for an unhealthy node instead,
+// so there only 429 (mirroring the HTTP status) counts.
+const evmRateLimitCode = -32005
+
+// IsRateLimitError reports whether err is an endpoint's rate-limit response
+// rather than a genuine failure: an HTTP 429 from the go-ethereum or Solana
+// JSON-RPC client, or a JSON-RPC error carrying a throttling code. Such
+// endpoints are busy, not broken. The error text is not inspected, so a
+// reverted call whose message happens to mention 429 is not mistaken for one.
+func IsRateLimitError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var gethHTTP gethrpc.HTTPError
+	if errors.As(err, &gethHTTP) {
+		return gethHTTP.StatusCode == http.StatusTooManyRequests
+	}
+	var solanaHTTP *jsonrpc.HTTPError
+	if errors.As(err, &solanaHTTP) {
+		return solanaHTTP.Code == http.StatusTooManyRequests
+	}
+	var solanaRPC *jsonrpc.RPCError
+	if errors.As(err, &solanaRPC) {
+		return solanaRPC.Code == http.StatusTooManyRequests
+	}
+	var gethRPC gethrpc.Error
+	if errors.As(err, &gethRPC) {
+		code := gethRPC.ErrorCode()
+		return code == evmRateLimitCode || code == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// degradedProbeEvery is how many rotations pass between the ones in which a
+// degraded endpoint keeps the lead when the rotation starts on it. Without
+// these probe calls a degraded endpoint would only be reached after its
+// healthy peers fail, and could never build its recovery streak.
+const degradedProbeEvery = 4
+
+// OrderEndpoints returns the n endpoint indices in rotation order starting at
+// start, with degraded endpoints moved behind the healthy ones and endpoints
+// currently in cooldown moved to the back. A degraded endpoint still leads
+// one rotation in degradedProbeEvery so it can recover. Cooling endpoints are
+// still tried as a last resort so a fully throttled pool degrades to slow
+// rather than unavailable.
+func OrderEndpoints(n int, start uint64, limiters []*EndpointLimiter) []int {
+	if n <= 0 {
+		return nil
+	}
+	probe := (start/uint64(n))%degradedProbeEvery == 0
+	ready := make([]int, 0, n)
+	var degraded, cooling []int
+	for attempt := 0; attempt < n; attempt++ {
+		idx := int((start + uint64(attempt)) % uint64(n))
+		var limiter *EndpointLimiter
+		if idx < len(limiters) {
+			limiter = limiters[idx]
+		}
+		switch {
+		case limiter.InCooldown():
+			cooling = append(cooling, idx)
+		case limiter.Degraded() && !(attempt == 0 && probe):
+			degraded = append(degraded, idx)
+		default:
+			ready = append(ready, idx)
+		}
+	}
+	return append(append(ready, degraded...), cooling...)
+}
@@ -0,0 +1,256 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gagliardetto/solana-go/rpc/jsonrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointLimiter_Wait(t *testing.T) {
+	t.Run("paces requests to the configured rate", func(t *testing.T) {
+		l := NewEndpointLimiter(20, 1)
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			require.NoError(t, l.Wait(context.Background()))
+		}
+		// First request is free (burst=1), the remaining 4 wait 50ms each.
+		assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond)
+	})
+
+	t.Run("burst admits requests immediately", func(t *testing.T) {
+		l := NewEndpointLimiter(1, 5)
+
+		start := time.Now()
+		for i := 0; i < 5; i++ {
+			require.NoError(t, l.Wait(context.Background()))
+		}
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("non-positive burst defaults to at least one", func(t *testing.T) {
+		l := NewEndpointLimiter(0.5, 0)
+		require.NoError(t, l.Wait(context.Background()))
+	})
+
+	t.Run("returns when context is canceled", func(t *testing.T) {
+		l := NewEndpointLimiter(0.1, 1)
+		require.NoError(t, l.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		assert.Error(t, l.Wait(ctx))
+	})
+
+	t.Run("non-positive rate never paces but still cools down", func(t *testing.T) {
+		l := NewEndpointLimiter(0, 0)
+		start := time.Now()
+		for i := 0; i < 100; i++ {
+			require.NoError(t, l.Wait(context.Background()))
+		}
+		assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+		l.Cooldown(time.Minute)
+		assert.True(t, l.InCooldown())
+	})
+
+	t.Run("nil limiter never blocks", func(t *testing.T) {
+		var l *EndpointLimiter
+		assert.NoError(t, l.Wait(context.Background()))
+		assert.False(t, l.InCooldown())
+		l.Cooldown(time.Minute) // must not panic
+	})
+}
+
+func TestEndpointLimiter_Cooldown(t *testing.T) {
+	l := NewEndpointLimiter(10, 1)
+	assert.False(t, l.InCooldown())
+
+	l.Cooldown(50 * time.Millisecond)
+	assert.True(t, l.InCooldown())
+
+	// A shorter cooldown must not cut an active one short.
+	l.Cooldown(time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	assert.True(t, l.InCooldown())
+
+	time.Sleep(50 * time.Millisecond)
+	assert.False(t, l.InCooldown())
+}
+
+func TestEndpointLimiter_Recovery(t *testing.T) {
+	t.Run("stays degraded until the success streak is met", func(t *testing.T) {
+		l := NewEndpointLimiter(0, 0)
+		l.SetRecoveryStreak(3)
+		l.Cooldown(10 * time.Millisecond)
+		assert.True(t, l.Degraded())
+
+		// Successes served while still cooling down (as a last resort) don't count.
+		l.RecordSuccess()
+		time.Sleep(20 * time.Millisecond)
+		assert.False(t, l.InCooldown())
+
+		l.RecordSuccess()
+		l.RecordSuccess()
+		assert.True(t, l.Degraded())
+		l.RecordSuccess()
+		assert.False(t, l.Degraded())
+	})
+
+	t.Run("a new cooldown resets the streak", func(t *testing.T) {
+		l := NewEndpointLimiter(0, 0)
+		l.SetRecoveryStreak(2)
+		l.Cooldown(time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		l.RecordSuccess()
+
+		l.Cooldown(time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		l.RecordSuccess()
+		assert.True(t, l.Degraded())
+		l.RecordSuccess()
+		assert.False(t, l.Degraded())
+	})
+
+	t.Run("repeated failures lengthen the backoff", func(t *testing.T) {
+		l := NewEndpointLimiter(0, 0)
+		remaining := func() time.Duration {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			return time.Until(l.cooldownUntil)
+		}
+		expire := func() {
+			l.mu.Lock()
+			l.cooldownUntil = time.Time{}
+			l.mu.Unlock()
+		}
+
+		l.Cooldown(time.Second)
+		assert.InDelta(t, time.Second, remaining(), float64(100*time.Millisecond))
+
+		// A 429 while still cooling down is the same incident, not a new strike.
+		l.Cooldown(time.Second)
+		assert.InDelta(t, time.Second, remaining(), float64(100*time.Millisecond))
+
+		expire()
+		l.Cooldown(time.Second)
+		assert.InDelta(t, 2*time.Second, remaining(), float64(100*time.Millisecond))
+
+		expire()
+		l.Cooldown(time.Second)
+		assert.InDelta(t, 4*time.Second, remaining(), float64(100*time.Millisecond))
+
+		expire()
+		l.Cooldown(time.Hour)
+		assert.InDelta(t, MaxRateLimitCooldown, remaining(), float64(100*time.Millisecond))
+	})
+
+	t.Run("recovery resets the backoff", func(t *testing.T) {
+		l := NewEndpointLimiter(0, 0)
+		l.SetRecoveryStreak(1)
+		l.Cooldown(time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		l.Cooldown(time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		l.RecordSuccess()
+		assert.False(t, l.Degraded())
+
+		l.Cooldown(time.Second)
+		l.mu.Lock()
+		remaining := time.Until(l.cooldownUntil)
+		l.mu.Unlock()
+		assert.InDelta(t, time.Second, remaining, float64(100*time.Millisecond))
+	})
+
+	t.Run("nil limiter is never degraded", func(t *testing.T) {
+		var l *EndpointLimiter
+		l.RecordSuccess()
+		l.SetRecoveryStreak(5)
+		assert.False(t, l.Degraded())
+	})
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"plain error", errors.New("connection refused"), false},
+		{"text alone is not enough", errors.New("execution reverted: 429 Too Many Requests"), false},
+		{"geth HTTP 429", gethrpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}, true},
+		{"geth HTTP 429 wrapped", fmt.Errorf("get block: %w", gethrpc.HTTPError{StatusCode: 429}), true},
+		{"geth HTTP 502", gethrpc.HTTPError{StatusCode: 502, Status: "502 Bad Gateway"}, false},
+		{"geth limit exceeded", rpcCodeError{code: -32005}, true},
+		{"geth execution reverted", rpcCodeError{code: 3}, false},
+		{"solana HTTP 429", jsonrpc.NewHTTPError(429, errors.New("status code: 429")), true},
+		{"solana HTTP 503", jsonrpc.NewHTTPError(503, errors.New("status code: 503")), false},
+		{"solana RPC 429", &jsonrpc.RPCError{Code: 429, Message: "Too many requests for a specific RPC call"}, true},
+		{"solana RPC node unhealthy", &jsonrpc.RPCError{Code: -32005, Message: "Node is unhealthy"}, false},
+		{"solana RPC invalid params", &jsonrpc.RPCError{Code: -32602}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRateLimitError(tt.err))
+		})
+	}
+}
+
+// rpcCodeError is a JSON-RPC error as go-ethereum's client returns it.
+type rpcCodeError struct{ code int }
+
+func (e rpcCodeError) Error() string  { return "rpc error" }
+func (e rpcCodeError) ErrorCode() int { return e.code }
+
+func TestOrderEndpoints(t *testing.T) {
+	t.Run("plain rotation without limiters", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 0}, OrderEndpoints(3, 1, nil))
+	})
+
+	t.Run("cooling endpoints are tried last but not dropped", func(t *testing.T) {
+		limiters := []*EndpointLimiter{
+			NewEndpointLimiter(10, 1),
+			NewEndpointLimiter(10, 1),
+			nil,
+		}
+		limiters[0].Cooldown(time.Minute)
+
+		assert.Equal(t, []int{1, 2, 0}, OrderEndpoints(3, 0, limiters))
+		assert.Equal(t, []int{2, 1, 0}, OrderEndpoints(3, 2, limiters))
+	})
+
+	t.Run("degraded endpoints follow healthy ones", func(t *testing.T) {
+		limiters := []*EndpointLimiter{
+			NewEndpointLimiter(10, 1),
+			NewEndpointLimiter(10, 1),
+			NewEndpointLimiter(10, 1),
+		}
+		limiters[0].Cooldown(time.Millisecond)
+		limiters[1].Cooldown(time.Minute)
+		time.Sleep(5 * time.Millisecond)
+		require.True(t, limiters[0].Degraded())
+		require.False(t, limiters[0].InCooldown())
+
+		// Rotation 1 (start 3..5) is not a probe rotation.
+		assert.Equal(t, []int{2, 0, 1}, OrderEndpoints(3, 3, limiters))
+		assert.Equal(t, []int{2, 0, 1}, OrderEndpoints(3, 5, limiters))
+	})
+
+	t.Run("a degraded endpoint leads its probe rotation", func(t *testing.T) {
+		limiters := []*EndpointLimiter{NewEndpointLimiter(10, 1), NewEndpointLimiter(10, 1)}
+		limiters[0].Cooldown(time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+
+		assert.Equal(t, []int{0, 1}, OrderEndpoints(2, 0, limiters), "rotation 0 probes")
+		assert.Equal(t, []int{1, 0}, OrderEndpoints(2, 2, limiters), "rotation 1 does not")
+		assert.Equal(t, []int{0, 1}, OrderEndpoints(2, 2*degradedProbeEvery, limiters))
+	})
+}
@@ -19,9 +19,10 @@ const (
 
 // EventCleaner handles periodic cleanup of old confirmed events for a chain
 type EventCleaner struct {
-	database        *db.DB
+	database        db.Store
 	cleanupInterval time.Duration
 	retentionPeriod time.Duration
+	rawRetention    time.Duration // 0: raw events are not captured, nothing to prune
 	logger          zerolog.Logger
 	ticker          *time.Ticker
 	stopCh          chan struct{}
@@ -30,7 +31,7 @@ type EventCleaner struct {
 
 // NewEventCleaner creates a new event cleaner for a chain
 func NewEventCleaner(
-	database *db.DB,
+	database db.Store,
 	cleanupIntervalSeconds *int,
 	retentionPeriodSeconds *int,
 	chainID string,
@@ -52,6 +53,14 @@ func NewEventCleaner(
 	}
 }
 
+// SetRawEventRetention makes each cleanup also prune raw events older than
+// retentionSeconds. Nil or non-positive leaves raw events alone.
+func (ec *EventCleaner) SetRawEventRetention(retentionSeconds *int) {
+	if retentionSeconds != nil && *retentionSeconds > 0 {
+		ec.rawRetention = time.Duration(*retentionSeconds) * time.Second
+	}
+}
+
 // Start begins the periodic cleanup process
 func (ec *EventCleaner) Start(ctx context.Context) error {
 	if ec.running {
@@ -123,11 +132,20 @@ func (ec *EventCleaner) performCleanup() error {
 		return fmt.Errorf("failed to cleanup events: %w", err)
 	}
 
+	var rawDeleted int64
+	if ec.rawRetention > 0 {
+		rawDeleted, err = chainStore.DeleteRawEventsBefore(time.Now().Add(-ec.rawRetention))
+		if err != nil {
+			return fmt.Errorf("failed to cleanup raw events: %w", err)
+		}
+	}
+
 	duration := time.Since(start)
 
-	if deletedCount > 0 {
+	if deletedCount > 0 || rawDeleted > 0 {
 		ec.logger.Info().
 			Int64("deleted_count", deletedCount).
+			Int64("raw_deleted_count", rawDeleted).
 			Str("duration", duration.String()).
 			Msg("terminal event cleanup completed (COMPLETED, REORGED, REVERTED)")
 
@@ -144,6 +162,11 @@ func (ec *EventCleaner) performCleanup() error {
 
 // checkpointWAL performs WAL checkpointing for the database
 func (ec *EventCleaner) checkpointWAL() {
+	// WAL checkpointing is SQLite-specific; other backends manage their own logs
+	if ec.database.Driver() != db.DriverSQLite {
+		return
+	}
+
 	ec.logger.Debug().Msg("performing WAL checkpoint")
 
 	// Use PRAGMA wal_checkpoint(TRUNCATE) to force a checkpoint and truncate the WAL
@@ -208,6 +208,30 @@ func TestPerformCleanup(t *testing.T) {
 		assert.Len(t, remaining, 1)
 	})
 
+	t.Run("prunes raw events older than raw retention", func(t *testing.T) {
+		database := newTestCleanerDB(t, nil)
+		cs := NewChainStore(database)
+		require.NoError(t, cs.InsertRawEvent(&storemodels.RawEvent{EventID: "old", BlockHeight: 1}))
+		require.NoError(t, cs.InsertRawEvent(&storemodels.RawEvent{EventID: "new", BlockHeight: 2}))
+		require.NoError(t, database.Client().Model(&storemodels.RawEvent{}).
+			Where("event_id = ?", "old").
+			Update("created_at", time.Now().Add(-2*time.Hour)).Error)
+
+		// Without a raw retention the cleaner leaves raw events alone.
+		cleaner := NewEventCleaner(database, intPtr(3600), intPtr(0), "test-chain", zerolog.Nop())
+		require.NoError(t, cleaner.performCleanup())
+		var count int64
+		require.NoError(t, database.Client().Model(&storemodels.RawEvent{}).Count(&count).Error)
+		assert.Equal(t, int64(2), count)
+
+		cleaner.SetRawEventRetention(intPtr(3600))
+		require.NoError(t, cleaner.performCleanup())
+		var remaining []storemodels.RawEvent
+		require.NoError(t, database.Client().Find(&remaining).Error)
+		require.Len(t, remaining, 1)
+		assert.Equal(t, "new", remaining[0].EventID)
+	})
+
 	t.Run("no events to delete returns no error", func(t *testing.T) {
 		database := newTestCleanerDB(t, nil)
 		cleaner := NewEventCleaner(database, intPtr(3600), intPtr(0), "test-chain", zerolog.Nop())
@@ -26,6 +26,7 @@ type EventProcessor struct {
 	chainID         string
 	inboundEnabled  bool
 	outboundEnabled bool
+	reportTurn      ReportTurnFunc
 	running         bool
 	stopCh          chan struct{}
 	wg              sync.WaitGroup
@@ -34,7 +35,7 @@ type EventProcessor struct {
 // NewEventProcessor creates a new event processor
 func NewEventProcessor(
 	signer *pushsigner.Signer,
-	database *db.DB,
+	database db.Store,
 	chainID string,
 	inboundEnabled bool,
 	outboundEnabled bool,
@@ -82,6 +83,18 @@ func (ep *EventProcessor) Stop() error {
 	return nil
 }
 
+// ReportTurnFunc reports whether this validator is due to vote the result
+// of the outbound observed in eventID, given how long the result has been
+// waiting for a report since pendingSince.
+type ReportTurnFunc func(eventID string, pendingSince time.Time) bool
+
+// SetReportTurn restricts outbound result votes to the validators whose turn
+// it is; the others park the event in STANDBY until they are due to take
+// over. Without it every validator votes every outbound. Call it before Start.
+func (ep *EventProcessor) SetReportTurn(reportTurn ReportTurnFunc) {
+	ep.reportTurn = reportTurn
+}
+
 // IsRunning returns whether the processor is currently running
 func (ep *EventProcessor) IsRunning() bool {
 	return ep.running
@@ -107,6 +120,9 @@ func (ep *EventProcessor) processLoop(ctx context.Context) {
 			if err := ep.processConfirmedEvents(ctx); err != nil {
 				ep.logger.Error().Err(err).Msg("failed to process confirmed events")
 			}
+			if err := ep.processStandbyEvents(ctx); err != nil {
+				ep.logger.Error().Err(err).Msg("failed to process standby events")
+			}
 		}
 	}
 }
@@ -136,6 +152,10 @@ func (ep *EventProcessor) processConfirmedEvents(ctx context.Context) error {
 				ep.logger.Warn().Str("event_id", event.EventID).Msg("outbound disabled, skipping outbound event processing")
 				continue
 			}
+			if ep.reportTurn != nil && !ep.reportTurn(event.EventID, time.Now()) {
+				ep.standby(&event)
+				continue
+			}
 			if err := ep.processOutboundEvent(ctx, &event); err != nil {
 				ep.logger.Error().
 					Err(err).
@@ -149,6 +169,47 @@ func (ep *EventProcessor) processConfirmedEvents(ctx context.Context) error {
 	return nil
 }
 
+// standby moves a confirmed outbound this validator is not yet due to report
+// out of CONFIRMED, so it is not re-read on every tick and does not crowd
+// out the events that still need a vote.
+func (ep *EventProcessor) standby(event *store.Event) {
+	rowsAffected, err := ep.chainStore.UpdateEventStatus(event.EventID, store.StatusConfirmed, store.StatusStandby)
+	if err != nil {
+		ep.logger.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to move outbound event to STANDBY")
+		return
+	}
+	if rowsAffected > 0 {
+		ep.logger.Debug().Str("event_id", event.EventID).Msg("not this node's turn to report, outbound event on STANDBY")
+	}
+}
+
+// processStandbyEvents votes the standby outbounds whose reporters have not
+// voted within their turn. An outbound finalized in the meantime is not voted
+// again (see pushsigner.Signer.VoteOutbound) and is simply marked COMPLETED.
+func (ep *EventProcessor) processStandbyEvents(ctx context.Context) error {
+	if ep.reportTurn == nil || !ep.outboundEnabled {
+		return nil
+	}
+	events, err := ep.chainStore.GetStandbyEvents(1000)
+	if err != nil {
+		return fmt.Errorf("failed to get standby events: %w", err)
+	}
+
+	for _, event := range events {
+		if !ep.reportTurn(event.EventID, event.UpdatedAt) {
+			continue
+		}
+		if err := ep.processOutboundEvent(ctx, &event); err != nil {
+			ep.logger.Error().
+				Err(err).
+				Str("event_id", event.EventID).
+				Msg("failed to vote on standby outbound event")
+		}
+	}
+
+	return nil
+}
+
 // processOutboundEvent processes an outbound event by voting on it
 func (ep *EventProcessor) processOutboundEvent(ctx context.Context, event *store.Event) error {
 	ep.logger.Debug().
@@ -177,7 +238,7 @@ func (ep *EventProcessor) processOutboundEvent(ctx context.Context, event *store
 	}
 
 	// Atomically record vote hash and flip status in one DB write
-	rowsAffected, err := ep.chainStore.UpdateStatusAndVoteTxHash(event.EventID, store.StatusConfirmed, store.StatusCompleted, voteTxHash)
+	rowsAffected, err := ep.chainStore.UpdateStatusAndVoteTxHash(event.EventID, event.Status, store.StatusCompleted, voteTxHash)
 	if err != nil {
 		return fmt.Errorf("failed to update event status and vote_tx_hash: %w", err)
 	}
@@ -207,6 +268,14 @@ func (ep *EventProcessor) processInboundEvent(ctx context.Context, event *store.
 		return fmt.Errorf("failed to construct inbound: %w", err)
 	}
 
+	// Key the captured raw event (if any) by the utx id it is voted under
+	if _, err := ep.chainStore.SetRawEventUniversalTxID(event.EventID, uexecutortypes.GetInboundUniversalTxKey(*inbound)); err != nil {
+		ep.logger.Warn().
+			Str("event_id", event.EventID).
+			Err(err).
+			Msg("failed to record utx id on raw event")
+	}
+
 	// Execute vote on blockchain
 	voteTxHash, err := ep.signer.VoteInbound(ctx, inbound)
 	if err != nil {
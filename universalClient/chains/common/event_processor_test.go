@@ -1054,3 +1054,73 @@ func TestProcessConfirmedEventsEnabledFlags(t *testing.T) {
 		assert.Equal(t, store.StatusConfirmed, inboundEvt.Status)
 	})
 }
+
+func TestProcessConfirmedEventsReportTurn(t *testing.T) {
+	logger := zerolog.Nop()
+	ctx := context.Background()
+
+	outboundEventData, _ := json.Marshal(OutboundEvent{
+		TxID:          "0xtxid",
+		UniversalTxID: "0xutxid",
+	})
+
+	setupDB := func(t *testing.T, status string) *ucdb.DB {
+		t.Helper()
+		database, err := ucdb.OpenInMemoryDB(true)
+		require.NoError(t, err)
+		t.Cleanup(func() { database.Close() })
+		require.NoError(t, database.Client().Create(&store.Event{
+			EventID:   "0xbbb:0",
+			Status:    status,
+			Type:      store.EventTypeOutbound,
+			EventData: outboundEventData,
+		}).Error)
+		return database
+	}
+	statusOf := func(t *testing.T, database *ucdb.DB) string {
+		t.Helper()
+		var evt store.Event
+		require.NoError(t, database.Client().Where("event_id = ?", "0xbbb:0").First(&evt).Error)
+		return evt.Status
+	}
+
+	t.Run("outbound not due to report moves to STANDBY", func(t *testing.T) {
+		database := setupDB(t, store.StatusConfirmed)
+		ep := NewEventProcessor(nil, database, "eip155:1", true, true, logger)
+		var asked string
+		ep.SetReportTurn(func(eventID string, _ time.Time) bool {
+			asked = eventID
+			return false
+		})
+
+		require.NoError(t, ep.processConfirmedEvents(ctx))
+		assert.Equal(t, "0xbbb:0", asked)
+		assert.Equal(t, store.StatusStandby, statusOf(t, database))
+
+		confirmed, err := ep.chainStore.GetConfirmedEvents(10)
+		require.NoError(t, err)
+		assert.Empty(t, confirmed)
+	})
+
+	t.Run("standby outbound waits for its turn", func(t *testing.T) {
+		database := setupDB(t, store.StatusStandby)
+		ep := NewEventProcessor(nil, database, "eip155:1", true, true, logger)
+		var since time.Time
+		ep.SetReportTurn(func(_ string, pendingSince time.Time) bool {
+			since = pendingSince
+			return false
+		})
+
+		require.NoError(t, ep.processStandbyEvents(ctx))
+		assert.False(t, since.IsZero(), "standby wait is measured from the event's last update")
+		assert.Equal(t, store.StatusStandby, statusOf(t, database))
+	})
+
+	t.Run("standby outbounds are ignored without a report turn", func(t *testing.T) {
+		database := setupDB(t, store.StatusStandby)
+		ep := NewEventProcessor(nil, database, "eip155:1", true, true, logger)
+
+		require.NoError(t, ep.processStandbyEvents(ctx))
+		assert.Equal(t, store.StatusStandby, statusOf(t, database))
+	})
+}
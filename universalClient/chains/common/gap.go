@@ -0,0 +1,26 @@
+package common
+
+// DefaultMaxGapBackfill bounds how many blocks (or slots) an inbound listener
+// rescans per poll when closing a gap; larger gaps close over several polls.
+const DefaultMaxGapBackfill uint64 = 10000
+
+// DetectGap compares the next block a listener is about to scan with the
+// persisted checkpoint (last fully processed block). If blocks between them
+// were never checkpointed — e.g. a range was skipped after an endpoint
+// failover or a failed checkpoint write — it returns the first missed range to
+// rescan, capped at maxBackfill blocks. A zero checkpoint means no state yet
+// (first run), which is never a gap.
+func DetectGap(checkpoint, next, maxBackfill uint64) (from, to uint64, ok bool) {
+	if checkpoint == 0 || maxBackfill == 0 {
+		return 0, 0, false
+	}
+	expected := checkpoint + 1
+	if next <= expected {
+		return 0, 0, false
+	}
+	from, to = expected, next-1
+	if to-from+1 > maxBackfill {
+		to = from + maxBackfill - 1
+	}
+	return from, to, true
+}
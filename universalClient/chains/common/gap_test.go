@@ -0,0 +1,34 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectGap(t *testing.T) {
+	tests := []struct {
+		name       string
+		checkpoint uint64
+		next       uint64
+		max        uint64
+		wantFrom   uint64
+		wantTo     uint64
+		wantOK     bool
+	}{
+		{name: "no state yet", checkpoint: 0, next: 500, max: 100},
+		{name: "contiguous", checkpoint: 99, next: 100, max: 100},
+		{name: "resuming at the checkpoint", checkpoint: 100, next: 100, max: 100},
+		{name: "gap", checkpoint: 99, next: 150, max: 100, wantFrom: 100, wantTo: 149, wantOK: true},
+		{name: "gap larger than the cap", checkpoint: 99, next: 1000, max: 100, wantFrom: 100, wantTo: 199, wantOK: true},
+		{name: "backfill disabled", checkpoint: 99, next: 150, max: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, ok := DetectGap(tt.checkpoint, tt.next, tt.max)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantFrom, from)
+			assert.Equal(t, tt.wantTo, to)
+		})
+	}
+}
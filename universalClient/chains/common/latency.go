@@ -0,0 +1,159 @@
+package common
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultLatencyWindow is how many recent calls per endpoint feed its
+// latency percentiles.
+const DefaultLatencyWindow = 256
+
+// LatencyWindow keeps the latencies of the most recent calls to one RPC
+// endpoint in a ring buffer so tail latency can be reported without
+// unbounded memory. A nil *LatencyWindow records nothing.
+type LatencyWindow struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	full    bool
+}
+
+// NewLatencyWindow creates a window holding the last size samples.
+// A non-positive size uses DefaultLatencyWindow.
+func NewLatencyWindow(size int) *LatencyWindow {
+	if size <= 0 {
+		size = DefaultLatencyWindow
+	}
+	return &LatencyWindow{samples: make([]time.Duration, size)}
+}
+
+// Observe records one call latency, evicting the oldest once the window is full.
+func (w *LatencyWindow) Observe(d time.Duration) {
+	if w == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % len(w.samples)
+	if w.next == 0 {
+		w.full = true
+	}
+}
+
+// LatencyPercentiles summarizes the latencies currently in a window.
+type LatencyPercentiles struct {
+	Count int
+	P50   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+}
+
+// Percentiles returns p50/p95/p99 over the window using nearest-rank, or a
+// zero value when nothing has been observed.
+func (w *LatencyWindow) Percentiles() LatencyPercentiles {
+	if w == nil {
+		return LatencyPercentiles{}
+	}
+	w.mu.Lock()
+	n := w.next
+	if w.full {
+		n = len(w.samples)
+	}
+	sorted := make([]time.Duration, n)
+	copy(sorted, w.samples[:n])
+	w.mu.Unlock()
+
+	if n == 0 {
+		return LatencyPercentiles{}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := func(p int) time.Duration {
+		// Nearest-rank: the smallest sample with at least p% of samples at or below it.
+		idx := (p*n+99)/100 - 1
+		if idx < 0 {
+			idx = 0
+		}
+		return sorted[idx]
+	}
+	return LatencyPercentiles{Count: n, P50: rank(50), P95: rank(95), P99: rank(99)}
+}
+
+// EndpointStats is a point-in-time view of one RPC endpoint.
+type EndpointStats struct {
+	Endpoint   string // redacted URL
+	InCooldown bool
+	Degraded   bool
+	Latency    LatencyPercentiles
+}
+
+var (
+	endpointLatencyDesc = prometheus.NewDesc(
+		"puniversal_rpc_endpoint_latency_seconds",
+		"RPC call latency of an endpoint over its recent calls, by quantile.",
+		[]string{"chain", "endpoint", "quantile"}, nil,
+	)
+	endpointDegradedDesc = prometheus.NewDesc(
+		"puniversal_rpc_endpoint_degraded",
+		"1 while an endpoint is marked degraded after repeated rate limiting.",
+		[]string{"chain", "endpoint"}, nil,
+	)
+	endpointCooldownDesc = prometheus.NewDesc(
+		"puniversal_rpc_endpoint_in_cooldown",
+		"1 while an endpoint is cooling down after a rate limit.",
+		[]string{"chain", "endpoint"}, nil,
+	)
+)
+
+// endpointStatsCollector exports the endpoint stats reported by a callback.
+type endpointStatsCollector struct {
+	stats func() map[string][]EndpointStats
+}
+
+// EndpointStatsCollector returns a collector for the metrics endpoint that
+// reports, on every scrape, the endpoint stats returned by stats (chain ID →
+// stats per endpoint). Endpoints with no recorded calls export no latency.
+func EndpointStatsCollector(stats func() map[string][]EndpointStats) prometheus.Collector {
+	return endpointStatsCollector{stats: stats}
+}
+
+func (c endpointStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- endpointLatencyDesc
+	ch <- endpointDegradedDesc
+	ch <- endpointCooldownDesc
+}
+
+func (c endpointStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.stats()
+	chainIDs := make([]string, 0, len(stats))
+	for chainID := range stats {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+
+	flag := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	for _, chainID := range chainIDs {
+		for _, s := range stats[chainID] {
+			ch <- prometheus.MustNewConstMetric(endpointDegradedDesc, prometheus.GaugeValue, flag(s.Degraded), chainID, s.Endpoint)
+			ch <- prometheus.MustNewConstMetric(endpointCooldownDesc, prometheus.GaugeValue, flag(s.InCooldown), chainID, s.Endpoint)
+			if s.Latency.Count == 0 {
+				continue
+			}
+			for _, q := range []struct {
+				quantile string
+				value    time.Duration
+			}{{"0.5", s.Latency.P50}, {"0.95", s.Latency.P95}, {"0.99", s.Latency.P99}} {
+				ch <- prometheus.MustNewConstMetric(endpointLatencyDesc, prometheus.GaugeValue, q.value.Seconds(), chainID, s.Endpoint, q.quantile)
+			}
+		}
+	}
+}
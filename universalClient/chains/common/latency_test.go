@@ -0,0 +1,95 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatencyWindow_Percentiles(t *testing.T) {
+	t.Run("known distribution", func(t *testing.T) {
+		w := NewLatencyWindow(100)
+		// 1ms..100ms in shuffled order.
+		for i := 0; i < 100; i++ {
+			w.Observe(time.Duration((i*37)%100+1) * time.Millisecond)
+		}
+
+		got := w.Percentiles()
+		assert.Equal(t, 100, got.Count)
+		assert.Equal(t, 50*time.Millisecond, got.P50)
+		assert.Equal(t, 95*time.Millisecond, got.P95)
+		assert.Equal(t, 99*time.Millisecond, got.P99)
+	})
+
+	t.Run("window keeps only the most recent samples", func(t *testing.T) {
+		w := NewLatencyWindow(10)
+		for i := 0; i < 10; i++ {
+			w.Observe(time.Second)
+		}
+		for i := 0; i < 10; i++ {
+			w.Observe(time.Millisecond)
+		}
+
+		got := w.Percentiles()
+		assert.Equal(t, 10, got.Count)
+		assert.Equal(t, time.Millisecond, got.P99)
+	})
+
+	t.Run("tail dominated by a few slow calls", func(t *testing.T) {
+		w := NewLatencyWindow(0)
+		for i := 0; i < 90; i++ {
+			w.Observe(10 * time.Millisecond)
+		}
+		for i := 0; i < 10; i++ {
+			w.Observe(time.Second)
+		}
+
+		got := w.Percentiles()
+		assert.Equal(t, 10*time.Millisecond, got.P50)
+		assert.Equal(t, time.Second, got.P95)
+		assert.Equal(t, time.Second, got.P99)
+	})
+
+	t.Run("empty and nil windows report zero", func(t *testing.T) {
+		assert.Equal(t, LatencyPercentiles{}, NewLatencyWindow(4).Percentiles())
+
+		var w *LatencyWindow
+		w.Observe(time.Second) // must not panic
+		assert.Equal(t, LatencyPercentiles{}, w.Percentiles())
+	})
+}
+
+func TestEndpointStatsCollector(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(EndpointStatsCollector(func() map[string][]EndpointStats {
+		return map[string][]EndpointStats{"eip155:1": {
+			{Endpoint: "https://a", Degraded: true, Latency: LatencyPercentiles{Count: 3, P50: 10 * time.Millisecond, P95: 20 * time.Millisecond, P99: 30 * time.Millisecond}},
+			{Endpoint: "https://b", InCooldown: true},
+		}}
+	})))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	got := map[string]float64{}
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			key := f.GetName()
+			for _, l := range m.GetLabel() {
+				key += "," + l.GetValue()
+			}
+			got[key] = m.GetGauge().GetValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"puniversal_rpc_endpoint_degraded,eip155:1,https://a":             1,
+		"puniversal_rpc_endpoint_degraded,eip155:1,https://b":             0,
+		"puniversal_rpc_endpoint_in_cooldown,eip155:1,https://a":          0,
+		"puniversal_rpc_endpoint_in_cooldown,eip155:1,https://b":          1,
+		"puniversal_rpc_endpoint_latency_seconds,eip155:1,https://a,0.5":  0.01,
+		"puniversal_rpc_endpoint_latency_seconds,eip155:1,https://a,0.95": 0.02,
+		"puniversal_rpc_endpoint_latency_seconds,eip155:1,https://a,0.99": 0.03,
+	}, got, "no latency is exported for an endpoint without calls")
+}
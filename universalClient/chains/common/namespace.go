@@ -0,0 +1,33 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// CAIP-2 namespaces handled by the compiled-in tx builders.
+const (
+	NamespaceEVM = "eip155"
+	NamespaceSVM = "solana"
+)
+
+var supportedNamespaces = map[string]bool{
+	NamespaceEVM: true,
+	NamespaceSVM: true,
+}
+
+// ErrUnsupportedChainNamespace is returned for a chain ID whose CAIP-2
+// namespace no compiled-in tx builder handles. Such an outbound can never be
+// signed by this binary, so callers reject it before any TSS work.
+var ErrUnsupportedChainNamespace = errors.New("unsupported chain namespace")
+
+// CheckChainNamespace returns an error wrapping ErrUnsupportedChainNamespace
+// unless chainID is "<namespace>:<reference>" with a supported namespace.
+func CheckChainNamespace(chainID string) error {
+	namespace, reference, ok := strings.Cut(chainID, ":")
+	if !ok || reference == "" || !supportedNamespaces[namespace] {
+		return fmt.Errorf("%w: %q", ErrUnsupportedChainNamespace, chainID)
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckChainNamespace(t *testing.T) {
+	for _, chainID := range []string{"eip155:1", "eip155:11155111", "solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1"} {
+		assert.NoError(t, CheckChainNamespace(chainID), chainID)
+	}
+	for _, chainID := range []string{"cosmos:cosmoshub-4", "bip122:000000000019d6689c085ae165831e93", "eip155", "eip155:", ""} {
+		assert.ErrorIs(t, CheckChainNamespace(chainID), ErrUnsupportedChainNamespace, chainID)
+	}
+}
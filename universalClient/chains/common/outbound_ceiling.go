@@ -0,0 +1,44 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// OutboundCeilings caps the amount a single outbound may move, per
+// destination chain and asset, in base units of that asset. Outbounds above
+// their ceiling are held for manual review instead of being signed. Assets
+// without a ceiling are uncapped.
+type OutboundCeilings map[string]map[string]*big.Int
+
+// Set parses a decimal ceiling for asset on chainID. An empty max leaves the
+// asset uncapped.
+func (c OutboundCeilings) Set(chainID, asset, max string) error {
+	if max == "" {
+		return nil
+	}
+	v, ok := new(big.Int).SetString(max, 10)
+	if !ok || v.Sign() < 0 {
+		return fmt.Errorf("invalid max outbound amount for %s on chain %s: %q", asset, chainID, max)
+	}
+	if c[chainID] == nil {
+		c[chainID] = make(map[string]*big.Int)
+	}
+	c[chainID][AssetKey(asset)] = v
+	return nil
+}
+
+// Exceeds reports whether an outbound of amount of asset to chainID is above
+// that asset's ceiling. An empty amount counts as zero; an unparseable one
+// exceeds any ceiling so a malformed event is never auto-processed.
+func (c OutboundCeilings) Exceeds(chainID, asset, amount string) bool {
+	ceiling, ok := c[chainID][AssetKey(asset)]
+	if !ok {
+		return false
+	}
+	if amount == "" {
+		amount = "0"
+	}
+	v, ok := new(big.Int).SetString(amount, 10)
+	return !ok || v.Cmp(ceiling) > 0
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const usdc = "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"
+
+func TestOutboundCeilings(t *testing.T) {
+	c := OutboundCeilings{}
+	require.NoError(t, c.Set("eip155:1", NativeAsset, "1000000000000000000000"))
+	require.NoError(t, c.Set("eip155:1", usdc, "1000000000"))
+	require.NoError(t, c.Set("eip155:56", NativeAsset, ""))
+	assert.Error(t, c.Set("eip155:10", NativeAsset, "1e18"))
+	assert.Error(t, c.Set("eip155:10", NativeAsset, "-1"))
+	assert.NotContains(t, c, "eip155:10")
+
+	native := "0x0000000000000000000000000000000000000000"
+	assert.False(t, c.Exceeds("eip155:1", native, "1000000000000000000000"), "at the ceiling")
+	assert.True(t, c.Exceeds("eip155:1", native, "1000000000000000000001"))
+	assert.False(t, c.Exceeds("eip155:1", native, ""))
+	assert.True(t, c.Exceeds("eip155:1", native, "0x10"), "unparseable amount")
+	assert.False(t, c.Exceeds("eip155:56", native, "999999999999999999999999999"), "uncapped chain")
+
+	// Each asset is capped in its own base units.
+	assert.True(t, c.Exceeds("eip155:1", usdc, "1000000001"), "6-decimal asset has its own ceiling")
+	assert.True(t, c.Exceeds("eip155:1", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", "1000000001"), "hex addresses match case-insensitively")
+	assert.False(t, c.Exceeds("eip155:1", "0x1111111111111111111111111111111111111111", "999999999999999999999999999"), "uncapped asset")
+
+	var none OutboundCeilings
+	assert.False(t, none.Exceeds("eip155:1", native, "1"))
+}
+
+func TestAssetKey(t *testing.T) {
+	for addr, want := range map[string]string{
+		"":       NativeAsset,
+		"0x0":    NativeAsset,
+		"native": NativeAsset,
+		"0x0000000000000000000000000000000000000000": NativeAsset,
+		usdc: "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+		"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+	} {
+		assert.Equal(t, want, AssetKey(addr), addr)
+	}
+}
@@ -0,0 +1,67 @@
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultRateWindow is the span a RateMeter averages over.
+const DefaultRateWindow = time.Minute
+
+// RateMeter reports how many events per second were marked over a sliding
+// window. A nil *RateMeter records nothing.
+type RateMeter struct {
+	mu      sync.Mutex
+	window  time.Duration
+	samples []rateSample // oldest first
+}
+
+type rateSample struct {
+	at time.Time
+	n  int
+}
+
+// NewRateMeter creates a meter averaging over window. A non-positive window
+// uses DefaultRateWindow.
+func NewRateMeter(window time.Duration) *RateMeter {
+	if window <= 0 {
+		window = DefaultRateWindow
+	}
+	return &RateMeter{window: window}
+}
+
+// Mark records n events at the given time.
+func (m *RateMeter) Mark(n int, at time.Time) {
+	if m == nil || n <= 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.samples = append(m.samples, rateSample{at: at, n: n})
+	m.prune(at)
+}
+
+// Rate returns the events per second marked within the window ending at now.
+func (m *RateMeter) Rate(now time.Time) float64 {
+	if m == nil {
+		return 0
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prune(now)
+	var total int
+	for _, s := range m.samples {
+		total += s.n
+	}
+	return float64(total) / m.window.Seconds()
+}
+
+// prune drops samples that fell out of the window ending at now.
+func (m *RateMeter) prune(now time.Time) {
+	cutoff := now.Add(-m.window)
+	i := 0
+	for i < len(m.samples) && !m.samples[i].at.After(cutoff) {
+		i++
+	}
+	m.samples = m.samples[i:]
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateMeter(t *testing.T) {
+	t0 := time.Unix(1_700_000_000, 0)
+
+	t.Run("averages over the window", func(t *testing.T) {
+		m := NewRateMeter(10 * time.Second)
+		m.Mark(30, t0)
+		m.Mark(20, t0.Add(5*time.Second))
+
+		assert.InDelta(t, 5.0, m.Rate(t0.Add(5*time.Second)), 1e-9)
+		// The first sample ages out; only the second remains.
+		assert.InDelta(t, 2.0, m.Rate(t0.Add(12*time.Second)), 1e-9)
+		assert.Zero(t, m.Rate(t0.Add(time.Minute)))
+	})
+
+	t.Run("nil meter and non-positive counts record nothing", func(t *testing.T) {
+		m := NewRateMeter(0)
+		m.Mark(0, t0)
+		m.Mark(-3, t0)
+		assert.Zero(t, m.Rate(t0))
+
+		var nilMeter *RateMeter
+		nilMeter.Mark(5, t0) // must not panic
+		assert.Zero(t, nilMeter.Rate(t0))
+	})
+}
@@ -0,0 +1,109 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	uetypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+)
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// NormalizeLowS returns a copy of a 65-byte [r(32)|s(32)|v(1)] secp256k1
+// signature with s in the lower half of the curve order. TSS may produce a
+// high-S signature, which is equally valid but rejected by EVM nodes (EIP-2)
+// and by strict on-chain verifiers. Negating s mirrors the recovery point, so
+// the recovery ID's parity bit is flipped to keep recovering the same key.
+func NormalizeLowS(signature []byte) ([]byte, error) {
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("signature must be 65 bytes [r(32)|s(32)|v(1)], got %d", len(signature))
+	}
+	out := make([]byte, 65)
+	copy(out, signature)
+
+	s := new(big.Int).SetBytes(out[32:64])
+	if s.Cmp(secp256k1HalfN) <= 0 {
+		return out, nil
+	}
+	s.Sub(secp256k1N, s)
+	s.FillBytes(out[32:64])
+	out[64] ^= 1
+	return out, nil
+}
+
+// FindRecoveryID tries each recovery ID, hint first, for the 64-byte r||s
+// signature over hash and reports the one that recovers to expected.
+// recovered holds the outcome of every attempt made, indexed by recovery ID:
+// the recovered address, or the recovery error.
+func FindRecoveryID(hash, signature []byte, expected ethcommon.Address, hint byte) (v byte, recovered [4]string, ok bool) {
+	try := func(v byte) bool {
+		pub, err := crypto.SigToPub(hash, append(append([]byte{}, signature...), v))
+		if err != nil {
+			recovered[v] = fmt.Sprintf("error(%v)", err)
+			return false
+		}
+		addr := crypto.PubkeyToAddress(*pub)
+		recovered[v] = addr.Hex()
+		return addr == expected
+	}
+
+	if hint < 4 && try(hint) {
+		return hint, recovered, true
+	}
+	for v := byte(0); v < 4; v++ {
+		if v != hint && try(v) {
+			return v, recovered, true
+		}
+	}
+	return 0, recovered, false
+}
+
+// SignatureCheck is the result of VerifyOutboundSignature.
+type SignatureCheck struct {
+	SigningHash []byte
+	Valid       bool
+	RecoveryID  byte      // recovery ID that yields the expected address; set when Valid
+	Recovered   [4]string // address (or error) recovered under each recovery ID tried
+}
+
+// VerifyOutboundSignature rebuilds an outbound's signing hash with the
+// destination chain's builder and checks whether signature (64-byte r||s or
+// 65-byte r||s||v) recovers to the expected TSS address. Intended for offline
+// debugging of outbounds rejected on-chain with a signature error.
+func VerifyOutboundSignature(
+	ctx context.Context,
+	builder TxBuilder,
+	data *uetypes.OutboundCreatedEvent,
+	nonce uint64,
+	signature []byte,
+	expected ethcommon.Address,
+) (*SignatureCheck, error) {
+	var hint byte
+	switch len(signature) {
+	case 64:
+	case 65:
+		hint = signature[64]
+	default:
+		return nil, fmt.Errorf("signature must be 64 or 65 bytes, got %d", len(signature))
+	}
+
+	req, err := builder.GetOutboundSigningRequest(ctx, data, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebuild signing request: %w", err)
+	}
+
+	v, recovered, ok := FindRecoveryID(req.SigningHash, signature[:64], expected, hint)
+	return &SignatureCheck{
+		SigningHash: req.SigningHash,
+		Valid:       ok,
+		RecoveryID:  v,
+		Recovered:   recovered,
+	}, nil
+}
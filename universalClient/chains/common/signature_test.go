@@ -0,0 +1,121 @@
+package common
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uetypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+)
+
+// toHighS returns the high-S twin of a low-S signature: s' = N - s, v' = v ^ 1.
+func toHighS(t *testing.T, sig []byte) []byte {
+	t.Helper()
+	out := make([]byte, 65)
+	copy(out, sig)
+	s := new(big.Int).SetBytes(sig[32:64])
+	new(big.Int).Sub(secp256k1N, s).FillBytes(out[32:64])
+	out[64] ^= 1
+	return out
+}
+
+func TestNormalizeLowS(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	hash := crypto.Keccak256([]byte("outbound"))
+
+	lowS, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+
+	t.Run("high-S is flipped to low-S and still recovers the signer", func(t *testing.T) {
+		highS := toHighS(t, lowS)
+		require.Equal(t, 1, new(big.Int).SetBytes(highS[32:64]).Cmp(secp256k1HalfN))
+
+		got, err := NormalizeLowS(highS)
+		require.NoError(t, err)
+		assert.Equal(t, lowS, got)
+		assert.True(t, crypto.ValidateSignatureValues(got[64], new(big.Int).SetBytes(got[:32]), new(big.Int).SetBytes(got[32:64]), true))
+
+		pub, err := crypto.SigToPub(hash, got)
+		require.NoError(t, err)
+		assert.Equal(t, addr, crypto.PubkeyToAddress(*pub))
+
+		// The input is left untouched.
+		assert.NotEqual(t, lowS, highS)
+	})
+
+	t.Run("low-S is returned unchanged", func(t *testing.T) {
+		got, err := NormalizeLowS(lowS)
+		require.NoError(t, err)
+		assert.Equal(t, lowS, got)
+	})
+
+	t.Run("rejects wrong length", func(t *testing.T) {
+		_, err := NormalizeLowS(lowS[:64])
+		assert.Error(t, err)
+	})
+}
+
+// hashBuilder rebuilds every outbound to a fixed signing hash.
+type hashBuilder struct {
+	TxBuilder
+	hash []byte
+}
+
+func (b hashBuilder) GetOutboundSigningRequest(context.Context, *uetypes.OutboundCreatedEvent, uint64) (*UnsignedSigningReq, error) {
+	return &UnsignedSigningReq{SigningHash: b.hash}, nil
+}
+
+func TestVerifyOutboundSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tssAddr := crypto.PubkeyToAddress(key.PublicKey)
+	hash := crypto.Keccak256([]byte("outbound"))
+	sig, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	builder := hashBuilder{hash: hash}
+	event := &uetypes.OutboundCreatedEvent{TxID: "0x01"}
+
+	t.Run("valid r||s||v signature", func(t *testing.T) {
+		check, err := VerifyOutboundSignature(context.Background(), builder, event, 0, sig, tssAddr)
+		require.NoError(t, err)
+		assert.True(t, check.Valid)
+		assert.Equal(t, sig[64], check.RecoveryID)
+		assert.Equal(t, hash, check.SigningHash)
+	})
+
+	t.Run("valid r||s signature finds the recovery ID", func(t *testing.T) {
+		check, err := VerifyOutboundSignature(context.Background(), builder, event, 0, sig[:64], tssAddr)
+		require.NoError(t, err)
+		assert.True(t, check.Valid)
+		assert.Equal(t, sig[64], check.RecoveryID)
+	})
+
+	t.Run("signature by another key is invalid", func(t *testing.T) {
+		other, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		check, err := VerifyOutboundSignature(context.Background(), builder, event, 0, sig, crypto.PubkeyToAddress(other.PublicKey))
+		require.NoError(t, err)
+		assert.False(t, check.Valid)
+		assert.Contains(t, check.Recovered[:], tssAddr.Hex())
+		for v, attempt := range check.Recovered {
+			assert.NotEmpty(t, attempt, "attempt v=%d missing", v)
+		}
+	})
+
+	t.Run("signature over a different message is invalid", func(t *testing.T) {
+		check, err := VerifyOutboundSignature(context.Background(), hashBuilder{hash: crypto.Keccak256([]byte("tampered"))}, event, 0, sig, tssAddr)
+		require.NoError(t, err)
+		assert.False(t, check.Valid)
+	})
+
+	t.Run("malformed signature length", func(t *testing.T) {
+		_, err := VerifyOutboundSignature(context.Background(), builder, event, 0, sig[:10], tssAddr)
+		require.Error(t, err)
+	})
+}
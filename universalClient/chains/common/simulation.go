@@ -0,0 +1,10 @@
+package common
+
+// SimulationResult is the outcome of dry-running an outbound against its
+// destination chain without broadcasting it.
+type SimulationResult struct {
+	Success bool
+	Err     string   // revert reason or program error; empty on success
+	Units   uint64   // EVM: estimated gas; SVM: compute units consumed
+	Logs    []string // SVM program logs; EVM return data (hex) if any
+}
@@ -44,6 +44,11 @@ type UnsignedSigningReq struct {
 	// signing time. Nil for outbound. Must be reused verbatim at broadcast — re-querying
 	// balance there races with a successful sweep from another validator.
 	TSSFundMigrationAmount *big.Int `json:"TSSFundMigrationAmount,omitempty"`
+
+	// RecoveryID is the SVM signature's recovery ID once verified against the
+	// on-chain TSS address. The SVM builder fills it in on first determination
+	// and uses it as-is when set; callers persist it so retries skip the lookup.
+	RecoveryID *uint8 `json:"RecoveryID,omitempty"`
 }
 
 // TxBuilder builds and broadcasts transactions for outbound transfers
@@ -67,6 +72,11 @@ type TxBuilder interface {
 	//   - status: 0 = failed/reverted, 1 = success
 	VerifyBroadcastedTx(ctx context.Context, txHash string) (found bool, blockHeight uint64, confirmations uint64, status uint8, err error)
 
+	// RequiredConfirmations returns the confirmations data's broadcast tx needs
+	// before it is final: base, raised by the chain's ValueConfirmationPolicy
+	// when the outbound amount is at or above its threshold.
+	RequiredConfirmations(data *uetypes.OutboundCreatedEvent, base uint64) uint64
+
 	// IsAlreadyExecuted checks whether a transaction with the given txID has already been
 	// executed on the destination chain (e.g., by another relayer).
 	// For SVM: checks if the ExecutedTx PDA exists on-chain, AND returns the
@@ -77,6 +87,12 @@ type TxBuilder interface {
 	// For EVM: returns (false, 0, nil). EVM uses nonce-based replay protection.
 	IsAlreadyExecuted(ctx context.Context, txID string) (executed bool, queryBlockTime int64, err error)
 
+	// IsGatewayPaused reports whether the destination gateway is paused, in
+	// which case a broadcast would only revert.
+	// EVM: the gateway's or the vault's paused() view.
+	// SVM: the paused flag of the gateway's Config PDA.
+	IsGatewayPaused(ctx context.Context) (bool, error)
+
 	// GetGasFeeUsed returns the gas fee used by a transaction on the destination chain.
 	// EVM: fetches receipt and returns gasUsed * effectiveGasPrice as decimal string.
 	// SVM: returns "0" (gas accounting is handled via vault gasFee reimbursement).
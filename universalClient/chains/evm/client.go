@@ -3,6 +3,7 @@ package evm
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
@@ -27,7 +28,7 @@ type Client struct {
 
 	// Infrastructure
 	rpcClient *RPCClient
-	database  *db.DB
+	database  db.Store
 	ctx       context.Context
 	cancel    context.CancelFunc
 
@@ -38,15 +39,22 @@ type Client struct {
 	eventCleaner    *common.EventCleaner
 	chainMetaOracle *ChainMetaOracle
 	txBuilder       *TxBuilder
+	balanceMonitor  *common.BalanceMonitor
 
 	// Dependencies
 	pushSigner *pushsigner.Signer
+
+	// dryRun builds outbound txs without broadcasting them; see SetDryRun
+	dryRun bool
+
+	// feePayer returns the address paying for outbound txs; see SetFeePayer
+	feePayer func(ctx context.Context) (string, error)
 }
 
 // NewClient creates a new EVM chain client
 func NewClient(
 	config *uregistrytypes.ChainConfig,
-	database *db.DB,
+	database db.Store,
 	chainConfig *config.ChainSpecificConfig,
 	pushSigner *pushsigner.Signer,
 	logger zerolog.Logger,
@@ -83,6 +91,7 @@ func NewClient(
 		chainIDStr,
 		log,
 	)
+	client.eventCleaner.SetRawEventRetention(chainConfig.RawEventRetentionSeconds)
 
 	// Initialize components that don't require RPC client
 	if pushSigner != nil {
@@ -101,6 +110,27 @@ func NewClient(
 	return client, nil
 }
 
+// SetDryRun makes the client build and sign outbound txs as usual but log
+// them instead of broadcasting. Call it before Start.
+func (c *Client) SetDryRun(enabled bool) {
+	c.dryRun = enabled
+}
+
+// SetReportTurn restricts this chain's outbound result votes to the
+// validators whose turn it is; see common.EventProcessor.SetReportTurn.
+// Call it before Start.
+func (c *Client) SetReportTurn(reportTurn common.ReportTurnFunc) {
+	if c.eventProcessor != nil {
+		c.eventProcessor.SetReportTurn(reportTurn)
+	}
+}
+
+// SetFeePayer sets how to look up the address that pays for outbound txs
+// (the TSS address), enabling the relayer balance monitor. Call it before Start.
+func (c *Client) SetFeePayer(feePayer func(ctx context.Context) (string, error)) {
+	c.feePayer = feePayer
+}
+
 // Start initializes and starts the EVM chain client
 func (c *Client) Start(ctx context.Context) error {
 	c.ctx, c.cancel = context.WithCancel(context.Background())
@@ -237,6 +267,12 @@ func (c *Client) initializeComponents() error {
 		if err != nil {
 			return fmt.Errorf("failed to create event listener: %w", err)
 		}
+		if c.chainConfig != nil && c.chainConfig.EventMaxBlockRange != nil && *c.chainConfig.EventMaxBlockRange > 0 {
+			eventListener.SetMaxBlockRange(uint64(*c.chainConfig.EventMaxBlockRange))
+		}
+		if c.chainConfig != nil && c.chainConfig.RawEventRetentionSeconds != nil && *c.chainConfig.RawEventRetentionSeconds > 0 {
+			eventListener.SetRawEventCapture(true)
+		}
 		c.eventListener = eventListener
 
 		// Create txBuilder
@@ -256,7 +292,12 @@ func (c *Client) initializeComponents() error {
 		if err != nil {
 			return fmt.Errorf("failed to create txBuilder: %w", err)
 		}
+		txBuilder.ApplyChainConfig(c.chainConfig)
 		c.txBuilder = txBuilder
+
+		if c.feePayer != nil {
+			c.balanceMonitor = c.newBalanceMonitor(c.relayerBalance)
+		}
 	}
 
 	// Apply defaults for all configuration values
@@ -314,6 +355,10 @@ func (c *Client) startComponents() error {
 		}
 	}
 
+	if c.balanceMonitor != nil {
+		c.balanceMonitor.Start(c.ctx)
+	}
+
 	if c.eventCleaner != nil {
 		if err := c.eventCleaner.Start(c.ctx); err != nil {
 			return fmt.Errorf("failed to start event cleaner: %w", err)
@@ -323,6 +368,59 @@ func (c *Client) startComponents() error {
 	return nil
 }
 
+// newBalanceMonitor creates the relayer balance monitor from the chain config.
+func (c *Client) newBalanceMonitor(fetch common.BalanceFetcher) *common.BalanceMonitor {
+	var threshold *big.Int
+	if t := c.chainConfig.RelayerBalanceThreshold; t != nil {
+		parsed, err := common.ParseBalanceThreshold(*t)
+		if err != nil {
+			c.logger.Warn().Err(err).Msg("invalid relayer balance threshold, exporting the balance without warnings")
+		} else {
+			threshold = parsed
+		}
+	}
+	var interval time.Duration
+	if s := c.chainConfig.RelayerBalanceCheckIntervalSeconds; s != nil {
+		interval = time.Duration(*s) * time.Second
+	}
+	return common.NewBalanceMonitor(fetch, interval, threshold, c.logger)
+}
+
+// EndpointStats reports the state and recent latency of each RPC endpoint,
+// or nil before the RPC client is created.
+func (c *Client) EndpointStats() []common.EndpointStats {
+	if c.rpcClient == nil {
+		return nil
+	}
+	return c.rpcClient.EndpointStats()
+}
+
+// RelayerBalance returns the last fee-payer balance read by the balance
+// monitor, or false if none has been read yet.
+func (c *Client) RelayerBalance() (common.RelayerBalance, bool) {
+	if c.balanceMonitor == nil {
+		return common.RelayerBalance{}, false
+	}
+	return c.balanceMonitor.Balance()
+}
+
+// relayerBalance reads the fee payer's balance in wei.
+func (c *Client) relayerBalance(ctx context.Context) (string, *big.Int, error) {
+	account, err := c.feePayer(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get fee payer: %w", err)
+	}
+	if !ethcommon.IsHexAddress(account) {
+		return "", nil, fmt.Errorf("invalid fee payer address: %s", account)
+	}
+	address := ethcommon.HexToAddress(account)
+	balance, err := c.rpcClient.GetBalance(ctx, address)
+	if err != nil {
+		return "", nil, err
+	}
+	return address.Hex(), balance, nil
+}
+
 // createRPCClient creates and initializes the RPC client
 func (c *Client) createRPCClient() error {
 	// Parse chain ID for validation
@@ -331,12 +429,34 @@ func (c *Client) createRPCClient() error {
 		return fmt.Errorf("failed to parse chain ID: %w", err)
 	}
 
+	rpcURLs := common.FilterEndpoints(c.chainConfig.RPCURLs, c.chainConfig.RPCAllowlist, c.chainConfig.RPCBlacklist)
+	if len(rpcURLs) == 0 {
+		return fmt.Errorf("no RPC URLs left after applying rpc_allowlist/rpc_blacklist")
+	}
+
 	// Create RPC client from URLs with chain ID validation
-	rpcClient, err := NewRPCClient(c.chainConfig.RPCURLs, chainID, c.logger)
+	rpcClient, err := NewRPCClient(rpcURLs, chainID, c.logger)
 	if err != nil {
 		return fmt.Errorf("failed to create RPC client: %w", err)
 	}
 
+	// Pace each endpoint to the operator's RPS budget (public RPCs throttle hard)
+	if rps := c.chainConfig.RPCRequestsPerSecond; rps != nil && *rps > 0 {
+		burst := 0
+		if c.chainConfig.RPCBurst != nil {
+			burst = *c.chainConfig.RPCBurst
+		}
+		rpcClient.SetRateLimit(*rps, burst)
+	}
+	if n := c.chainConfig.RPCRecoveryStreak; n != nil {
+		rpcClient.SetRecoveryStreak(*n)
+	}
+	if err := rpcClient.SetHealthCheck(c.chainConfig.RPCHealthCheck); err != nil {
+		rpcClient.Close()
+		return fmt.Errorf("invalid rpc_health_check: %w", err)
+	}
+	rpcClient.SetDryRun(c.dryRun)
+
 	c.rpcClient = rpcClient
 	c.logger.Info().Int("connected_count", len(rpcClient.clients)).Msg("RPC clients initialized successfully")
 	return nil
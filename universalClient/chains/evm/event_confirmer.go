@@ -34,7 +34,7 @@ type EventConfirmer struct {
 // NewEventConfirmer creates a new event confirmer
 func NewEventConfirmer(
 	rpcClient *RPCClient,
-	database *db.DB,
+	database db.Store,
 	chainID string,
 	pollIntervalSeconds int,
 	fastConfirmations uint64,
@@ -4,24 +4,31 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog"
 
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
 	"github.com/pushchain/push-chain-node/universalClient/db"
+	"github.com/pushchain/push-chain-node/universalClient/store"
 	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
 )
 
+// DefaultMaxBlockRange is the widest block span queried per eth_getLogs call,
+// safely under the 10000-block cap most providers enforce.
+const DefaultMaxBlockRange uint64 = 9000
+
 // EventListener listens for gateway and vault events on EVM chains and stores them in the database
 type EventListener struct {
 	// Core dependencies
 	rpcClient  *RPCClient
 	chainStore *common.ChainStore
-	database   *db.DB
+	database   db.Store
 
 	// Configuration
 	gatewayAddress      string
@@ -29,8 +36,12 @@ type EventListener struct {
 	chainID             string
 	eventTopics         []ethcommon.Hash
 	topicToEventType    map[ethcommon.Hash]string
+	methodConfirmation  map[string]string // event type → configured confirmation type
 	eventPollingSeconds int
 	eventStartFrom      *int64
+	maxGapBackfill      uint64
+	maxBlockRange       uint64 // widest eth_getLogs span; shrinks when a provider rejects it
+	captureRawEvents    bool   // keep the raw log behind each inbound
 
 	// State
 	logger  zerolog.Logger
@@ -47,7 +58,7 @@ func NewEventListener(
 	chainID string,
 	gatewayMethods []*uregistrytypes.GatewayMethods,
 	vaultMethods []*uregistrytypes.VaultMethods,
-	database *db.DB,
+	database db.Store,
 	eventPollingSeconds int,
 	eventStartFrom *int64,
 	logger zerolog.Logger,
@@ -63,12 +74,15 @@ func NewEventListener(
 	// Build event topics for filtering
 	eventTopics := make([]ethcommon.Hash, 0, 4)
 	topicToEventType := make(map[ethcommon.Hash]string)
+	methodConfirmation := make(map[string]string)
 
 	// Gateway event topics
 	for _, method := range gatewayMethods {
 		if method.EventIdentifier == "" {
 			continue
 		}
+		// addFunds is not subscribed: it emits its own event, whose layout
+		// differs from UniversalTx and has no decoder here.
 		switch method.Name {
 		case EventTypeSendFunds,
 			EventTypeExecuteUniversalTx,
@@ -76,6 +90,9 @@ func NewEventListener(
 			topic := ethcommon.HexToHash(method.EventIdentifier)
 			eventTopics = append(eventTopics, topic)
 			topicToEventType[topic] = method.Name
+			if ct := storeConfirmationType(method.ConfirmationType); ct != "" {
+				methodConfirmation[method.Name] = ct
+			}
 		}
 	}
 
@@ -92,6 +109,16 @@ func NewEventListener(
 		}
 	}
 
+	log := logger.With().Str("component", "evm_event_listener").Str("chain", chainID).Logger()
+	for _, m := range CheckEventIdentifiers(gatewayMethods, vaultMethods) {
+		log.Warn().
+			Str("method", m.Method).
+			Str("configured", m.Configured).
+			Str("expected", m.Expected).
+			Str("signature", m.Signature).
+			Msg("configured event identifier does not match the known event signature; chain config may be stale")
+	}
+
 	return &EventListener{
 		rpcClient:           rpcClient,
 		chainStore:          common.NewChainStore(database),
@@ -101,9 +128,12 @@ func NewEventListener(
 		chainID:             chainID,
 		eventTopics:         eventTopics,
 		topicToEventType:    topicToEventType,
+		methodConfirmation:  methodConfirmation,
 		eventPollingSeconds: eventPollingSeconds,
 		eventStartFrom:      eventStartFrom,
-		logger:              logger.With().Str("component", "evm_event_listener").Str("chain", chainID).Logger(),
+		maxGapBackfill:      common.DefaultMaxGapBackfill,
+		maxBlockRange:       DefaultMaxBlockRange,
+		logger:              log,
 		stopCh:              make(chan struct{}),
 	}, nil
 }
@@ -149,11 +179,19 @@ func (el *EventListener) listen(ctx context.Context) {
 	// Get polling interval from config
 	pollInterval := el.getPollingInterval()
 
-	// Get starting block
+	// Get starting block; a freshly enabled chain may need the tip, so retry
+	// until the RPC answers rather than giving up on the chain.
 	fromBlock, err := el.getStartBlock(ctx)
-	if err != nil {
-		el.logger.Error().Err(err).Msg("failed to get start block")
-		return
+	for err != nil {
+		el.logger.Error().Err(err).Msg("failed to get start block, retrying")
+		select {
+		case <-ctx.Done():
+			return
+		case <-el.stopCh:
+			return
+		case <-time.After(pollInterval):
+		}
+		fromBlock, err = el.getStartBlock(ctx)
 	}
 
 	// Get event topics
@@ -202,40 +240,80 @@ func (el *EventListener) processNewBlocks(
 		return fmt.Errorf("failed to get latest block: %w", err)
 	}
 
+	// Close any gap behind the cursor before scanning new blocks, so the
+	// checkpoint never advances past blocks that were not scanned.
+	if closed, err := el.backfillGap(ctx, *currentBlock, topics); err != nil || !closed {
+		return err
+	}
+
 	// Skip if no new blocks
 	if *currentBlock >= latestBlock {
 		return nil
 	}
 
-	// Process blocks in range
+	// Process blocks in range; the checkpoint advances chunk by chunk
 	if err := el.processBlockRange(ctx, *currentBlock, latestBlock, topics); err != nil {
 		return fmt.Errorf("failed to process block range: %w", err)
 	}
 
-	// Update last processed block in database
-	if err := el.updateLastProcessedBlock(latestBlock); err != nil {
-		el.logger.Error().Err(err).Msg("failed to update last processed block")
-		// Don't return error - continue processing
-	}
-
 	// Move to next block
 	*currentBlock = latestBlock + 1
 	return nil
 }
 
-// processBlockRange processes events in a range of blocks
+// backfillGap rescans blocks between the persisted checkpoint and the cursor
+// that were never checkpointed, at most maxGapBackfill per call. It reports
+// whether the gap is fully closed; if not, the caller retries on the next poll.
+func (el *EventListener) backfillGap(ctx context.Context, nextBlock uint64, topics []ethcommon.Hash) (bool, error) {
+	checkpoint, err := el.chainStore.GetChainHeight()
+	if err != nil {
+		return false, fmt.Errorf("failed to get chain height: %w", err)
+	}
+	from, to, ok := common.DetectGap(checkpoint, nextBlock, el.maxGapBackfill)
+	if !ok {
+		return true, nil
+	}
+
+	el.logger.Warn().
+		Uint64("checkpoint", checkpoint).
+		Uint64("next_block", nextBlock).
+		Uint64("backfill_from", from).
+		Uint64("backfill_to", to).
+		Msg("gap detected behind cursor, backfilling missed blocks")
+
+	if err := el.processBlockRange(ctx, from, to, topics); err != nil {
+		return false, fmt.Errorf("failed to backfill blocks %d-%d: %w", from, to, err)
+	}
+	return to+1 >= nextBlock, nil
+}
+
+// SetMaxBlockRange caps the block span of each eth_getLogs query. Zero is ignored.
+func (el *EventListener) SetMaxBlockRange(blocks uint64) {
+	if blocks > 0 {
+		el.maxBlockRange = blocks
+	}
+}
+
+// SetRawEventCapture makes the listener store the raw log behind each new
+// inbound event alongside it.
+func (el *EventListener) SetRawEventCapture(enabled bool) {
+	el.captureRawEvents = enabled
+}
+
+// processBlockRange processes events in a range of blocks, split into
+// eth_getLogs queries of at most maxBlockRange blocks. When a provider rejects
+// a query as too wide, the range is halved and the chunk retried; the smaller
+// range is kept for later polls.
 func (el *EventListener) processBlockRange(
 	ctx context.Context,
 	fromBlock, toBlock uint64,
 	topics []ethcommon.Hash,
 ) error {
-	const maxBlockRange uint64 = 9000 // Safe under the 10000 RPC limit
-
 	currentFrom := fromBlock
 
 	// Process in chunks if the range is too large
 	for currentFrom <= toBlock {
-		currentTo := currentFrom + maxBlockRange - 1
+		currentTo := currentFrom + el.maxBlockRange - 1
 		if currentTo > toBlock {
 			currentTo = toBlock
 		}
@@ -252,9 +330,26 @@ func (el *EventListener) processBlockRange(
 
 		// Process chunk
 		if err := el.processBlockChunk(ctx, currentFrom, currentTo, topics); err != nil {
+			if isRangeTooLargeError(err) && blockRange > 1 {
+				el.maxBlockRange = blockRange / 2
+				el.logger.Warn().
+					Err(err).
+					Uint64("from_block", currentFrom).
+					Uint64("to_block", currentTo).
+					Uint64("max_block_range", el.maxBlockRange).
+					Msg("provider rejected log query range, shrinking")
+				continue
+			}
 			return fmt.Errorf("failed to process chunk %d-%d: %w", currentFrom, currentTo, err)
 		}
 
+		// Checkpoint after every chunk so a restart mid-backfill resumes here
+		// instead of rescanning the whole range.
+		if err := el.updateLastProcessedBlock(currentTo); err != nil {
+			el.logger.Error().Err(err).Uint64("block", currentTo).Msg("failed to update last processed block")
+			// Don't return error - continue processing
+		}
+
 		// Move to next chunk
 		currentFrom = currentTo + 1
 	}
@@ -296,17 +391,7 @@ func (el *EventListener) processBlockChunk(
 
 	// Process each log
 	for _, log := range logs {
-		if len(log.Topics) == 0 {
-			continue
-		}
-
-		// Determine event type based on topic
-		eventType, ok := el.topicToEventType[log.Topics[0]]
-		if !ok {
-			continue
-		}
-
-		event := ParseEvent(&log, eventType, el.chainID, el.logger)
+		event := el.parseLog(&log)
 		if event != nil {
 			// Insert event if it doesn't already exist
 			if stored, err := el.chainStore.InsertEventIfNotExists(event); err != nil {
@@ -322,6 +407,9 @@ func (el *EventListener) processBlockChunk(
 					Uint64("block", event.BlockHeight).
 					Str("confirmation_type", event.ConfirmationType).
 					Msg("stored new event")
+				if el.captureRawEvents && event.Type == store.EventTypeInbound {
+					el.storeRawEvent(event, &log)
+				}
 			}
 		}
 	}
@@ -329,6 +417,60 @@ func (el *EventListener) processBlockChunk(
 	return nil
 }
 
+// storeRawEvent persists the JSON-encoded log an inbound event was decoded
+// from. Failures are logged only; the decoded event is already stored.
+func (el *EventListener) storeRawEvent(event *store.Event, log *types.Log) {
+	payload, err := log.MarshalJSON()
+	if err == nil {
+		err = el.chainStore.InsertRawEvent(&store.RawEvent{
+			EventID:     event.EventID,
+			BlockHeight: event.BlockHeight,
+			Payload:     payload,
+		})
+	}
+	if err != nil {
+		el.logger.Warn().Err(err).
+			Str("event_id", event.EventID).
+			Msg("failed to store raw event")
+	}
+}
+
+// parseLog routes a log to its configured method by topic and parses it.
+// Logs whose topic matches no configured method are ignored. The method's
+// configured confirmation type is applied, except for sendFunds, whose
+// UniversalTx payload carries a tx type that decides it (gas-only txs are FAST).
+func (el *EventListener) parseLog(log *types.Log) *store.Event {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	eventType, ok := el.topicToEventType[log.Topics[0]]
+	if !ok {
+		return nil
+	}
+
+	event := ParseEvent(log, eventType, el.chainID, el.logger)
+	if event == nil {
+		return nil
+	}
+	if ct, ok := el.methodConfirmation[eventType]; ok && eventType != EventTypeSendFunds {
+		event.ConfirmationType = ct
+	}
+	return event
+}
+
+// storeConfirmationType maps a registry confirmation type to the store's
+// representation; "" for CONFIRMATION_UNKNOWN.
+func storeConfirmationType(ct uregistrytypes.ConfirmationType) string {
+	switch ct {
+	case uregistrytypes.ConfirmationType_CONFIRMATION_TYPE_FAST:
+		return store.ConfirmationFast
+	case uregistrytypes.ConfirmationType_CONFIRMATION_TYPE_STANDARD:
+		return store.ConfirmationStandard
+	default:
+		return ""
+	}
+}
+
 // getStartBlock returns the block to start watching from
 func (el *EventListener) getStartBlock(ctx context.Context) (uint64, error) {
 	// Get chain height from store
@@ -349,35 +491,45 @@ func (el *EventListener) getStartBlock(ctx context.Context) (uint64, error) {
 	return blockHeight, nil
 }
 
-// getStartBlockFromConfig determines start block from configuration
+// getStartBlockFromConfig determines where a freshly enabled chain starts watching:
+// the enable block recorded in the checkpoint store if there is one, otherwise
+// EventStartFrom (>= 0), or the latest block when it is -1 or unset. The
+// resolved block is recorded so a restart before any progress resumes from the
+// same point instead of re-reading the tip; a failed tip lookup is returned
+// rather than falling back to genesis.
 func (el *EventListener) getStartBlockFromConfig(ctx context.Context) (uint64, error) {
-	// Check config for EventStartFrom
-	if el.eventStartFrom != nil {
-		if *el.eventStartFrom >= 0 {
-			startBlock := uint64(*el.eventStartFrom)
-			el.logger.Info().
-				Uint64("block", startBlock).
-				Msg("no previous state found, starting from configured EventStartFrom")
-			return startBlock, nil
-		}
+	enableBlock, ok, err := el.chainStore.GetEnableHeight()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get enable block: %w", err)
+	}
+	if ok {
+		el.logger.Info().
+			Uint64("block", enableBlock).
+			Msg("no block processed yet, starting from recorded enable block")
+		return enableBlock, nil
+	}
 
-		// -1 means start from latest block
-		if *el.eventStartFrom == -1 {
-			latestBlock, err := el.rpcClient.GetLatestBlock(ctx)
-			if err != nil {
-				el.logger.Warn().Err(err).Msg("failed to get latest block, starting from 0")
-				return 0, nil
-			}
-			el.logger.Info().
-				Uint64("block", latestBlock).
-				Msg("no previous state found, starting from latest block (EventStartFrom=-1)")
-			return latestBlock, nil
+	var startBlock uint64
+	if el.eventStartFrom != nil && *el.eventStartFrom >= 0 {
+		startBlock = uint64(*el.eventStartFrom)
+		el.logger.Info().
+			Uint64("block", startBlock).
+			Msg("no previous state found, starting from configured EventStartFrom")
+	} else {
+		latestBlock, err := el.rpcClient.GetLatestBlock(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get latest block: %w", err)
 		}
+		startBlock = latestBlock
+		el.logger.Info().
+			Uint64("block", startBlock).
+			Msg("no previous state found, starting from latest block")
 	}
 
-	// No config, get latest block
-	el.logger.Info().Msg("no last processed block found, starting from latest")
-	return el.rpcClient.GetLatestBlock(ctx)
+	if err := el.chainStore.SetEnableHeight(startBlock); err != nil {
+		return 0, fmt.Errorf("failed to record enable block: %w", err)
+	}
+	return startBlock, nil
 }
 
 // updateLastProcessedBlock updates the last processed block in the database
@@ -392,3 +544,20 @@ func (el *EventListener) getPollingInterval() time.Duration {
 	}
 	return 5 * time.Second // default
 }
+
+// isRangeTooLargeError reports whether err is a provider refusing an
+// eth_getLogs query for spanning too many blocks or returning too many logs,
+// as opposed to a genuine failure. Providers word this differently, e.g.
+// "block range is too large", "query returned more than 10000 results" or
+// "log response size exceeded".
+func isRangeTooLargeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "range too large") ||
+		strings.Contains(msg, "block range") ||
+		strings.Contains(msg, "query returned more than") ||
+		strings.Contains(msg, "response size exceeded") ||
+		strings.Contains(msg, "limited to a")
+}
@@ -2,15 +2,25 @@ package evm
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pushchain/push-chain-node/universalClient/db"
+	"github.com/pushchain/push-chain-node/universalClient/store"
 	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
 )
 
@@ -274,6 +284,67 @@ func TestNewEventListener_UnknownMethodNameSkipped(t *testing.T) {
 	assert.Len(t, el.topicToEventType, 0)
 }
 
+func TestEventListener_ParseLogRoutesByMethod(t *testing.T) {
+	sendFundsTopic := ethcommon.HexToHash("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	addFundsTopic := ethcommon.HexToHash("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	executeTopic := ethcommon.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc")
+
+	gatewayMethods := []*uregistrytypes.GatewayMethods{
+		{Name: EventTypeSendFunds, EventIdentifier: sendFundsTopic.Hex(), ConfirmationType: uregistrytypes.ConfirmationType_CONFIRMATION_TYPE_STANDARD},
+		{Name: EventTypeAddFunds, EventIdentifier: addFundsTopic.Hex(), ConfirmationType: uregistrytypes.ConfirmationType_CONFIRMATION_TYPE_FAST},
+		{Name: EventTypeExecuteUniversalTx, EventIdentifier: executeTopic.Hex(), ConfirmationType: uregistrytypes.ConfirmationType_CONFIRMATION_TYPE_FAST},
+	}
+	el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", gatewayMethods, nil, testDB(t), 5, nil, testLogger(t))
+	require.NoError(t, err)
+	// addFunds has its own event layout, which UniversalTx decoding would misread.
+	assert.NotContains(t, el.topicToEventType, addFundsTopic)
+	assert.NotContains(t, el.eventTopics, addFundsTopic)
+
+	// inboundLog builds a UniversalTx log with a FUNDS (non-gas) tx type, which
+	// the payload alone would confirm as STANDARD.
+	inboundLog := func(topic ethcommon.Hash, index uint) *types.Log {
+		data := make([]byte, 7*32)
+		big.NewInt(1000).FillBytes(data[32:64])
+		big.NewInt(2).FillBytes(data[4*32 : 5*32])
+		return &types.Log{
+			Topics: []ethcommon.Hash{
+				topic,
+				ethcommon.HexToHash("0x000000000000000000000000742d35cc6634c0532925a3b844bc9e7595f0beb7"),
+				ethcommon.HexToHash("0x000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7"),
+			},
+			Data:        data,
+			TxHash:      ethcommon.HexToHash("0xabc"),
+			Index:       index,
+			BlockNumber: 100,
+		}
+	}
+
+	t.Run("sendFunds keeps its payload-derived STANDARD confirmation", func(t *testing.T) {
+		event := el.parseLog(inboundLog(sendFundsTopic, 0))
+		require.NotNil(t, event)
+		assert.Equal(t, store.EventTypeInbound, event.Type)
+		assert.Equal(t, store.ConfirmationStandard, event.ConfirmationType)
+	})
+
+	t.Run("addFunds logs are not decoded as inbounds", func(t *testing.T) {
+		assert.Nil(t, el.parseLog(inboundLog(addFundsTopic, 1)))
+	})
+
+	t.Run("outbound observations take the method's confirmation", func(t *testing.T) {
+		log := inboundLog(executeTopic, 2)
+		event := el.parseLog(log)
+		require.NotNil(t, event)
+		assert.Equal(t, store.EventTypeOutbound, event.Type)
+		assert.Equal(t, store.ConfirmationFast, event.ConfirmationType)
+	})
+
+	t.Run("unknown events are ignored", func(t *testing.T) {
+		unknown := ethcommon.HexToHash("0xdddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd")
+		assert.Nil(t, el.parseLog(inboundLog(unknown, 3)))
+		assert.Nil(t, el.parseLog(&types.Log{}))
+	})
+}
+
 func TestNewEventListener_NoMethodsProducesEmptyTopics(t *testing.T) {
 	database := testDB(t)
 	logger := testLogger(t)
@@ -326,12 +397,11 @@ func TestNewEventListener_EventStartFromNil(t *testing.T) {
 	assert.Nil(t, el.eventStartFrom)
 }
 func TestEventListener_GetStartBlockFromConfig(t *testing.T) {
-	database := testDB(t)
 	logger := testLogger(t)
 
 	t.Run("positive eventStartFrom returns that block", func(t *testing.T) {
 		startBlock := int64(5000)
-		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, &startBlock, logger)
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startBlock, logger)
 		require.NoError(t, err)
 
 		block, err := el.getStartBlockFromConfig(context.Background())
@@ -341,7 +411,7 @@ func TestEventListener_GetStartBlockFromConfig(t *testing.T) {
 
 	t.Run("zero eventStartFrom returns 0", func(t *testing.T) {
 		startBlock := int64(0)
-		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, &startBlock, logger)
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startBlock, logger)
 		require.NoError(t, err)
 
 		block, err := el.getStartBlockFromConfig(context.Background())
@@ -351,7 +421,7 @@ func TestEventListener_GetStartBlockFromConfig(t *testing.T) {
 
 	t.Run("large positive eventStartFrom", func(t *testing.T) {
 		startBlock := int64(999999999)
-		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, &startBlock, logger)
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startBlock, logger)
 		require.NoError(t, err)
 
 		block, err := el.getStartBlockFromConfig(context.Background())
@@ -361,7 +431,7 @@ func TestEventListener_GetStartBlockFromConfig(t *testing.T) {
 
 	t.Run("minus one eventStartFrom with nil rpcClient panics", func(t *testing.T) {
 		startBlock := int64(-1)
-		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, &startBlock, logger)
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startBlock, logger)
 		require.NoError(t, err)
 
 		// rpcClient is nil, so calling GetLatestBlock panics
@@ -371,7 +441,7 @@ func TestEventListener_GetStartBlockFromConfig(t *testing.T) {
 	})
 
 	t.Run("nil eventStartFrom with nil rpcClient panics", func(t *testing.T) {
-		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, nil, logger)
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, nil, logger)
 		require.NoError(t, err)
 
 		// nil rpcClient, nil eventStartFrom -> falls through to rpcClient.GetLatestBlock which panics on nil
@@ -382,7 +452,7 @@ func TestEventListener_GetStartBlockFromConfig(t *testing.T) {
 
 	t.Run("negative value less than -1 with nil rpcClient panics", func(t *testing.T) {
 		startBlock := int64(-5)
-		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, &startBlock, logger)
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startBlock, logger)
 		require.NoError(t, err)
 
 		// -5 is < 0 but not -1, and not >= 0, so falls through to rpcClient.GetLatestBlock
@@ -416,3 +486,401 @@ func TestEventListener_ContextCancellationStopsGoroutine(t *testing.T) {
 	el.Stop()
 	assert.False(t, el.IsRunning())
 }
+
+func TestEventListener_GetStartBlockCheckpoint(t *testing.T) {
+	startFrom := int64(5000)
+
+	t.Run("first run uses the configured start block", func(t *testing.T) {
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startFrom, testLogger(t))
+		require.NoError(t, err)
+
+		block, err := el.getStartBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5000), block)
+	})
+
+	t.Run("restart resumes from the stored checkpoint over the config", func(t *testing.T) {
+		database := testDB(t)
+		el, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, &startFrom, testLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, el.updateLastProcessedBlock(7200))
+
+		// A fresh listener on the same DB, as after a process restart.
+		restarted, err := NewEventListener(nil, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, &startFrom, testLogger(t))
+		require.NoError(t, err)
+		block, err := restarted.getStartBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7200), block)
+	})
+}
+
+func TestEventListener_FreshChainStartsFromEnableBlock(t *testing.T) {
+	var tip atomic.Uint64
+	var tipDown atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == "eth_chainId":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x1"}`))
+		case req.Method == "eth_blockNumber" && !tipDown.Load():
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"` + hexutil.EncodeUint64(tip.Load()) + `"}`))
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"error":{"code":-32000,"message":"unavailable"}}`))
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	require.NoError(t, err)
+	defer rc.Close()
+
+	t.Run("defaults to the tip and records it", func(t *testing.T) {
+		tip.Store(8000)
+		database := testDB(t)
+		el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, nil, testLogger(t))
+		require.NoError(t, err)
+		block, err := el.getStartBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8000), block)
+
+		// Restarted before any block was processed: the tip has moved on but
+		// the recorded enable block still wins, so nothing in between is skipped.
+		tip.Store(8500)
+		restarted, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, database, 5, nil, testLogger(t))
+		require.NoError(t, err)
+		block, err = restarted.getStartBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8000), block)
+	})
+
+	t.Run("configured enable block overrides the tip", func(t *testing.T) {
+		startFrom := int64(7000)
+		el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startFrom, testLogger(t))
+		require.NoError(t, err)
+		block, err := el.getStartBlock(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7000), block)
+
+		enableBlock, ok, err := el.chainStore.GetEnableHeight()
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(7000), enableBlock)
+	})
+
+	t.Run("tip lookup failure does not fall back to genesis", func(t *testing.T) {
+		tipDown.Store(true)
+		defer tipDown.Store(false)
+		startFrom := int64(-1)
+		el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, &startFrom, testLogger(t))
+		require.NoError(t, err)
+		_, err = el.getStartBlock(context.Background())
+		require.Error(t, err)
+
+		_, ok, err := el.chainStore.GetEnableHeight()
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestEventListener_ProcessBlockRangeCheckpointsEachChunk(t *testing.T) {
+	// eth_getLogs succeeds for the first chunk and fails for the second.
+	var getLogsCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "eth_chainId":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x1"}`))
+		case "eth_getLogs":
+			if getLogsCalls.Add(1) == 1 {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":[]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"error":{"code":-32000,"message":"boom"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":null}`))
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	require.NoError(t, err)
+	defer rc.Close()
+
+	el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, nil, testLogger(t))
+	require.NoError(t, err)
+
+	topics := []ethcommon.Hash{ethcommon.HexToHash("0xaa")}
+	err = el.processBlockRange(context.Background(), 1000, 1000+2*9000, topics)
+	require.Error(t, err)
+
+	// The first 9000-block chunk is checkpointed even though the range failed.
+	height, err := el.chainStore.GetChainHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000+9000-1), height)
+}
+
+func TestEventListener_ProcessNewBlocksBackfillsGap(t *testing.T) {
+	// Record the ranges requested via eth_getLogs.
+	var mu sync.Mutex
+	var ranges [][2]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "eth_chainId":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x1"}`))
+		case "eth_blockNumber":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x640"}`)) // 1600
+		case "eth_getLogs":
+			var filter struct {
+				FromBlock string `json:"fromBlock"`
+				ToBlock   string `json:"toBlock"`
+			}
+			_ = json.Unmarshal(req.Params[0], &filter)
+			mu.Lock()
+			ranges = append(ranges, [2]string{filter.FromBlock, filter.ToBlock})
+			mu.Unlock()
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":[]}`))
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":null}`))
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	require.NoError(t, err)
+	defer rc.Close()
+
+	el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, nil, testLogger(t))
+	require.NoError(t, err)
+	topics := []ethcommon.Hash{ethcommon.HexToHash("0xaa")}
+
+	t.Run("gap is rescanned before new blocks", func(t *testing.T) {
+		// Checkpoint at 1000 but the cursor already at 1500: 1001-1499 were never scanned.
+		require.NoError(t, el.updateLastProcessedBlock(1000))
+		cursor := uint64(1500)
+		require.NoError(t, el.processNewBlocks(context.Background(), &cursor, topics))
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, [][2]string{{"0x3e9", "0x5db"}, {"0x5dc", "0x640"}}, ranges)
+		assert.Equal(t, uint64(1601), cursor)
+		height, err := el.chainStore.GetChainHeight()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1600), height)
+	})
+
+	t.Run("backfill is bounded per poll", func(t *testing.T) {
+		mu.Lock()
+		ranges = nil
+		mu.Unlock()
+		el.maxGapBackfill = 100
+
+		// Gap 1601-1999 exceeds the cap: only 1601-1700 is scanned this poll
+		// and the cursor does not move until the gap is closed.
+		cursor := uint64(2000)
+		require.NoError(t, el.processNewBlocks(context.Background(), &cursor, topics))
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, [][2]string{{"0x641", "0x6a4"}}, ranges)
+		assert.Equal(t, uint64(2000), cursor)
+		height, err := el.chainStore.GetChainHeight()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1700), height)
+	})
+}
+
+// logRangeServer serves eth_getLogs with no logs, recording each requested
+// [from, to] range; spans wider than limit are rejected as too large.
+func logRangeServer(t *testing.T, limit uint64) (*RPCClient, func() [][2]uint64) {
+	t.Helper()
+	var mu sync.Mutex
+	var ranges [][2]uint64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "eth_chainId":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x1"}`))
+		case "eth_getLogs":
+			var filter struct {
+				FromBlock hexutil.Uint64 `json:"fromBlock"`
+				ToBlock   hexutil.Uint64 `json:"toBlock"`
+			}
+			_ = json.Unmarshal(req.Params[0], &filter)
+			from, to := uint64(filter.FromBlock), uint64(filter.ToBlock)
+			mu.Lock()
+			ranges = append(ranges, [2]uint64{from, to})
+			mu.Unlock()
+			if to-from+1 > limit {
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"error":{"code":-32005,"message":"block range is too large"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":[]}`))
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":null}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	require.NoError(t, err)
+	t.Cleanup(rc.Close)
+	return rc, func() [][2]uint64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([][2]uint64(nil), ranges...)
+	}
+}
+
+func TestEventListener_ProcessBlockRangeSplitsByMaxRange(t *testing.T) {
+	rc, ranges := logRangeServer(t, 10000)
+	el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, nil, testLogger(t))
+	require.NoError(t, err)
+	el.SetMaxBlockRange(1000)
+
+	topics := []ethcommon.Hash{ethcommon.HexToHash("0xaa")}
+	require.NoError(t, el.processBlockRange(context.Background(), 100, 2599, topics))
+
+	assert.Equal(t, [][2]uint64{{100, 1099}, {1100, 2099}, {2100, 2599}}, ranges())
+	height, err := el.chainStore.GetChainHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2599), height)
+}
+
+func TestEventListener_ProcessBlockRangeShrinksOnRangeError(t *testing.T) {
+	// The provider only accepts spans of up to 300 blocks.
+	rc, ranges := logRangeServer(t, 300)
+	el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", nil, nil, testDB(t), 5, nil, testLogger(t))
+	require.NoError(t, err)
+	el.SetMaxBlockRange(1000)
+
+	topics := []ethcommon.Hash{ethcommon.HexToHash("0xaa")}
+	require.NoError(t, el.processBlockRange(context.Background(), 0, 999, topics))
+
+	assert.Equal(t, [][2]uint64{
+		{0, 999}, {0, 499}, // rejected, halved twice
+		{0, 249}, {250, 499}, {500, 749}, {750, 999},
+	}, ranges())
+	// The shrunk range sticks for later polls.
+	assert.Equal(t, uint64(250), el.maxBlockRange)
+	height, err := el.chainStore.GetChainHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(999), height)
+}
+
+func TestIsRangeTooLargeError(t *testing.T) {
+	for _, msg := range []string{
+		"block range is too large",
+		"query returned more than 10000 results",
+		"Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range",
+		"eth_getLogs is limited to a 10,000 range",
+	} {
+		assert.True(t, isRangeTooLargeError(errors.New(msg)), msg)
+	}
+	assert.False(t, isRangeTooLargeError(errors.New("connection refused")))
+	assert.False(t, isRangeTooLargeError(nil))
+}
+
+func TestEventListener_CapturesRawInboundLogs(t *testing.T) {
+	sendFundsTopic := ethcommon.HexToHash("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	executeTopic := ethcommon.HexToHash("0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc")
+	gatewayMethods := []*uregistrytypes.GatewayMethods{
+		{Name: EventTypeSendFunds, EventIdentifier: sendFundsTopic.Hex()},
+		{Name: EventTypeExecuteUniversalTx, EventIdentifier: executeTopic.Hex()},
+	}
+
+	logFor := func(topic ethcommon.Hash, txHash string) types.Log {
+		data := make([]byte, 7*32)
+		big.NewInt(1000).FillBytes(data[32:64])
+		return types.Log{
+			Topics: []ethcommon.Hash{
+				topic,
+				ethcommon.HexToHash("0x000000000000000000000000742d35cc6634c0532925a3b844bc9e7595f0beb7"),
+				ethcommon.HexToHash("0x000000000000000000000000dac17f958d2ee523a2206206994597c13d831ec7"),
+			},
+			Data:        data,
+			TxHash:      ethcommon.HexToHash(txHash),
+			BlockNumber: 100,
+		}
+	}
+	logs := []types.Log{logFor(sendFundsTopic, "0xa1"), logFor(executeTopic, "0xa2")}
+	result, err := json.Marshal(logs)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "eth_chainId":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":"0x1"}`))
+		case "eth_getLogs":
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + string(result) + `}`))
+		default:
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":null}`))
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	require.NoError(t, err)
+	defer rc.Close()
+
+	topics := []ethcommon.Hash{sendFundsTopic, executeTopic}
+	rawEvents := func(t *testing.T, el *EventListener) []store.RawEvent {
+		var raws []store.RawEvent
+		require.NoError(t, el.database.Client().Find(&raws).Error)
+		return raws
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", gatewayMethods, nil, testDB(t), 5, nil, testLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, el.processBlockChunk(context.Background(), 100, 100, topics))
+
+		assert.Empty(t, rawEvents(t, el))
+	})
+
+	t.Run("stores the raw log behind inbounds only", func(t *testing.T) {
+		el, err := NewEventListener(rc, "0xGateway", "0xVault", "eip155:1", gatewayMethods, nil, testDB(t), 5, nil, testLogger(t))
+		require.NoError(t, err)
+		el.SetRawEventCapture(true)
+		require.NoError(t, el.processBlockChunk(context.Background(), 100, 100, topics))
+
+		raws := rawEvents(t, el)
+		require.Len(t, raws, 1)
+		inbound := logs[0]
+		assert.Equal(t, inbound.TxHash.Hex()+":0", raws[0].EventID)
+		assert.Equal(t, uint64(100), raws[0].BlockHeight)
+
+		var decoded types.Log
+		require.NoError(t, json.Unmarshal(raws[0].Payload, &decoded))
+		assert.Equal(t, inbound.Topics, decoded.Topics)
+		assert.Equal(t, inbound.Data, decoded.Data)
+	})
+}
@@ -17,6 +17,7 @@ import (
 // Event type constants matching gateway method names in chain config.
 const (
 	EventTypeSendFunds          = "sendFunds"
+	EventTypeAddFunds           = "addFunds"
 	EventTypeExecuteUniversalTx = "executeUniversalTx"
 	EventTypeRevertUniversalTx  = "revertUniversalTx"
 )
@@ -28,7 +29,8 @@ const (
 )
 
 // ParseEvent parses a log into a store.Event based on the event type.
-// eventType should be one of: sendFunds, executeUniversalTx, revertUniversalTx.
+// eventType should be one of: sendFunds, executeUniversalTx, revertUniversalTx,
+// finalizeUniversalTx, fundsRescued.
 func ParseEvent(log *types.Log, eventType string, chainID string, logger zerolog.Logger) *store.Event {
 	if len(log.Topics) == 0 {
 		return nil
@@ -0,0 +1,63 @@
+package evm
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+)
+
+// eventSignatures are the canonical signatures of the gateway and vault
+// events the listener decodes, by method name. A configured event identifier
+// must be the keccak256 of its method's signature. addFunds is not pinned:
+// the listener does not subscribe to it.
+var eventSignatures = map[string]string{
+	EventTypeSendFunds:           "UniversalTx(address,address,address,uint256,bytes,address,uint8,bytes,bool)",
+	EventTypeExecuteUniversalTx:  "UniversalTxExecuted(bytes32,bytes32,address,address,bytes)",
+	EventTypeRevertUniversalTx:   "RevertUniversalTx(bytes32,bytes32,address,address,uint256,(address,bytes))",
+	EventTypeFinalizeUniversalTx: "UniversalTxFinalized(bytes32,bytes32,address,address,address,uint256,bytes)",
+	EventTypeFundsRescued:        "FundsRescued(bytes32,bytes32,address,uint256,(address,bytes))",
+}
+
+// EventIdentifierMismatch is a configured event identifier that is not the
+// topic of the event the listener expects for that method.
+type EventIdentifierMismatch struct {
+	Method     string
+	Configured string
+	Expected   string
+	Signature  string
+}
+
+// CheckEventIdentifiers compares the configured event identifiers of the
+// gateway and vault methods with the topics of their known event signatures.
+// A mismatch usually means the chain config is stale after a contract
+// upgrade, and the listener would silently miss those events. Methods
+// without an identifier or a known signature are skipped.
+func CheckEventIdentifiers(
+	gatewayMethods []*uregistrytypes.GatewayMethods,
+	vaultMethods []*uregistrytypes.VaultMethods,
+) []EventIdentifierMismatch {
+	var mismatches []EventIdentifierMismatch
+	check := func(name, identifier string) {
+		signature, ok := eventSignatures[name]
+		if !ok || identifier == "" {
+			return
+		}
+		expected := crypto.Keccak256Hash([]byte(signature))
+		if ethcommon.HexToHash(identifier) != expected {
+			mismatches = append(mismatches, EventIdentifierMismatch{
+				Method:     name,
+				Configured: identifier,
+				Expected:   expected.Hex(),
+				Signature:  signature,
+			})
+		}
+	}
+	for _, m := range gatewayMethods {
+		check(m.Name, m.EventIdentifier)
+	}
+	for _, m := range vaultMethods {
+		check(m.Name, m.EventIdentifier)
+	}
+	return mismatches
+}
@@ -0,0 +1,42 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+)
+
+func TestCheckEventIdentifiers(t *testing.T) {
+	// Identifiers as deployed on the testnet gateways and vaults.
+	gateway := []*uregistrytypes.GatewayMethods{
+		{Name: EventTypeSendFunds, EventIdentifier: "0xd9074957cd6846aa1b09b2e676dac3b9cdeecabd643cabd3d0a0f41e2acd1c50"},
+		{Name: EventTypeAddFunds, EventIdentifier: "0xb28f49668e7e76dc96d7aabe5b7f63fecfbd1c3574774c05e8204e749fd96fbd"},
+		{Name: EventTypeExecuteUniversalTx, EventIdentifier: "0xb31071202ec8043435b593e33693894ef14a9106ba62dbbe3766932931c2b01d"},
+		{Name: EventTypeRevertUniversalTx, EventIdentifier: "0x9e72774545963e176bcb028d615b6cc68fbd4601393cd6ef50b2704c3b332eb6"},
+	}
+	vault := []*uregistrytypes.VaultMethods{
+		{Name: EventTypeFinalizeUniversalTx, EventIdentifier: "0xB689A5DB58AF5DE77BFEA50B6D5844E1C1AEED8B24EDD7996A9F8B18AC133819"},
+		{Name: EventTypeFundsRescued, EventIdentifier: "0x25a3527f55f5a35edc28d8df3c716bcd0f3a42c4d82103e716d4ae8263a95e0f"},
+	}
+
+	t.Run("matching identifiers", func(t *testing.T) {
+		assert.Empty(t, CheckEventIdentifiers(gateway, vault))
+	})
+
+	t.Run("stale identifier is reported", func(t *testing.T) {
+		stale := []*uregistrytypes.GatewayMethods{
+			// Identifier left over from an older gateway ABI.
+			{Name: EventTypeSendFunds, EventIdentifier: "0x9b6aa93200000000000000000000000000000000000000000000000000000000"},
+			{Name: EventTypeAddFunds, EventIdentifier: "0x01"}, // no pinned signature: skipped
+			{Name: EventTypeRevertUniversalTx},                 // no identifier: skipped
+		}
+		got := CheckEventIdentifiers(stale, nil)
+		require.Len(t, got, 1)
+		assert.Equal(t, EventTypeSendFunds, got[0].Method)
+		assert.Equal(t, gateway[0].EventIdentifier, got[0].Expected)
+		assert.Equal(t, eventSignatures[EventTypeSendFunds], got[0].Signature)
+	})
+}
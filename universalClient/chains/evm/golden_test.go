@@ -0,0 +1,87 @@
+package evm
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	uetypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite testdata golden files from the current encoder output")
+
+// goldenVectorsFile pins the bytes the TSS signs and the vault calldata for
+// canonical outbound events. Regenerate with
+//
+//	go test ./universalClient/chains/evm -run TestOutboundGoldenVectors -update
+//
+// only when an encoding change is intended, and review the resulting diff.
+const goldenVectorsFile = "outbound_vectors.json"
+
+// outboundVector is one golden case: the event as emitted by uexecutor, the
+// nonce it is signed at, and the expected encoder output (hex, 0x-prefixed).
+type outboundVector struct {
+	Name        string                       `json:"name"`
+	Nonce       uint64                       `json:"nonce"`
+	Event       uetypes.OutboundCreatedEvent `json:"event"`
+	SigningHash string                       `json:"signing_hash"`
+	TxData      string                       `json:"tx_data"`
+}
+
+func TestOutboundGoldenVectors(t *testing.T) {
+	path := filepath.Join("testdata", goldenVectorsFile)
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var vectors []outboundVector
+	require.NoError(t, json.Unmarshal(raw, &vectors))
+	require.NotEmpty(t, vectors)
+
+	builder := newTestTxBuilder(t)
+	for i := range vectors {
+		v := &vectors[i]
+		t.Run(v.Name, func(t *testing.T) {
+			req, err := builder.GetOutboundSigningRequest(context.Background(), &v.Event, v.Nonce)
+			require.NoError(t, err)
+			txData, _, err := builder.outboundCall(&v.Event)
+			require.NoError(t, err)
+
+			signingHash := "0x" + hex.EncodeToString(req.SigningHash)
+			txDataHex := "0x" + hex.EncodeToString(txData)
+			if *updateGolden {
+				v.SigningHash, v.TxData = signingHash, txDataHex
+				return
+			}
+			requireGoldenHex(t, "signing_hash", v.SigningHash, signingHash)
+			requireGoldenHex(t, "tx_data", v.TxData, txDataHex)
+		})
+	}
+
+	if *updateGolden {
+		out, err := json.MarshalIndent(vectors, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, append(out, '\n'), 0o644))
+	}
+}
+
+// requireGoldenHex fails with the first differing byte so an encoding
+// regression points at the field that moved.
+func requireGoldenHex(t *testing.T, field, want, got string) {
+	t.Helper()
+	if want == got {
+		return
+	}
+	wantBytes, _ := hex.DecodeString(removeHexPrefix(want))
+	gotBytes, _ := hex.DecodeString(removeHexPrefix(got))
+	offset := 0
+	for offset < len(wantBytes) && offset < len(gotBytes) && wantBytes[offset] == gotBytes[offset] {
+		offset++
+	}
+	t.Fatalf("%s differs from golden vector at byte %d (want %d bytes, got %d)\nwant: %s\ngot:  %s\nrerun with -update only if the encoding change is intended",
+		field, offset, len(wantBytes), len(gotBytes), want, got)
+}
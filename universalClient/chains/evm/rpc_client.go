@@ -10,17 +10,33 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+)
+
+// Health-check methods selectable per chain via rpc_health_check.
+const (
+	HealthCheckBlockNumber = "block_number" // eth_blockNumber answers (default)
+	HealthCheckSyncing     = "syncing"      // eth_syncing reports the node caught up
 )
 
 // RPCClient provides EVM-specific RPC operations
 type RPCClient struct {
-	clients []*ethclient.Client
-	index   uint64
-	mu      sync.RWMutex
-	logger  zerolog.Logger
+	clients   []*ethclient.Client
+	limiters  []*common.EndpointLimiter // aligned with clients; tracks pacing and 429 cooldowns
+	endpoints []string                  // aligned with clients; redacted URLs for attribution
+	latencies []*common.LatencyWindow   // aligned with clients; recent call latencies for stats
+	index     uint64
+	streak    int    // consecutive successes a degraded endpoint needs; see SetRecoveryStreak
+	probe     string // IsHealthy method, one of the HealthCheck* constants; empty means the default
+	dryRun    bool   // BroadcastTransaction logs instead of sending; see SetDryRun
+	mu        sync.RWMutex
+	gate      common.DrainGate // lets Close wait out in-flight calls
+	logger    zerolog.Logger
 }
 
 // NewRPCClient creates a new EVM RPC client from RPC URLs and validates chain ID
@@ -31,6 +47,7 @@ func NewRPCClient(rpcURLs []string, expectedChainID int64, logger zerolog.Logger
 
 	log := logger.With().Str("component", "evm_rpc_client").Logger()
 	clients := make([]*ethclient.Client, 0, len(rpcURLs))
+	endpoints := make([]string, 0, len(rpcURLs))
 
 	// Create a temporary context for initial connection and chain ID verification
 	// Use longer timeout for chain ID verification (30 seconds)
@@ -55,6 +72,7 @@ func NewRPCClient(rpcURLs []string, expectedChainID int64, logger zerolog.Logger
 				Int64("expected_chain_id", expectedChainID).
 				Msg("failed to verify chain ID (timeout or error), proceeding with client anyway")
 			clients = append(clients, client)
+			endpoints = append(endpoints, common.RedactEndpoint(url))
 			continue
 		}
 
@@ -69,6 +87,7 @@ func NewRPCClient(rpcURLs []string, expectedChainID int64, logger zerolog.Logger
 		}
 
 		clients = append(clients, client)
+		endpoints = append(endpoints, common.RedactEndpoint(url))
 		log.Debug().Int("index", i).Msg("RPC client added to pool")
 	}
 
@@ -77,15 +96,26 @@ func NewRPCClient(rpcURLs []string, expectedChainID int64, logger zerolog.Logger
 	}
 
 	return &RPCClient{
-		clients: clients,
-		logger:  log,
+		clients:   clients,
+		limiters:  newEndpointLimiters(len(clients), 0, 0),
+		endpoints: endpoints,
+		latencies: newLatencyWindows(len(clients)),
+		logger:    log,
 	}, nil
 }
 
 // executeWithFailover executes a function with round-robin failover
 func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string, fn func(*ethclient.Client) error) error {
+	if err := rc.gate.Enter(); err != nil {
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rc.gate.Leave()
+
 	rc.mu.RLock()
 	clients := rc.clients
+	limiters := rc.limiters
+	endpoints := rc.endpoints
+	latencies := rc.latencies
 	rc.mu.RUnlock()
 
 	if len(clients) == 0 {
@@ -97,7 +127,7 @@ func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string,
 	// counter advances and retry the same failing endpoint.
 	startIndex := atomic.AddUint64(&rc.index, 1) - 1
 	var lastErr error
-	for attempt := 0; attempt < maxAttempts; attempt++ {
+	for attempt, idx := range common.OrderEndpoints(len(clients), startIndex, limiters) {
 		if ctx != nil {
 			select {
 			case <-ctx.Done():
@@ -106,18 +136,45 @@ func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string,
 			}
 		}
 
-		client := clients[(startIndex+uint64(attempt))%uint64(len(clients))]
+		client := clients[idx]
 
 		if client == nil {
 			continue
 		}
 
+		var limiter *common.EndpointLimiter
+		if idx < len(limiters) {
+			limiter = limiters[idx]
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		callStart := time.Now()
 		err := fn(client)
+		if idx < len(latencies) {
+			latencies[idx].Observe(time.Since(callStart))
+		}
 		if err == nil {
+			limiter.RecordSuccess()
+			if idx < len(endpoints) {
+				common.RecordEndpoint(ctx, operation, endpoints[idx])
+			}
 			return nil
 		}
 		lastErr = err
 
+		// A throttled endpoint is healthy, just busy: park it briefly so the
+		// next calls prefer its peers, and don't report it as a failure.
+		if common.IsRateLimitError(err) {
+			limiter.Cooldown(common.DefaultRateLimitCooldown)
+			rc.logger.Debug().
+				Str("operation", operation).
+				Int("endpoint", idx).
+				Msg("endpoint rate limited, cooling down")
+			continue
+		}
+
 		rc.logger.Warn().
 			Str("operation", operation).
 			Int("attempt", attempt+1).
@@ -131,18 +188,124 @@ func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string,
 	return fmt.Errorf("operation %s failed after trying %d endpoints", operation, maxAttempts)
 }
 
+// SetRateLimit paces every endpoint with its own token bucket admitting
+// requestsPerSecond with the given burst. A non-positive rate removes pacing;
+// 429 cooldowns apply either way.
+func (rc *RPCClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.limiters = newEndpointLimiters(len(rc.clients), requestsPerSecond, burst)
+	for _, limiter := range rc.limiters {
+		limiter.SetRecoveryStreak(rc.streak)
+	}
+}
+
+// SetDryRun makes BroadcastTransaction log each signed tx and return
+// common.ErrBroadcastSkipped instead of sending it.
+func (rc *RPCClient) SetDryRun(enabled bool) {
+	rc.mu.Lock()
+	rc.dryRun = enabled
+	rc.mu.Unlock()
+}
+
+// SetHealthCheck selects the RPC method IsHealthy probes. An empty method
+// keeps the default (HealthCheckBlockNumber).
+func (rc *RPCClient) SetHealthCheck(method string) error {
+	switch method {
+	case "", HealthCheckBlockNumber, HealthCheckSyncing:
+	default:
+		return fmt.Errorf("unknown health check %q (want %q or %q)", method, HealthCheckBlockNumber, HealthCheckSyncing)
+	}
+	rc.mu.Lock()
+	rc.probe = method
+	rc.mu.Unlock()
+	return nil
+}
+
+// SetRecoveryStreak sets how many consecutive successful calls an endpoint
+// that was rate limited needs before it counts as healthy again. A
+// non-positive n uses common.DefaultRecoveryStreak.
+func (rc *RPCClient) SetRecoveryStreak(n int) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.streak = n
+	for _, limiter := range rc.limiters {
+		limiter.SetRecoveryStreak(n)
+	}
+}
+
+func newLatencyWindows(n int) []*common.LatencyWindow {
+	windows := make([]*common.LatencyWindow, n)
+	for i := range windows {
+		windows[i] = common.NewLatencyWindow(common.DefaultLatencyWindow)
+	}
+	return windows
+}
+
+// EndpointStats reports each endpoint's throttling state and its p50/p95/p99
+// latency over recent calls, in configuration order.
+func (rc *RPCClient) EndpointStats() []common.EndpointStats {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	stats := make([]common.EndpointStats, len(rc.clients))
+	for i := range rc.clients {
+		if i < len(rc.endpoints) {
+			stats[i].Endpoint = rc.endpoints[i]
+		}
+		if i < len(rc.limiters) {
+			stats[i].InCooldown = rc.limiters[i].InCooldown()
+			stats[i].Degraded = rc.limiters[i].Degraded()
+		}
+		if i < len(rc.latencies) {
+			stats[i].Latency = rc.latencies[i].Percentiles()
+		}
+	}
+	return stats
+}
+
+func newEndpointLimiters(n int, requestsPerSecond float64, burst int) []*common.EndpointLimiter {
+	limiters := make([]*common.EndpointLimiter, n)
+	for i := range limiters {
+		limiters[i] = common.NewEndpointLimiter(requestsPerSecond, burst)
+	}
+	return limiters
+}
+
 // IsHealthy checks if any RPC in the pool is healthy by pinging it
 func (rc *RPCClient) IsHealthy(ctx context.Context) bool {
 	rc.mu.RLock()
 	hasClients := len(rc.clients) > 0
+	probe := rc.probe
 	rc.mu.RUnlock()
 
 	if !hasClients {
 		return false
 	}
 
-	_, err := rc.GetLatestBlock(ctx)
-	return err == nil
+	var err error
+	switch probe {
+	case HealthCheckSyncing:
+		err = rc.checkSynced(ctx)
+	default:
+		_, err = rc.GetLatestBlock(ctx)
+	}
+	// A pool that only answers 429s is throttled, not down.
+	return err == nil || common.IsRateLimitError(err)
+}
+
+// checkSynced fails unless eth_syncing reports the node is not syncing.
+func (rc *RPCClient) checkSynced(ctx context.Context) error {
+	return rc.executeWithFailover(ctx, "get_sync_progress", func(client *ethclient.Client) error {
+		progress, err := client.SyncProgress(ctx)
+		if err != nil {
+			return err
+		}
+		if progress != nil {
+			return fmt.Errorf("node is syncing: block %d of %d", progress.CurrentBlock, progress.HighestBlock)
+		}
+		return nil
+	})
 }
 
 // GetLatestBlock returns the latest block number
@@ -169,6 +332,36 @@ func (rc *RPCClient) GetGasPrice(ctx context.Context) (*big.Int, error) {
 	return gasPrice, err
 }
 
+// GetBaseFee returns the EIP-1559 base fee of the latest block, or nil if the
+// chain does not use EIP-1559.
+func (rc *RPCClient) GetBaseFee(ctx context.Context) (*big.Int, error) {
+	var baseFee *big.Int
+	err := rc.executeWithFailover(ctx, "get_base_fee", func(client *ethclient.Client) error {
+		callCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		header, innerErr := client.HeaderByNumber(callCtx, nil)
+		if innerErr != nil {
+			return innerErr
+		}
+		baseFee = header.BaseFee
+		return nil
+	})
+	return baseFee, err
+}
+
+// GetGasTipCap fetches the suggested EIP-1559 priority fee per gas
+func (rc *RPCClient) GetGasTipCap(ctx context.Context) (*big.Int, error) {
+	var tip *big.Int
+	err := rc.executeWithFailover(ctx, "get_gas_tip_cap", func(client *ethclient.Client) error {
+		callCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		var innerErr error
+		tip, innerErr = client.SuggestGasTipCap(callCtx)
+		return innerErr
+	})
+	return tip, err
+}
+
 // GetBalance fetches the native token balance for an address at the latest block.
 func (rc *RPCClient) GetBalance(ctx context.Context, address ethcommon.Address) (*big.Int, error) {
 	var balance *big.Int
@@ -277,8 +470,39 @@ func (rc *RPCClient) CallContractWithFrom(ctx context.Context, from, contractAdd
 	return result, err
 }
 
+// EstimateGasWithFrom estimates the gas a call from the given address would use (eth_estimateGas).
+func (rc *RPCClient) EstimateGasWithFrom(ctx context.Context, from, contractAddr ethcommon.Address, data []byte, value *big.Int) (uint64, error) {
+	var gas uint64
+	err := rc.executeWithFailover(ctx, "estimate_gas", func(client *ethclient.Client) error {
+		var innerErr error
+		gas, innerErr = client.EstimateGas(ctx, ethereum.CallMsg{
+			From:  from,
+			To:    &contractAddr,
+			Data:  data,
+			Value: value,
+		})
+		return innerErr
+	})
+	return gas, err
+}
+
 // BroadcastTransaction broadcasts a signed transaction and returns the transaction hash
 func (rc *RPCClient) BroadcastTransaction(ctx context.Context, tx *types.Transaction) (string, error) {
+	rc.mu.RLock()
+	dryRun := rc.dryRun
+	rc.mu.RUnlock()
+	if dryRun {
+		raw, err := tx.MarshalBinary()
+		if err != nil {
+			return "", fmt.Errorf("failed to encode transaction: %w", err)
+		}
+		rc.logger.Info().
+			Str("tx_hash", tx.Hash().Hex()).
+			Str("raw_tx", hexutil.Encode(raw)).
+			Msg("dry run: transaction built but not broadcast")
+		return tx.Hash().Hex(), common.ErrBroadcastSkipped
+	}
+
 	var txHash string
 	err := rc.executeWithFailover(ctx, "send_transaction", func(client *ethclient.Client) error {
 		innerErr := client.SendTransaction(ctx, tx)
@@ -291,8 +515,15 @@ func (rc *RPCClient) BroadcastTransaction(ctx context.Context, tx *types.Transac
 	return txHash, err
 }
 
-// Close closes all RPC connections
+// Close stops admitting calls, waits up to DefaultDrainTimeout for calls in
+// flight to finish, then closes all RPC connections.
 func (rc *RPCClient) Close() {
+	if !rc.gate.Drain(common.DefaultDrainTimeout) {
+		rc.logger.Warn().
+			Dur("timeout", common.DefaultDrainTimeout).
+			Msg("in-flight RPC calls did not finish before close")
+	}
+
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
 
@@ -2,12 +2,25 @@ package evm
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/rs/zerolog"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
 )
 
 // TestExecuteWithFailover_ConcurrentRotation verifies F-2026-16960 is fixed:
@@ -94,3 +107,340 @@ func TestExecuteWithFailover_SequentialRotation(t *testing.T) {
 		}
 	}
 }
+
+// TestExecuteWithFailover_RateLimitCooldown verifies that a 429 parks the
+// endpoint for a cooldown (later calls start elsewhere) without dropping it:
+// when every other endpoint fails, the cooling endpoint is still tried.
+func TestExecuteWithFailover_RateLimitCooldown(t *testing.T) {
+	clients := []*ethclient.Client{{}, {}}
+	indexOf := map[*ethclient.Client]int{clients[0]: 0, clients[1]: 1}
+
+	rc := &RPCClient{clients: clients, logger: zerolog.Nop()}
+	rc.SetRateLimit(1000, 10)
+
+	// Endpoint 0 answers 429, endpoint 1 serves the request.
+	err := rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error {
+		if indexOf[c] == 0 {
+			return rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected failover to succeed, got %v", err)
+	}
+	if !rc.limiters[0].InCooldown() {
+		t.Fatal("endpoint 0 should be cooling down after a 429")
+	}
+
+	// Next call would start at endpoint 1 anyway; the one after would start at
+	// endpoint 0 but must skip it while it cools down.
+	_ = rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error { return nil })
+	first := -1
+	_ = rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error {
+		if first < 0 {
+			first = indexOf[c]
+		}
+		return nil
+	})
+	if first != 1 {
+		t.Errorf("cooling endpoint should not be tried first, got endpoint %d", first)
+	}
+
+	// Cooldown is not exclusion: with endpoint 1 failing, endpoint 0 still serves.
+	var visited []int
+	err = rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error {
+		visited = append(visited, indexOf[c])
+		if indexOf[c] == 1 {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("cooling endpoint should still be tried as last resort, got %v (visited=%v)", err, visited)
+	}
+	if len(visited) != 2 || visited[1] != 0 {
+		t.Errorf("expected cooling endpoint tried last, visited=%v", visited)
+	}
+}
+
+// TestSetRateLimit_Paces verifies requests are paced per endpoint.
+func TestSetRateLimit_Paces(t *testing.T) {
+	rc := &RPCClient{clients: []*ethclient.Client{{}}, logger: zerolog.Nop()}
+	rc.SetRateLimit(20, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_ = rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error { return nil })
+	}
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("5 requests at 20 RPS should take >= ~200ms, took %v", elapsed)
+	}
+
+	rc.SetRateLimit(0, 0)
+	start = time.Now()
+	for i := 0; i < 5; i++ {
+		_ = rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error { return nil })
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("non-positive rate should remove pacing, took %v", elapsed)
+	}
+}
+
+// TestExecuteWithFailover_RateLimitWithoutPacing verifies 429 classification
+// works without a configured rate: repeated 429s only degrade an endpoint,
+// while genuine errors don't put it in cooldown.
+func TestExecuteWithFailover_RateLimitWithoutPacing(t *testing.T) {
+	clients := []*ethclient.Client{{}, {}}
+	indexOf := map[*ethclient.Client]int{clients[0]: 0, clients[1]: 1}
+	rc := &RPCClient{clients: clients, limiters: newEndpointLimiters(2, 0, 0), logger: zerolog.Nop()}
+
+	// Endpoint 0 is throttled on every call; it must keep being tried and
+	// served whenever endpoint 1 is unavailable.
+	for i := 0; i < 10; i++ {
+		served := -1
+		err := rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error {
+			switch {
+			case indexOf[c] == 0 && i%2 == 0:
+				return rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}
+			case indexOf[c] == 1 && i%2 == 1:
+				return errors.New("connection refused")
+			}
+			served = indexOf[c]
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("call %d: expected an endpoint to serve, got %v", i, err)
+		}
+		if i%2 == 1 && served != 0 {
+			t.Fatalf("call %d: rate-limited endpoint should still serve, served=%d", i, served)
+		}
+	}
+	if !rc.limiters[0].InCooldown() {
+		t.Error("endpoint 0 should be cooling down")
+	}
+	if rc.limiters[1].InCooldown() {
+		t.Error("genuine errors must not be treated as rate limiting")
+	}
+
+	// When every endpoint is throttled the error still surfaces.
+	err := rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error {
+		return rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}
+	})
+	if err == nil {
+		t.Fatal("expected error when every endpoint is rate limited")
+	}
+}
+
+// TestIsHealthy_RateLimited verifies a throttled endpoint is reported healthy.
+func TestIsHealthy_RateLimited(t *testing.T) {
+	var throttle atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == "eth_chainId":
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+		case throttle.Load():
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewRPCClient: %v", err)
+	}
+	defer rc.Close()
+
+	if rc.IsHealthy(context.Background()) {
+		t.Error("endpoint returning 500 should be unhealthy")
+	}
+	throttle.Store(true)
+	if !rc.IsHealthy(context.Background()) {
+		t.Error("endpoint returning 429 should be healthy (throttled, not down)")
+	}
+}
+
+// TestExecuteWithFailover_RecordsServingEndpoint verifies the endpoint that
+// answered — not one that failed first — is reported to the context recorder.
+func TestExecuteWithFailover_RecordsServingEndpoint(t *testing.T) {
+	clients := []*ethclient.Client{{}, {}}
+	rc := &RPCClient{
+		clients:   clients,
+		endpoints: []string{"https://rpc-a.example", "https://rpc-b.example"},
+		logger:    zerolog.Nop(),
+	}
+
+	ctx, rec := common.WithEndpointRecorder(context.Background())
+	err := rc.executeWithFailover(ctx, "send_transaction", func(c *ethclient.Client) error {
+		if c == clients[0] {
+			return errors.New("connection refused")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected endpoint b to serve, got %v", err)
+	}
+	if got := rec.Served()["send_transaction"]; got != "https://rpc-b.example" {
+		t.Errorf("served endpoint = %q, want https://rpc-b.example", got)
+	}
+
+	// Calls that fail on every endpoint record nothing.
+	ctx, rec = common.WithEndpointRecorder(context.Background())
+	_ = rc.executeWithFailover(ctx, "get_nonce", func(c *ethclient.Client) error {
+		return errors.New("connection refused")
+	})
+	if served := rec.Served(); len(served) != 0 {
+		t.Errorf("expected no recorded endpoints, got %v", served)
+	}
+}
+
+// TestEndpointStats_ReportsLatencyPerEndpoint verifies call latencies are
+// attributed to the endpoint that served them.
+func TestEndpointStats_ReportsLatencyPerEndpoint(t *testing.T) {
+	clients := []*ethclient.Client{{}, {}}
+	rc := &RPCClient{
+		clients:   clients,
+		limiters:  newEndpointLimiters(2, 0, 0),
+		endpoints: []string{"https://slow.example", "https://fast.example"},
+		latencies: newLatencyWindows(2),
+		logger:    zerolog.Nop(),
+	}
+
+	for i := 0; i < 4; i++ {
+		_ = rc.executeWithFailover(context.Background(), "test", func(c *ethclient.Client) error {
+			if c == clients[0] {
+				time.Sleep(20 * time.Millisecond)
+			}
+			return nil
+		})
+	}
+
+	stats := rc.EndpointStats()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 endpoint stats, got %d", len(stats))
+	}
+	if stats[0].Endpoint != "https://slow.example" || stats[0].Latency.Count != 2 {
+		t.Errorf("unexpected slow endpoint stats: %+v", stats[0])
+	}
+	if stats[0].Latency.P95 < 20*time.Millisecond {
+		t.Errorf("slow endpoint p95 = %v, want >= 20ms", stats[0].Latency.P95)
+	}
+	if stats[1].Latency.P95 >= 20*time.Millisecond {
+		t.Errorf("fast endpoint p95 = %v, want < 20ms", stats[1].Latency.P95)
+	}
+}
+
+// TestIsHealthy_HealthCheckMethod verifies IsHealthy probes the configured
+// method: eth_blockNumber by default, eth_syncing when selected.
+func TestIsHealthy_HealthCheckMethod(t *testing.T) {
+	var syncing atomic.Bool
+	syncing.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == "eth_chainId":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x1"}`, req.ID)
+		case req.Method == "eth_syncing" && syncing.Load():
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"startingBlock":"0x0","currentBlock":"0x5","highestBlock":"0x10"}}`, req.ID)
+		case req.Method == "eth_syncing":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":false}`, req.ID)
+		default:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x5"}`, req.ID)
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewRPCClient: %v", err)
+	}
+	defer rc.Close()
+
+	if !rc.IsHealthy(context.Background()) {
+		t.Error("default block_number probe should pass while eth_blockNumber answers")
+	}
+
+	if err := rc.SetHealthCheck(HealthCheckSyncing); err != nil {
+		t.Fatalf("SetHealthCheck: %v", err)
+	}
+	if rc.IsHealthy(context.Background()) {
+		t.Error("syncing probe should fail while the node is syncing")
+	}
+	syncing.Store(false)
+	if !rc.IsHealthy(context.Background()) {
+		t.Error("syncing probe should pass once the node is caught up")
+	}
+
+	if err := rc.SetHealthCheck("health"); err == nil {
+		t.Error("expected error for an SVM-only health check")
+	}
+}
+
+func TestBroadcastTransaction_DryRun(t *testing.T) {
+	var sends atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "eth_sendRawTransaction":
+			sends.Add(1)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%064x"}`, req.ID, 1)
+		default:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x1"}`, req.ID)
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewRPCClient: %v", err)
+	}
+	defer rc.Close()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	tx, err := types.SignTx(
+		types.NewTransaction(0, ethcommon.Address{}, big.NewInt(1), 21000, big.NewInt(1), nil),
+		types.NewEIP155Signer(big.NewInt(1)), key,
+	)
+	if err != nil {
+		t.Fatalf("SignTx: %v", err)
+	}
+
+	rc.SetDryRun(true)
+	hash, err := rc.BroadcastTransaction(context.Background(), tx)
+	if !errors.Is(err, common.ErrBroadcastSkipped) {
+		t.Fatalf("expected ErrBroadcastSkipped, got %v", err)
+	}
+	if hash != tx.Hash().Hex() {
+		t.Errorf("dry run returned hash %s, want %s", hash, tx.Hash().Hex())
+	}
+	if n := sends.Load(); n != 0 {
+		t.Errorf("dry run sent %d transactions", n)
+	}
+
+	rc.SetDryRun(false)
+	if _, err := rc.BroadcastTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("BroadcastTransaction: %v", err)
+	}
+	if n := sends.Load(); n != 1 {
+		t.Errorf("expected 1 send after disabling dry run, got %d", n)
+	}
+}
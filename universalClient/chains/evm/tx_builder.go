@@ -3,6 +3,7 @@ package evm
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -12,9 +13,11 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/rs/zerolog"
 
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/config"
 	uetypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 )
 
@@ -33,6 +36,9 @@ type TxBuilder struct {
 	gatewayAddress ethcommon.Address
 	vaultAddress   ethcommon.Address
 	logger         zerolog.Logger
+	valuePolicy    common.ValueConfirmationPolicy
+	minGasPrice    *big.Int // nil = unbounded
+	maxGasPrice    *big.Int // nil = unbounded
 }
 
 // NewTxBuilder creates a new EVM transaction builder for Vault + Gateway.
@@ -104,35 +110,18 @@ func (tb *TxBuilder) GetOutboundSigningRequest(
 	if gasPrice.Sign() == 0 {
 		return nil, fmt.Errorf("gas price is zero or missing in outbound event")
 	}
-
-	gasLimit, err := parseGasLimit(data.GasLimit)
-	if err != nil {
+	if err := tb.checkGasPrice(gasPrice); err != nil {
 		return nil, err
 	}
 
-	amount := new(big.Int)
-	amount, ok := amount.SetString(data.Amount, 10)
-	if !ok {
-		return nil, fmt.Errorf("invalid amount: %s", data.Amount)
-	}
-
-	assetAddr := ethcommon.HexToAddress(data.AssetAddr)
-
-	txType, err := parseTxType(data.TxType)
+	gasLimit, err := parseGasLimit(data.GasLimit)
 	if err != nil {
-		return nil, fmt.Errorf("invalid tx type: %w", err)
+		return nil, err
 	}
 
-	funcName := tb.determineFunctionName(txType, assetAddr)
-
-	txData, err := tb.encodeFunctionCall(funcName, data, amount, assetAddr, txType)
+	txData, txValue, err := tb.outboundCall(data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode function call: %w", err)
-	}
-
-	txValue := big.NewInt(0)
-	if assetAddr == (ethcommon.Address{}) {
-		txValue = amount
+		return nil, err
 	}
 
 	tx := types.NewTransaction(
@@ -144,8 +133,7 @@ func (tb *TxBuilder) GetOutboundSigningRequest(
 		txData,
 	)
 
-	signer := types.NewEIP155Signer(big.NewInt(tb.chainIDInt))
-	txHash := signer.Hash(tx).Bytes()
+	txHash := tb.signer().Hash(tx).Bytes()
 
 	return &common.UnsignedSigningReq{
 		SigningHash: txHash,
@@ -153,6 +141,75 @@ func (tb *TxBuilder) GetOutboundSigningRequest(
 	}, nil
 }
 
+// SetGasPriceBounds makes the builder refuse to sign outbounds whose gas price
+// is outside [minPrice, maxPrice] wei; nil leaves that side unbounded. The
+// bounds never change the signed price, so a validator with different bounds
+// declines to sign rather than signing a different tx.
+func (tb *TxBuilder) SetGasPriceBounds(minPrice, maxPrice *big.Int) {
+	tb.minGasPrice = minPrice
+	tb.maxGasPrice = maxPrice
+}
+
+// ApplyChainConfig sets the gas price bounds and high-value confirmation
+// policy from the chain's config.
+func (tb *TxBuilder) ApplyChainConfig(chainConfig *config.ChainSpecificConfig) {
+	if chainConfig == nil {
+		return
+	}
+	var minGasPrice, maxGasPrice *big.Int
+	if chainConfig.MinGasPrice != nil && *chainConfig.MinGasPrice > 0 {
+		minGasPrice = big.NewInt(*chainConfig.MinGasPrice)
+	}
+	if chainConfig.MaxGasPrice != nil && *chainConfig.MaxGasPrice > 0 {
+		maxGasPrice = big.NewInt(*chainConfig.MaxGasPrice)
+	}
+	tb.SetGasPriceBounds(minGasPrice, maxGasPrice)
+	if len(chainConfig.HighValueThresholds) > 0 && chainConfig.HighValueConfirmations != nil {
+		policy, err := common.NewValueConfirmationPolicy(chainConfig.HighValueThresholds, *chainConfig.HighValueConfirmations)
+		if err != nil {
+			tb.logger.Warn().Err(err).Msg("invalid high-value confirmation policy, using standard confirmations")
+		} else {
+			tb.SetValueConfirmationPolicy(policy)
+		}
+	}
+}
+
+// checkGasPrice rejects an event gas price outside the configured bounds, so
+// a bad oracle value cannot drain the TSS address in one tx. The outbound is
+// held until the price comes back in bounds or the bounds are changed.
+func (tb *TxBuilder) checkGasPrice(gasPrice *big.Int) error {
+	if tb.maxGasPrice != nil && gasPrice.Cmp(tb.maxGasPrice) > 0 {
+		return fmt.Errorf("gas price %s wei is above the configured max %s, refusing to sign", gasPrice, tb.maxGasPrice)
+	}
+	if tb.minGasPrice != nil && gasPrice.Cmp(tb.minGasPrice) < 0 {
+		return fmt.Errorf("gas price %s wei is below the configured min %s, refusing to sign", gasPrice, tb.minGasPrice)
+	}
+	return nil
+}
+
+// signer returns the EIP-155 signer for this chain. Legacy txs signed with it
+// carry v = chainID*2 + 35 + recoveryID, so a signed outbound cannot be
+// replayed on another EVM chain. This is independent of the TSS signature the
+// gateway verifies, which covers the chain ID inside its own message.
+func (tb *TxBuilder) signer() types.Signer {
+	return types.NewEIP155Signer(big.NewInt(tb.chainIDInt))
+}
+
+// applySignature attaches a 65-byte [r|s|v] TSS signature to tx, normalizing it
+// to low-S first: nodes reject high-S signatures (EIP-2) and TSS doesn't
+// guarantee low-S.
+func (tb *TxBuilder) applySignature(tx *types.Transaction, signature []byte) (*types.Transaction, error) {
+	signature, err := common.NormalizeLowS(signature)
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := tx.WithSignature(tb.signer(), signature)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply signature: %w", err)
+	}
+	return signedTx, nil
+}
+
 // GetNextNonce returns the next nonce for the signer.
 func (tb *TxBuilder) GetNextNonce(ctx context.Context, signerAddress string, useFinalized bool) (uint64, error) {
 	if signerAddress == "" {
@@ -182,29 +239,9 @@ func (tb *TxBuilder) BroadcastOutboundSigningRequest(
 		return "", fmt.Errorf("signature must be 65 bytes [r(32)|s(32)|v(1)], got %d", len(signature))
 	}
 
-	amount := new(big.Int)
-	amount, ok := amount.SetString(data.Amount, 10)
-	if !ok {
-		return "", fmt.Errorf("invalid amount: %s", data.Amount)
-	}
-
-	assetAddr := ethcommon.HexToAddress(data.AssetAddr)
-
-	txType, err := parseTxType(data.TxType)
+	txData, txValue, err := tb.outboundCall(data)
 	if err != nil {
-		return "", fmt.Errorf("invalid tx type: %w", err)
-	}
-
-	funcName := tb.determineFunctionName(txType, assetAddr)
-
-	txData, err := tb.encodeFunctionCall(funcName, data, amount, assetAddr, txType)
-	if err != nil {
-		return "", fmt.Errorf("failed to encode function call: %w", err)
-	}
-
-	txValue := big.NewInt(0)
-	if assetAddr == (ethcommon.Address{}) {
-		txValue = amount
+		return "", err
 	}
 
 	gasLimitForTx, err := parseGasLimit(data.GasLimit)
@@ -226,15 +263,17 @@ func (tb *TxBuilder) BroadcastOutboundSigningRequest(
 		txData,
 	)
 
-	signer := types.NewEIP155Signer(big.NewInt(tb.chainIDInt))
-
-	signedTx, err := tx.WithSignature(signer, signature)
+	signedTx, err := tb.applySignature(tx, signature)
 	if err != nil {
-		return "", fmt.Errorf("failed to apply signature: %w", err)
+		return "", err
 	}
 
 	txHashStr := signedTx.Hash().Hex()
 
+	if err := tb.checkRelayerBalance(ctx, signedTx); err != nil {
+		return txHashStr, err
+	}
+
 	if _, err := tb.rpcClient.BroadcastTransaction(ctx, signedTx); err != nil {
 		return txHashStr, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
@@ -246,6 +285,93 @@ func (tb *TxBuilder) BroadcastOutboundSigningRequest(
 	return txHashStr, nil
 }
 
+// outboundCall returns the vault calldata and native value for an outbound.
+func (tb *TxBuilder) outboundCall(data *uetypes.OutboundCreatedEvent) ([]byte, *big.Int, error) {
+	amount, ok := new(big.Int).SetString(data.Amount, 10)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid amount: %s", data.Amount)
+	}
+
+	assetAddr := ethcommon.HexToAddress(data.AssetAddr)
+
+	txType, err := parseTxType(data.TxType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid tx type: %w", err)
+	}
+
+	funcName := tb.determineFunctionName(txType, assetAddr)
+
+	txData, err := tb.encodeFunctionCall(funcName, data, amount, assetAddr, txType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode function call: %w", err)
+	}
+
+	txValue := big.NewInt(0)
+	if assetAddr == (ethcommon.Address{}) {
+		txValue = amount
+	}
+	return txData, txValue, nil
+}
+
+// SimulateOutbound dry-runs an outbound's vault call from the given sender
+// (the TSS address) with eth_call and, if it succeeds, estimates its gas. A
+// revert is reported in the result; other RPC failures are returned as errors.
+func (tb *TxBuilder) SimulateOutbound(ctx context.Context, data *uetypes.OutboundCreatedEvent, from ethcommon.Address) (*common.SimulationResult, error) {
+	if data == nil {
+		return nil, fmt.Errorf("outbound event data is nil")
+	}
+	txData, txValue, err := tb.outboundCall(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := tb.rpcClient.CallContractWithFrom(ctx, from, tb.vaultAddress, txData, txValue, nil)
+	if err != nil {
+		if isRevertError(err) {
+			return &common.SimulationResult{Err: err.Error()}, nil
+		}
+		return nil, fmt.Errorf("eth_call failed: %w", err)
+	}
+
+	result := &common.SimulationResult{Success: true}
+	if len(ret) > 0 {
+		result.Logs = []string{"return data: 0x" + hex.EncodeToString(ret)}
+	}
+	gas, err := tb.rpcClient.EstimateGasWithFrom(ctx, from, tb.vaultAddress, txData, txValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	result.Units = gas
+	return result, nil
+}
+
+// isRevertError reports whether err is the node rejecting a call's execution
+// rather than a transport or endpoint failure.
+func isRevertError(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// checkRelayerBalance returns common.ErrInsufficientRelayerBalance if the
+// sender of signedTx cannot pay its full cost (gas limit × gas price + value),
+// which nodes would otherwise reject only after the signature is spent. A
+// failed balance lookup does not block the broadcast.
+func (tb *TxBuilder) checkRelayerBalance(ctx context.Context, signedTx *types.Transaction) error {
+	sender, err := types.Sender(tb.signer(), signedTx)
+	if err != nil {
+		return fmt.Errorf("failed to recover tx sender: %w", err)
+	}
+	balance, err := tb.rpcClient.GetBalance(ctx, sender)
+	if err != nil {
+		tb.logger.Warn().Err(err).Str("sender", sender.Hex()).Msg("failed to check relayer balance, broadcasting anyway")
+		return nil
+	}
+	return common.CheckRelayerBalance(sender.Hex(), balance, signedTx.Cost())
+}
+
 // VerifyBroadcastedTx checks the status of a broadcasted transaction on the EVM chain.
 func (tb *TxBuilder) VerifyBroadcastedTx(ctx context.Context, txHash string) (found bool, blockHeight uint64, confirmations uint64, status uint8, err error) {
 	hash := ethcommon.HexToHash(txHash)
@@ -265,6 +391,46 @@ func (tb *TxBuilder) VerifyBroadcastedTx(ctx context.Context, txHash string) (fo
 	return true, receiptBlock, confs, uint8(receipt.Status), nil
 }
 
+// SetValueConfirmationPolicy sets the confirmation depth required for
+// high-value outbounds.
+func (tb *TxBuilder) SetValueConfirmationPolicy(p common.ValueConfirmationPolicy) {
+	tb.valuePolicy = p
+}
+
+// RequiredConfirmations returns base, or the high-value block depth when the
+// outbound amount reaches its asset's configured threshold.
+func (tb *TxBuilder) RequiredConfirmations(data *uetypes.OutboundCreatedEvent, base uint64) uint64 {
+	if data == nil {
+		return base
+	}
+	return tb.valuePolicy.RequiredConfirmations(data.AssetAddr, data.Amount, base)
+}
+
+// pausedSelector is the 4-byte selector of the Pausable paused() view.
+var pausedSelector = crypto.Keccak256([]byte("paused()"))[:4]
+
+// IsGatewayPaused reports whether the gateway or the vault is paused. Every
+// outbound is a vault call that settles through the gateway, so either being
+// paused reverts it.
+func (tb *TxBuilder) IsGatewayPaused(ctx context.Context) (bool, error) {
+	for _, contract := range []struct {
+		name    string
+		address ethcommon.Address
+	}{{"gateway", tb.gatewayAddress}, {"vault", tb.vaultAddress}} {
+		result, err := tb.rpcClient.CallContract(ctx, contract.address, pausedSelector, nil)
+		if err != nil {
+			return false, fmt.Errorf("%s paused() call failed: %w", contract.name, err)
+		}
+		if len(result) < 32 {
+			return false, fmt.Errorf("%s paused() returned invalid data (len=%d)", contract.name, len(result))
+		}
+		if new(big.Int).SetBytes(result[:32]).Sign() != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // determineFunctionName determines the Vault function name based on TxType.
 //
 // Routing (all on Vault):
@@ -474,6 +640,59 @@ func (tb *TxBuilder) GetGasFeeUsed(ctx context.Context, txHash string) (string,
 	return gasFeeUsed.String(), nil
 }
 
+// EstimateOutboundCost returns the estimated gas cost in wei of the outbound
+// tx for data: its gas limit times the effective gas price. The effective
+// price is the current network price — base fee plus priority fee on EIP-1559
+// chains, eth_gasPrice otherwise — or the event's gas price the tx
+// is signed with, whichever is higher. Native value sent with the tx is not
+// included.
+func (tb *TxBuilder) EstimateOutboundCost(ctx context.Context, data *uetypes.OutboundCreatedEvent) (*big.Int, error) {
+	if data == nil {
+		return nil, fmt.Errorf("outbound event data is nil")
+	}
+	gasLimit, err := parseGasLimit(data.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, err := tb.currentGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if data.GasPrice != "" {
+		signed, ok := new(big.Int).SetString(data.GasPrice, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid gas price in event data: %s", data.GasPrice)
+		}
+		if signed.Cmp(gasPrice) > 0 {
+			gasPrice = signed
+		}
+	}
+
+	return new(big.Int).Mul(gasLimit, gasPrice), nil
+}
+
+// currentGasPrice returns the price per gas a tx pays at current fees: base
+// fee plus suggested priority fee on EIP-1559 chains, else eth_gasPrice.
+func (tb *TxBuilder) currentGasPrice(ctx context.Context) (*big.Int, error) {
+	baseFee, err := tb.rpcClient.GetBaseFee(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base fee: %w", err)
+	}
+	if baseFee == nil {
+		gasPrice, err := tb.rpcClient.GetGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gas price: %w", err)
+		}
+		return gasPrice, nil
+	}
+	tip, err := tb.rpcClient.GetGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get priority fee: %w", err)
+	}
+	return new(big.Int).Add(baseFee, tip), nil
+}
+
 // GetFundMigrationSigningRequest builds a native token transfer for fund migration,
 // transferring the maximum possible balance (balance minus gas cost minus L1 fee).
 // Fund migration only triggers when outbound is disabled and no pending outbounds remain,
@@ -525,8 +744,7 @@ func (tb *TxBuilder) GetFundMigrationSigningRequest(ctx context.Context, data *c
 		nil, // no calldata for simple transfer
 	)
 
-	signer := types.NewEIP155Signer(big.NewInt(tb.chainIDInt))
-	txHash := signer.Hash(tx).Bytes()
+	txHash := tb.signer().Hash(tx).Bytes()
 
 	// TSSFundMigrationAmount rides alongside Nonce in the req — both are signing-time-decided
 	// values that must reach broadcast unchanged so the signed tx is reproduced exactly.
@@ -568,10 +786,9 @@ func (tb *TxBuilder) BroadcastFundMigrationTx(ctx context.Context, req *common.U
 		nil,
 	)
 
-	signer := types.NewEIP155Signer(big.NewInt(tb.chainIDInt))
-	signedTx, err := tx.WithSignature(signer, signature)
+	signedTx, err := tb.applySignature(tx, signature)
 	if err != nil {
-		return "", fmt.Errorf("failed to apply signature: %w", err)
+		return "", err
 	}
 
 	txHashStr := signedTx.Hash().Hex()
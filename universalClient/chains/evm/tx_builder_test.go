@@ -3,18 +3,25 @@ package evm
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/config"
 	uetypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 )
 
@@ -682,6 +689,42 @@ func TestBroadcastOutboundSigningRequestValidation(t *testing.T) {
 	})
 }
 
+// TestApplySignatureEIP155 tests that signed legacy txs carry the EIP-155 v for
+// the builder's chain ID and recover to the TSS address
+func TestApplySignatureEIP155(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tssAddr := crypto.PubkeyToAddress(key.PublicKey)
+
+	for _, chainID := range []int64{1, 56, 11155111} {
+		builder := newTestTxBuilder(t)
+		builder.chainIDInt = chainID
+
+		tx := types.NewTransaction(7, builder.vaultAddress, big.NewInt(1), 21000, big.NewInt(1e9), nil)
+		sig, err := crypto.Sign(builder.signer().Hash(tx).Bytes(), key)
+		require.NoError(t, err)
+
+		signedTx, err := builder.applySignature(tx, sig)
+		require.NoError(t, err)
+
+		v, _, _ := signedTx.RawSignatureValues()
+		wantV := big.NewInt(chainID*2 + 35 + int64(sig[64]))
+		assert.Equal(t, wantV, v, "chain %d", chainID)
+		assert.True(t, signedTx.Protected())
+		assert.Equal(t, big.NewInt(chainID), signedTx.ChainId())
+
+		from, err := types.Sender(types.NewEIP155Signer(big.NewInt(chainID)), signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, tssAddr, from)
+
+		// The same signed tx does not recover to the TSS address on another chain.
+		other, err := types.Sender(types.NewEIP155Signer(big.NewInt(chainID+1)), signedTx)
+		if err == nil {
+			assert.NotEqual(t, tssAddr, other)
+		}
+	}
+}
+
 // TestGetFunctionSignatureUnknown tests unknown function name returns empty string
 func TestGetFunctionSignatureUnknown(t *testing.T) {
 	builder := newTestTxBuilder(t)
@@ -1263,3 +1306,331 @@ func TestBroadcastFundMigrationTx_DoesNotQueryBalance(t *testing.T) {
 	assert.NotContains(t, err.Error(), "get_balance", "broadcast must not call GetBalance")
 	assert.NotContains(t, err.Error(), "failed to get balance", "broadcast must not call GetBalance")
 }
+
+func TestCheckGasPrice(t *testing.T) {
+	builder := newTestTxBuilder(t)
+	builder.SetGasPriceBounds(big.NewInt(1e9), big.NewInt(100e9))
+
+	assert.ErrorContains(t, builder.checkGasPrice(big.NewInt(5000e9)), "above the configured max")
+	assert.ErrorContains(t, builder.checkGasPrice(big.NewInt(1)), "below the configured min")
+	assert.NoError(t, builder.checkGasPrice(big.NewInt(20e9)), "in bounds")
+	assert.NoError(t, builder.checkGasPrice(big.NewInt(100e9)), "bounds are inclusive")
+
+	builder.SetGasPriceBounds(nil, nil)
+	assert.NoError(t, builder.checkGasPrice(big.NewInt(5000e9)), "unbounded by default")
+}
+
+func TestRequiredConfirmations(t *testing.T) {
+	builder := newTestTxBuilder(t)
+	small := &uetypes.OutboundCreatedEvent{Amount: "999"}
+	large := &uetypes.OutboundCreatedEvent{Amount: "1000"}
+
+	assert.Equal(t, uint64(12), builder.RequiredConfirmations(large, 12), "no policy by default")
+
+	builder.SetValueConfirmationPolicy(common.ValueConfirmationPolicy{Thresholds: map[string]*big.Int{common.NativeAsset: big.NewInt(1000)}, Confirmations: 64})
+	assert.Equal(t, uint64(12), builder.RequiredConfirmations(small, 12), "small outbound keeps standard depth")
+	assert.Equal(t, uint64(64), builder.RequiredConfirmations(large, 12), "high-value outbound needs the escalated depth")
+	assert.Equal(t, uint64(12), builder.RequiredConfirmations(nil, 12))
+}
+
+func TestGetOutboundSigningRequest_GasPriceBounds(t *testing.T) {
+	event := func(gasPrice string) *uetypes.OutboundCreatedEvent {
+		return &uetypes.OutboundCreatedEvent{
+			TxID:             "0x" + hex.EncodeToString(make([]byte, 32)),
+			UniversalTxId:    "0x" + hex.EncodeToString(make([]byte, 32)),
+			DestinationChain: "eip155:11155111",
+			Sender:           "0x1111111111111111111111111111111111111111",
+			Recipient:        "0x2222222222222222222222222222222222222222",
+			Amount:           "1000",
+			TxType:           "FUNDS",
+			GasPrice:         gasPrice,
+			GasLimit:         "21000",
+		}
+	}
+	signingHash := func(t *testing.T, builder *TxBuilder, gasPrice string) []byte {
+		t.Helper()
+		req, err := builder.GetOutboundSigningRequest(context.Background(), event(gasPrice), 3)
+		require.NoError(t, err)
+		return req.SigningHash
+	}
+
+	unbounded := newTestTxBuilder(t)
+	bounded := newTestTxBuilder(t)
+	bounded.SetGasPriceBounds(big.NewInt(1e9), big.NewInt(100e9))
+
+	// Out-of-bounds prices are refused, not rewritten.
+	_, err := bounded.GetOutboundSigningRequest(context.Background(), event("999000000000000"), 3)
+	assert.ErrorContains(t, err, "refusing to sign")
+	_, err = bounded.GetOutboundSigningRequest(context.Background(), event("7"), 3)
+	assert.ErrorContains(t, err, "refusing to sign")
+	// In-bounds prices sign the same tx as a validator with no bounds.
+	assert.Equal(t, signingHash(t, unbounded, "20000000000"), signingHash(t, bounded, "20000000000"))
+}
+
+func TestApplyChainConfig(t *testing.T) {
+	minGasPrice, maxGasPrice := int64(1e9), int64(100e9)
+	confirmations := 64
+
+	builder := newTestTxBuilder(t)
+	builder.ApplyChainConfig(nil)
+	assert.Nil(t, builder.minGasPrice)
+	assert.Nil(t, builder.maxGasPrice)
+
+	builder.ApplyChainConfig(&config.ChainSpecificConfig{
+		MinGasPrice:            &minGasPrice,
+		MaxGasPrice:            &maxGasPrice,
+		HighValueThresholds:    map[string]string{common.NativeAsset: "1000"},
+		HighValueConfirmations: &confirmations,
+	})
+	assert.Equal(t, big.NewInt(1e9), builder.minGasPrice)
+	assert.Equal(t, big.NewInt(100e9), builder.maxGasPrice)
+	assert.Equal(t, uint64(64), builder.RequiredConfirmations(&uetypes.OutboundCreatedEvent{Amount: "1000"}, 12))
+}
+
+// newFeeServer serves the fee RPCs: the latest header carries baseFee (hex
+// wei, omitted when empty for a pre-London chain), eth_maxPriorityFeePerGas
+// returns tip and eth_gasPrice returns gasPrice.
+func newFeeServer(t *testing.T, baseFee, tip, gasPrice string) *httptest.Server {
+	t.Helper()
+	zero32 := `"0x` + strings.Repeat("00", 32) + `"`
+	header := `{"parentHash":` + zero32 + `,"sha3Uncles":` + zero32 + `,"miner":"0x` + strings.Repeat("00", 20) + `",` +
+		`"stateRoot":` + zero32 + `,"transactionsRoot":` + zero32 + `,"receiptsRoot":` + zero32 + `,` +
+		`"logsBloom":"0x` + strings.Repeat("00", 256) + `","difficulty":"0x0","number":"0x10","gasLimit":"0x1c9c380",` +
+		`"gasUsed":"0x0","timestamp":"0x0","extraData":"0x","mixHash":` + zero32 + `,"nonce":"0x0000000000000000"`
+	if baseFee != "" {
+		header += `,"baseFeePerGas":"` + baseFee + `"`
+	}
+	header += `}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		result := "null"
+		switch req.Method {
+		case "eth_chainId":
+			result = `"0x1"`
+		case "eth_getBlockByNumber":
+			result = header
+		case "eth_maxPriorityFeePerGas":
+			result = `"` + tip + `"`
+		case "eth_gasPrice":
+			result = `"` + gasPrice + `"`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEstimateOutboundCost(t *testing.T) {
+	event := func(gasPrice string) *uetypes.OutboundCreatedEvent {
+		return &uetypes.OutboundCreatedEvent{GasLimit: "100000", GasPrice: gasPrice}
+	}
+	newBuilder := func(t *testing.T, server *httptest.Server) *TxBuilder {
+		rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+		require.NoError(t, err)
+		t.Cleanup(rc.Close)
+		builder := newTestTxBuilder(t)
+		builder.rpcClient = rc
+		return builder
+	}
+
+	t.Run("legacy chain uses eth_gasPrice", func(t *testing.T) {
+		builder := newBuilder(t, newFeeServer(t, "", "0x0", "0x4a817c800")) // 20 gwei
+		cost, err := builder.EstimateOutboundCost(context.Background(), event("1000000000"))
+		require.NoError(t, err)
+		assert.Equal(t, "2000000000000000", cost.String()) // 100000 * 20 gwei
+	})
+
+	t.Run("1559 chain uses base fee plus priority fee", func(t *testing.T) {
+		// base 30 gwei + tip 2 gwei; eth_gasPrice is ignored.
+		builder := newBuilder(t, newFeeServer(t, "0x6fc23ac00", "0x77359400", "0x1"))
+		cost, err := builder.EstimateOutboundCost(context.Background(), event("1000000000"))
+		require.NoError(t, err)
+		assert.Equal(t, "3200000000000000", cost.String()) // 100000 * 32 gwei
+	})
+
+	t.Run("signed gas price above network price wins", func(t *testing.T) {
+		builder := newBuilder(t, newFeeServer(t, "0x6fc23ac00", "0x77359400", "0x1"))
+		cost, err := builder.EstimateOutboundCost(context.Background(), event("50000000000"))
+		require.NoError(t, err)
+		assert.Equal(t, "5000000000000000", cost.String()) // 100000 * 50 gwei
+	})
+
+	t.Run("missing gas limit", func(t *testing.T) {
+		builder := newTestTxBuilder(t)
+		_, err := builder.EstimateOutboundCost(context.Background(), &uetypes.OutboundCreatedEvent{})
+		assert.Error(t, err)
+	})
+}
+
+// newCallServer answers eth_call with callResult, or with a revert carrying
+// revertMsg when it is set, and eth_estimateGas with gas.
+func newCallServer(t *testing.T, callResult, revertMsg, gas string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		reply := `"result":null`
+		switch req.Method {
+		case "eth_chainId":
+			reply = `"result":"0x1"`
+		case "eth_call":
+			if revertMsg != "" {
+				reply = `"error":{"code":3,"message":"execution reverted: ` + revertMsg + `","data":"0x08c379a0"}`
+			} else {
+				reply = `"result":"` + callResult + `"`
+			}
+		case "eth_estimateGas":
+			reply = `"result":"` + gas + `"`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,` + reply + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestSimulateOutbound(t *testing.T) {
+	event := &uetypes.OutboundCreatedEvent{
+		TxID:          "0x" + strings.Repeat("11", 32),
+		UniversalTxId: "0x" + strings.Repeat("22", 32),
+		Sender:        "0xabcdef1234567890abcdef1234567890abcdef12",
+		Recipient:     "0x1111111111111111111111111111111111111111",
+		Amount:        "1000",
+		TxType:        "FUNDS",
+	}
+	from := ethcommon.HexToAddress("0x3333333333333333333333333333333333333333")
+	newBuilder := func(t *testing.T, server *httptest.Server) *TxBuilder {
+		rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+		require.NoError(t, err)
+		t.Cleanup(rc.Close)
+		builder := newTestTxBuilder(t)
+		builder.rpcClient = rc
+		return builder
+	}
+
+	t.Run("successful call reports estimated gas", func(t *testing.T) {
+		builder := newBuilder(t, newCallServer(t, "0x", "", "0x15f90"))
+		result, err := builder.SimulateOutbound(context.Background(), event, from)
+		require.NoError(t, err)
+		assert.True(t, result.Success)
+		assert.Empty(t, result.Err)
+		assert.Equal(t, uint64(90000), result.Units)
+		assert.Empty(t, result.Logs)
+	})
+
+	t.Run("revert is reported in the result", func(t *testing.T) {
+		builder := newBuilder(t, newCallServer(t, "", "paused", "0x0"))
+		result, err := builder.SimulateOutbound(context.Background(), event, from)
+		require.NoError(t, err)
+		assert.False(t, result.Success)
+		assert.Contains(t, result.Err, "paused")
+	})
+
+	t.Run("invalid event is an error", func(t *testing.T) {
+		builder := newTestTxBuilder(t)
+		_, err := builder.SimulateOutbound(context.Background(), &uetypes.OutboundCreatedEvent{Amount: "x"}, from)
+		assert.Error(t, err)
+	})
+}
+
+func TestIsGatewayPaused(t *testing.T) {
+	newBuilder := func(t *testing.T, server *httptest.Server) *TxBuilder {
+		rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+		require.NoError(t, err)
+		t.Cleanup(rc.Close)
+		builder := newTestTxBuilder(t)
+		builder.rpcClient = rc
+		return builder
+	}
+	word := func(v byte) string { return "0x" + strings.Repeat("00", 31) + hex.EncodeToString([]byte{v}) }
+
+	paused, err := newBuilder(t, newCallServer(t, word(0), "", "0x0")).IsGatewayPaused(context.Background())
+	require.NoError(t, err)
+	assert.False(t, paused)
+
+	paused, err = newBuilder(t, newCallServer(t, word(1), "", "0x0")).IsGatewayPaused(context.Background())
+	require.NoError(t, err)
+	assert.True(t, paused)
+
+	_, err = newBuilder(t, newCallServer(t, "", "no paused()", "0x0")).IsGatewayPaused(context.Background())
+	assert.Error(t, err)
+}
+
+func TestBroadcastOutboundSigningRequest_RelayerBalanceGuard(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// Native outbound: cost = 21000 gas * 1 gwei + 1000 wei value.
+	data := &uetypes.OutboundCreatedEvent{
+		TxID:             "0x" + hex.EncodeToString(make([]byte, 32)),
+		UniversalTxId:    "0x" + hex.EncodeToString(make([]byte, 32)),
+		DestinationChain: "eip155:1",
+		Sender:           "0x1111111111111111111111111111111111111111",
+		Recipient:        "0x2222222222222222222222222222222222222222",
+		Amount:           "1000",
+		TxType:           "FUNDS",
+		GasPrice:         "1000000000",
+		GasLimit:         "21000",
+	}
+	const cost = 21000*1000000000 + 1000
+
+	run := func(t *testing.T, balance int64) (int32, error) {
+		var sends atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     json.RawMessage `json:"id"`
+				Method string          `json:"method"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			result := "null"
+			switch req.Method {
+			case "eth_chainId":
+				result = `"0x1"`
+			case "eth_getBalance":
+				result = `"0x` + big.NewInt(balance).Text(16) + `"`
+			case "eth_sendRawTransaction":
+				sends.Add(1)
+				result = `"0x` + strings.Repeat("22", 32) + `"`
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+		}))
+		t.Cleanup(server.Close)
+
+		rc, err := NewRPCClient([]string{server.URL}, 1, zerolog.Nop())
+		require.NoError(t, err)
+		t.Cleanup(rc.Close)
+		builder := newTestTxBuilder(t)
+		builder.chainIDInt = 1
+		builder.rpcClient = rc
+
+		req, err := builder.GetOutboundSigningRequest(context.Background(), data, 0)
+		require.NoError(t, err)
+		sig, err := crypto.Sign(req.SigningHash, key)
+		require.NoError(t, err)
+
+		_, err = builder.BroadcastOutboundSigningRequest(context.Background(), req, data, sig)
+		return sends.Load(), err
+	}
+
+	t.Run("sufficient balance broadcasts", func(t *testing.T) {
+		sends, err := run(t, cost)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), sends)
+	})
+
+	t.Run("insufficient balance is not broadcast", func(t *testing.T) {
+		sends, err := run(t, cost-1)
+		assert.ErrorIs(t, err, common.ErrInsufficientRelayerBalance)
+		assert.Equal(t, int32(0), sends)
+	})
+}
@@ -17,7 +17,7 @@ import (
 type Client struct {
 	logger        zerolog.Logger
 	pushCore      *pushcore.Client
-	database      *db.DB
+	database      db.Store
 	eventListener *EventListener
 	eventCleaner  *common.EventCleaner
 	ctx           context.Context
@@ -26,7 +26,7 @@ type Client struct {
 
 // NewClient creates a new Push chain client
 func NewClient(
-	database *db.DB,
+	database db.Store,
 	chainConfig *config.ChainSpecificConfig,
 	pushCore *pushcore.Client,
 	chainID string,
@@ -132,3 +132,14 @@ func (c *Client) IsHealthy() bool {
 func (c *Client) GetTxBuilder() (common.TxBuilder, error) {
 	return nil, fmt.Errorf("txBuilder not supported for Push chain")
 }
+
+// SetOutboundCeilings sets the per-chain, per-asset amount ceilings above
+// which outbounds are held for manual review. Call before Start.
+func (c *Client) SetOutboundCeilings(ceilings common.OutboundCeilings) {
+	c.eventListener.SetOutboundCeilings(ceilings)
+}
+
+// EventStats reports how well the client keeps up with Push Chain's event stream.
+func (c *Client) EventStats() EventStats {
+	return c.eventListener.Stats()
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
 	"github.com/pushchain/push-chain-node/universalClient/config"
 	"github.com/pushchain/push-chain-node/universalClient/db"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/pushcore"
 	"github.com/pushchain/push-chain-node/universalClient/store"
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 	"github.com/rs/zerolog"
 )
 
@@ -26,6 +28,13 @@ var (
 // Config holds configuration for the Push event listener.
 type Config struct {
 	PollInterval time.Duration
+	// BatchSize is the page size used when fetching pending events. Each poll
+	// pages through the whole backlog; 0 uses pushcore.DefaultPendingPageSize.
+	BatchSize uint64
+	// MaxInFlightSigns pauses fetching outbounds and fund migrations while
+	// this many sign events are CONFIRMED or IN_PROGRESS locally, i.e. queued
+	// for or inside a TSS session. 0 disables the limit.
+	MaxInFlightSigns int
 }
 
 // EventListener polls Push chain for active TSS events and pending outbounds
@@ -36,6 +45,14 @@ type EventListener struct {
 	cfg        Config
 	logger     zerolog.Logger
 
+	signsPaused bool // only touched by the poll loop
+
+	ceilings common.OutboundCeilings // per destination chain and asset; set before Start
+
+	observed *common.RateMeter // new events stored; see Stats
+	statsMu  sync.Mutex
+	stats    EventStats // backlog and height as of the last poll
+
 	mu      sync.Mutex
 	running bool
 	cancel  context.CancelFunc
@@ -45,7 +62,7 @@ type EventListener struct {
 // NewEventListener creates a new Push event listener.
 func NewEventListener(
 	pushCore *pushcore.Client,
-	database *db.DB,
+	database db.Store,
 	logger zerolog.Logger,
 	chainConfig *config.ChainSpecificConfig,
 ) (*EventListener, error) {
@@ -60,15 +77,30 @@ func NewEventListener(
 	if chainConfig != nil && chainConfig.EventPollingIntervalSeconds != nil && *chainConfig.EventPollingIntervalSeconds > 0 {
 		pollInterval = time.Duration(*chainConfig.EventPollingIntervalSeconds) * time.Second
 	}
+	var batchSize uint64
+	if chainConfig != nil && chainConfig.EventBatchSize != nil && *chainConfig.EventBatchSize > 0 {
+		batchSize = uint64(*chainConfig.EventBatchSize)
+	}
+	var maxInFlightSigns int
+	if chainConfig != nil && chainConfig.MaxInFlightSignEvents != nil && *chainConfig.MaxInFlightSignEvents > 0 {
+		maxInFlightSigns = *chainConfig.MaxInFlightSignEvents
+	}
 
 	return &EventListener{
 		pushCore:   pushCore,
 		chainStore: common.NewChainStore(database),
-		cfg:        Config{PollInterval: pollInterval},
+		cfg:        Config{PollInterval: pollInterval, BatchSize: batchSize, MaxInFlightSigns: maxInFlightSigns},
 		logger:     logger.With().Str("component", "push_event_listener").Logger(),
+		observed:   common.NewRateMeter(common.DefaultRateWindow),
 	}, nil
 }
 
+// SetOutboundCeilings sets the per-chain, per-asset amount ceilings above
+// which outbounds are held for manual review. Call before Start.
+func (el *EventListener) SetOutboundCeilings(ceilings common.OutboundCeilings) {
+	el.ceilings = ceilings
+}
+
 // Start begins polling for Push chain events.
 func (el *EventListener) Start(ctx context.Context) error {
 	el.mu.Lock()
@@ -84,6 +116,8 @@ func (el *EventListener) Start(ctx context.Context) error {
 
 	el.logger.Debug().
 		Dur("poll_interval", el.cfg.PollInterval).
+		Uint64("batch_size", el.cfg.BatchSize).
+		Int("max_in_flight_signs", el.cfg.MaxInFlightSigns).
 		Msg("starting Push event listener")
 
 	el.wg.Add(1)
@@ -115,11 +149,14 @@ func (el *EventListener) IsRunning() bool {
 	return el.running
 }
 
-// run is the main loop: poll immediately, then on every tick.
+// run is the main loop: poll immediately, then on every tick. The first poll
+// doubles as the startup catch-up: the pending outbound set on Push Chain
+// holds every outbound not yet finalized, so outbounds created while this
+// node was down are picked up regardless of the block they were created at.
 func (el *EventListener) run(ctx context.Context) {
 	defer el.wg.Done()
 
-	el.poll(ctx)
+	el.poll(ctx, true)
 
 	ticker := time.NewTicker(el.cfg.PollInterval)
 	defer ticker.Stop()
@@ -129,16 +166,40 @@ func (el *EventListener) run(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			el.poll(ctx)
+			el.poll(ctx, false)
 		}
 	}
 }
 
 // poll fetches pending TSS, outbound & fund migration events, stores them, and updates latest block height.
-func (el *EventListener) poll(ctx context.Context) {
+// On the startup poll the outbound catch-up is summarized.
+func (el *EventListener) poll(ctx context.Context, startup bool) {
 	tssCount := el.pollTssEvents(ctx)
-	outboundCount := el.pollOutboundEvents(ctx)
-	migrationCount := el.pollFundMigrationEvents(ctx)
+	// Key events are never throttled: a pending keygen or quorum change must
+	// not wait behind a signing backlog.
+	var outbounds outboundCounts
+	var migrationCount int
+	if room := el.signRoom(); room != 0 {
+		outbounds = el.pollOutboundEvents(ctx, room)
+		if room != unlimitedSignRoom {
+			room -= outbounds.stored - outbounds.held
+		}
+		if room != 0 {
+			migrationCount = el.pollFundMigrationEvents(ctx, room)
+		}
+	}
+	outboundCount := outbounds.stored
+
+	if startup {
+		el.logger.Info().
+			Int("outstanding", outbounds.outstanding).
+			Int("enqueued", outbounds.stored).
+			Int("held", outbounds.held).
+			Int("already_known", outbounds.known).
+			Int("finalized", outbounds.finalized).
+			Int("deferred", outbounds.deferred).
+			Msg("startup outbound catch-up complete")
+	}
 
 	if total := tssCount + outboundCount + migrationCount; total > 0 {
 		el.logger.Info().
@@ -152,16 +213,18 @@ func (el *EventListener) poll(ctx context.Context) {
 	latestBlock, err := el.pushCore.GetLatestBlock(ctx)
 	if err != nil {
 		el.logger.Error().Err(err).Msg("failed to get latest block height")
+		el.refreshStats(0)
 		return
 	}
 	if err := el.chainStore.UpdateChainHeight(latestBlock); err != nil {
 		el.logger.Error().Err(err).Uint64("height", latestBlock).Msg("failed to persist chain height")
 	}
+	el.refreshStats(latestBlock)
 }
 
 // pollTssEvents fetches pending TSS events and inserts them into the DB. Returns new event count.
 func (el *EventListener) pollTssEvents(ctx context.Context) int {
-	tssEvents, err := el.pushCore.GetPendingTssEvents(ctx)
+	tssEvents, err := el.pushCore.GetPendingTssEvents(ctx, el.cfg.BatchSize)
 	if err != nil {
 		el.logger.Error().Err(err).Msg("failed to fetch pending TSS events")
 		return 0
@@ -181,13 +244,73 @@ func (el *EventListener) pollTssEvents(ctx context.Context) int {
 	return newCount
 }
 
-// pollOutboundEvents fetches pending outbounds and inserts them into the DB.
-// Returns new event count.
-func (el *EventListener) pollOutboundEvents(ctx context.Context) int {
-	entries, outbounds, err := el.pushCore.GetAllPendingOutbounds(ctx)
+// unlimitedSignRoom is signRoom's result when no in-flight cap is set.
+const unlimitedSignRoom = -1
+
+// signRoom returns how many new sign events the local backlog has room for,
+// or unlimitedSignRoom without a cap, logging when fetching pauses and
+// resumes. Events already on Push Chain stay pending there, so pausing or
+// deferring loses nothing.
+func (el *EventListener) signRoom() int {
+	if el.cfg.MaxInFlightSigns <= 0 {
+		return unlimitedSignRoom
+	}
+
+	inFlight, err := el.chainStore.CountEvents(
+		[]string{store.EventTypeSignOutbound, store.EventTypeSignFundMigrate},
+		[]string{store.StatusConfirmed, store.StatusInProgress},
+	)
+	if err != nil {
+		// Fail open: the limit protects against overload, not correctness.
+		el.logger.Warn().Err(err).Msg("failed to count in-flight sign events; fetching anyway")
+		return unlimitedSignRoom
+	}
+
+	room := max(int64(el.cfg.MaxInFlightSigns)-inFlight, 0)
+	atCapacity := room == 0
+	if atCapacity != el.signsPaused {
+		el.signsPaused = atCapacity
+		if atCapacity {
+			el.logger.Warn().
+				Int64("in_flight", inFlight).
+				Int("max_in_flight_signs", el.cfg.MaxInFlightSigns).
+				Msg("sign backlog at capacity; pausing outbound and fund migration fetching")
+		} else {
+			el.logger.Info().
+				Int64("in_flight", inFlight).
+				Msg("sign backlog below capacity; resuming outbound and fund migration fetching")
+		}
+	}
+	return int(room)
+}
+
+// outboundCounts summarizes one pass over the pending outbound set.
+type outboundCounts struct {
+	outstanding int // outbounds reported pending by Push Chain
+	stored      int // newly enqueued
+	held        int // of stored, held for manual review
+	known       int // already in the local DB (whatever their local status) or failed to store
+	finalized   int // already finalized on chain, so skipped
+	deferred    int // not enqueued for lack of sign room; fetched again next poll
+}
+
+// outboundFinalized reports whether an outbound has left PENDING on chain.
+func outboundFinalized(s uexecutortypes.Status) bool {
+	switch s {
+	case uexecutortypes.Status_OBSERVED, uexecutortypes.Status_REVERTED, uexecutortypes.Status_ABORTED:
+		return true
+	default:
+		return false
+	}
+}
+
+// pollOutboundEvents fetches pending outbounds and inserts up to room of them
+// into the DB.
+func (el *EventListener) pollOutboundEvents(ctx context.Context, room int) outboundCounts {
+	entries, outbounds, err := el.pushCore.GetAllPendingOutbounds(ctx, el.cfg.BatchSize)
 	if err != nil {
 		el.logger.Error().Err(err).Msg("failed to fetch pending outbounds")
-		return 0
+		return outboundCounts{}
 	}
 
 	if len(entries) != len(outbounds) {
@@ -195,26 +318,66 @@ func (el *EventListener) pollOutboundEvents(ctx context.Context) int {
 			Int("entries", len(entries)).
 			Int("outbounds", len(outbounds)).
 			Msg("mismatched entries and outbounds lengths")
-		return 0
+		return outboundCounts{}
 	}
 
-	var newCount int
+	return el.storeOutbounds(entries, outbounds, room)
+}
+
+// storeOutbounds enqueues pending outbounds not yet known locally. Outbounds
+// already observed, reverted or aborted on chain are skipped: the pending set
+// can briefly lag finalization, and signing them again would only waste a
+// TSS session. Outbounds already in the DB are left untouched, so a completed
+// local event is never re-enqueued. Outbounds above their asset's ceiling on
+// the destination chain are stored as HELD so no TSS session picks them up.
+//
+// At most room outbounds are enqueued for signing (unlimitedSignRoom for no
+// cap), so one page cannot overshoot the in-flight cap; the rest stay
+// pending on Push Chain for a later poll. Held outbounds take no room.
+func (el *EventListener) storeOutbounds(entries []*uexecutortypes.PendingOutboundEntry, outbounds []*uexecutortypes.OutboundTx, room int) outboundCounts {
+	counts := outboundCounts{outstanding: len(entries)}
 	for i, entry := range entries {
+		if ob := outbounds[i]; ob != nil && outboundFinalized(ob.OutboundStatus) {
+			counts.finalized++
+			continue
+		}
+		if room != unlimitedSignRoom && counts.stored-counts.held >= room {
+			counts.deferred++
+			continue
+		}
+
 		event, err := convertOutboundToEvent(entry, outbounds[i])
 		if err != nil {
 			el.logger.Warn().Err(err).Str("outbound_id", entry.OutboundId).Msg("failed to convert outbound event")
 			continue
 		}
+		if ob := outbounds[i]; el.ceilings.Exceeds(ob.DestinationChain, ob.ExternalAssetAddr, ob.Amount) {
+			event.Status = store.StatusHeld
+		}
 
-		newCount += el.storeEvent(event)
+		if el.storeEvent(event) == 1 {
+			counts.stored++
+			if event.Status == store.StatusHeld {
+				counts.held++
+				log := logger.WithTraceID(el.logger, entry.UniversalTxId)
+				log.Warn().
+					Str("outbound_id", entry.OutboundId).
+					Str("destination_chain", outbounds[i].DestinationChain).
+					Str("asset", outbounds[i].ExternalAssetAddr).
+					Str("amount", outbounds[i].Amount).
+					Msg("outbound exceeds the asset's max outbound amount; held for manual review")
+			}
+		} else {
+			counts.known++
+		}
 	}
-
-	return newCount
+	return counts
 }
 
-// pollFundMigrationEvents fetches pending fund migrations and inserts them into the DB.
-// Returns new event count.
-func (el *EventListener) pollFundMigrationEvents(ctx context.Context) int {
+// pollFundMigrationEvents fetches pending fund migrations and inserts up to
+// room of them into the DB (unlimitedSignRoom for no cap). Returns new event
+// count.
+func (el *EventListener) pollFundMigrationEvents(ctx context.Context, room int) int {
 	migrations, err := el.pushCore.GetPendingFundMigrations(ctx)
 	if err != nil {
 		el.logger.Error().Err(err).Msg("failed to fetch pending fund migrations")
@@ -223,6 +386,9 @@ func (el *EventListener) pollFundMigrationEvents(ctx context.Context) int {
 
 	var newCount int
 	for _, m := range migrations {
+		if room != unlimitedSignRoom && newCount >= room {
+			break
+		}
 		event, err := convertFundMigrationEvent(m)
 		if err != nil {
 			el.logger.Warn().Err(err).Uint64("migration_id", m.Id).Msg("failed to convert fund migration event")
@@ -238,13 +404,15 @@ func (el *EventListener) pollFundMigrationEvents(ctx context.Context) int {
 // storeEvent inserts an event into the DB if it doesn't already exist.
 // Returns 1 if stored, 0 if duplicate or error.
 func (el *EventListener) storeEvent(event *store.Event) int {
+	log := logger.WithTraceID(el.logger, event.UniversalTxID())
 	stored, err := el.chainStore.InsertEventIfNotExists(event)
 	if err != nil {
-		el.logger.Error().Err(err).Str("event_id", event.EventID).Msg("failed to store event")
+		log.Error().Err(err).Str("event_id", event.EventID).Msg("failed to store event")
 		return 0
 	}
 	if stored {
-		el.logger.Debug().
+		el.observed.Mark(1, time.Now())
+		log.Debug().
 			Str("event_id", event.EventID).
 			Str("type", event.Type).
 			Uint64("block_height", event.BlockHeight).
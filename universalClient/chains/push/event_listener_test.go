@@ -1,11 +1,17 @@
 package push
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
 	"github.com/pushchain/push-chain-node/universalClient/config"
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -49,6 +55,30 @@ func TestNewEventListener(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, DefaultPollInterval, el.cfg.PollInterval)
 	})
+
+	t.Run("custom batch size from config", func(t *testing.T) {
+		batch := 50
+		cfg := config.ChainSpecificConfig{EventBatchSize: &batch}
+		el, err := NewEventListener(client, db, logger, &cfg)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(50), el.cfg.BatchSize)
+	})
+
+	t.Run("max in-flight signs from config", func(t *testing.T) {
+		maxSigns := 25
+		cfg := config.ChainSpecificConfig{MaxInFlightSignEvents: &maxSigns}
+		el, err := NewEventListener(client, db, logger, &cfg)
+		require.NoError(t, err)
+		assert.Equal(t, 25, el.cfg.MaxInFlightSigns)
+	})
+
+	t.Run("non-positive batch size uses default", func(t *testing.T) {
+		batch := -1
+		cfg := config.ChainSpecificConfig{EventBatchSize: &batch}
+		el, err := NewEventListener(client, db, logger, &cfg)
+		require.NoError(t, err)
+		assert.Zero(t, el.cfg.BatchSize)
+	})
 }
 
 func TestEventListener_StartStop(t *testing.T) {
@@ -95,3 +125,260 @@ func TestErrors(t *testing.T) {
 func TestDefaultPollInterval(t *testing.T) {
 	assert.Equal(t, 2*time.Second, DefaultPollInterval)
 }
+
+func TestStoreOutbounds(t *testing.T) {
+	pending := func(id string, createdAt int64) (*uexecutortypes.PendingOutboundEntry, *uexecutortypes.OutboundTx) {
+		return &uexecutortypes.PendingOutboundEntry{OutboundId: id, UniversalTxId: "utx-" + id, CreatedAt: createdAt},
+			&uexecutortypes.OutboundTx{Id: id, DestinationChain: "eip155:1", Amount: "1", OutboundStatus: uexecutortypes.Status_PENDING}
+	}
+
+	t.Run("enqueues outstanding outbounds created while offline", func(t *testing.T) {
+		database := newTestDB(t)
+		el, err := NewEventListener(newTestPushCoreClient(), database, zerolog.Nop(), nil)
+		require.NoError(t, err)
+
+		e1, o1 := pending("0xa", 10)
+		e2, o2 := pending("0xb", 20)
+		counts := el.storeOutbounds(
+			[]*uexecutortypes.PendingOutboundEntry{e1, e2},
+			[]*uexecutortypes.OutboundTx{o1, o2},
+			unlimitedSignRoom,
+		)
+
+		assert.Equal(t, outboundCounts{outstanding: 2, stored: 2}, counts)
+		var events []store.Event
+		require.NoError(t, database.Client().Order("block_height").Find(&events).Error)
+		require.Len(t, events, 2)
+		assert.Equal(t, "0xa", events[0].EventID)
+		assert.Equal(t, store.StatusConfirmed, events[0].Status)
+		assert.Equal(t, store.EventTypeSignOutbound, events[1].Type)
+	})
+
+	t.Run("leaves locally completed outbounds untouched", func(t *testing.T) {
+		database := newTestDB(t)
+		el, err := NewEventListener(newTestPushCoreClient(), database, zerolog.Nop(), nil)
+		require.NoError(t, err)
+		require.NoError(t, database.Client().Create(&store.Event{
+			EventID:          "0xdone",
+			Type:             store.EventTypeSignOutbound,
+			ConfirmationType: store.ConfirmationInstant,
+			Status:           store.StatusCompleted,
+			EventData:        []byte(`{}`),
+		}).Error)
+
+		e1, o1 := pending("0xdone", 10)
+		e2, o2 := pending("0xnew", 20)
+		counts := el.storeOutbounds(
+			[]*uexecutortypes.PendingOutboundEntry{e1, e2},
+			[]*uexecutortypes.OutboundTx{o1, o2},
+			unlimitedSignRoom,
+		)
+
+		assert.Equal(t, outboundCounts{outstanding: 2, stored: 1, known: 1}, counts)
+		var done store.Event
+		require.NoError(t, database.Client().Where("event_id = ?", "0xdone").First(&done).Error)
+		assert.Equal(t, store.StatusCompleted, done.Status)
+	})
+
+	t.Run("skips outbounds already finalized on chain", func(t *testing.T) {
+		database := newTestDB(t)
+		el, err := NewEventListener(newTestPushCoreClient(), database, zerolog.Nop(), nil)
+		require.NoError(t, err)
+
+		var entries []*uexecutortypes.PendingOutboundEntry
+		var outbounds []*uexecutortypes.OutboundTx
+		for i, status := range []uexecutortypes.Status{
+			uexecutortypes.Status_OBSERVED,
+			uexecutortypes.Status_REVERTED,
+			uexecutortypes.Status_ABORTED,
+		} {
+			e, o := pending(fmt.Sprintf("0xfinal%d", i), int64(i))
+			o.OutboundStatus = status
+			entries = append(entries, e)
+			outbounds = append(outbounds, o)
+		}
+
+		counts := el.storeOutbounds(entries, outbounds, unlimitedSignRoom)
+
+		assert.Equal(t, outboundCounts{outstanding: 3, finalized: 3}, counts)
+		var n int64
+		require.NoError(t, database.Client().Model(&store.Event{}).Count(&n).Error)
+		assert.Zero(t, n)
+	})
+
+	t.Run("holds outbounds above the destination chain's ceiling", func(t *testing.T) {
+		database := newTestDB(t)
+		el, err := NewEventListener(newTestPushCoreClient(), database, zerolog.Nop(), nil)
+		require.NoError(t, err)
+		ceilings := common.OutboundCeilings{}
+		require.NoError(t, ceilings.Set("eip155:1", common.NativeAsset, "100"))
+		el.SetOutboundCeilings(ceilings)
+
+		e1, o1 := pending("0xunder", 10)
+		o1.Amount = "100"
+		e2, o2 := pending("0xover", 20)
+		o2.Amount = "101"
+		e3, o3 := pending("0xtoken", 30)
+		o3.Amount = "1000000"
+		o3.ExternalAssetAddr = "0x1111111111111111111111111111111111111111"
+		counts := el.storeOutbounds(
+			[]*uexecutortypes.PendingOutboundEntry{e1, e2, e3},
+			[]*uexecutortypes.OutboundTx{o1, o2, o3},
+			unlimitedSignRoom,
+		)
+
+		assert.Equal(t, outboundCounts{outstanding: 3, stored: 3, held: 1}, counts)
+		var events []store.Event
+		require.NoError(t, database.Client().Order("block_height").Find(&events).Error)
+		require.Len(t, events, 3)
+		assert.Equal(t, store.StatusConfirmed, events[0].Status, "under the ceiling proceeds")
+		assert.Equal(t, store.StatusHeld, events[1].Status, "over the ceiling is held for review")
+		assert.Equal(t, store.StatusConfirmed, events[2].Status, "the native ceiling does not cap other assets")
+	})
+}
+
+func TestStoreOutbounds_SignRoom(t *testing.T) {
+	database := newTestDB(t)
+	el, err := NewEventListener(newTestPushCoreClient(), database, zerolog.Nop(), nil)
+	require.NoError(t, err)
+	ceilings := common.OutboundCeilings{}
+	require.NoError(t, ceilings.Set("eip155:1", common.NativeAsset, "100"))
+	el.SetOutboundCeilings(ceilings)
+
+	var entries []*uexecutortypes.PendingOutboundEntry
+	var outbounds []*uexecutortypes.OutboundTx
+	for i, amount := range []string{"1", "500", "2", "3", "4"} {
+		id := fmt.Sprintf("0x%d", i)
+		entries = append(entries, &uexecutortypes.PendingOutboundEntry{OutboundId: id, UniversalTxId: "utx-" + id, CreatedAt: int64(10 + i)})
+		outbounds = append(outbounds, &uexecutortypes.OutboundTx{Id: id, DestinationChain: "eip155:1", Amount: amount, OutboundStatus: uexecutortypes.Status_PENDING})
+	}
+
+	// Room for two: the held outbound takes none, the last two wait on chain.
+	counts := el.storeOutbounds(entries, outbounds, 2)
+	assert.Equal(t, outboundCounts{outstanding: 5, stored: 3, held: 1, deferred: 2}, counts)
+
+	var n int64
+	require.NoError(t, database.Client().Model(&store.Event{}).Where("status = ?", store.StatusConfirmed).Count(&n).Error)
+	assert.Equal(t, int64(2), n)
+}
+
+func TestPoll_SignBackpressure(t *testing.T) {
+	var logs bytes.Buffer
+	database := newTestDB(t)
+	maxSigns := 2
+	cfg := config.ChainSpecificConfig{MaxInFlightSignEvents: &maxSigns}
+	// The test pushcore client has no endpoints, so every fetch attempt logs
+	// an error; that is how the test observes which fetches were made.
+	el, err := NewEventListener(newTestPushCoreClient(), database, zerolog.New(&logs), &cfg)
+	require.NoError(t, err)
+
+	for _, id := range []string{"0x1", "0x2"} {
+		require.NoError(t, database.Client().Create(&store.Event{
+			EventID:          id,
+			Type:             store.EventTypeSignOutbound,
+			ConfirmationType: store.ConfirmationInstant,
+			Status:           store.StatusInProgress,
+			EventData:        []byte(`{}`),
+		}).Error)
+	}
+
+	t.Run("stops fetching sign events at capacity", func(t *testing.T) {
+		logs.Reset()
+		el.poll(context.Background(), false)
+
+		assert.NotContains(t, logs.String(), "failed to fetch pending outbounds")
+		assert.NotContains(t, logs.String(), "failed to fetch pending fund migrations")
+		assert.Contains(t, logs.String(), "failed to fetch pending TSS events", "key events are never throttled")
+		assert.Contains(t, logs.String(), "pausing outbound and fund migration fetching")
+	})
+
+	t.Run("resumes when a session completes", func(t *testing.T) {
+		require.NoError(t, database.Client().Model(&store.Event{}).
+			Where("event_id = ?", "0x1").Update("status", store.StatusSigned).Error)
+
+		logs.Reset()
+		el.poll(context.Background(), false)
+
+		assert.Contains(t, logs.String(), "failed to fetch pending outbounds")
+		assert.Contains(t, logs.String(), "failed to fetch pending fund migrations")
+		assert.Contains(t, logs.String(), "resuming outbound and fund migration fetching")
+	})
+
+	t.Run("room is the cap minus the in-flight sign events", func(t *testing.T) {
+		assert.Equal(t, 1, el.signRoom())
+	})
+
+	t.Run("no limit when unset", func(t *testing.T) {
+		unlimited, err := NewEventListener(newTestPushCoreClient(), database, zerolog.Nop(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, unlimitedSignRoom, unlimited.signRoom())
+	})
+}
+
+func TestEventListener_Stats(t *testing.T) {
+	database := newTestDB(t)
+	el, err := NewEventListener(newTestPushCoreClient(), database, zerolog.Nop(), nil)
+	require.NoError(t, err)
+
+	pending := func(id string, createdAt int64) (*uexecutortypes.PendingOutboundEntry, *uexecutortypes.OutboundTx) {
+		return &uexecutortypes.PendingOutboundEntry{OutboundId: id, UniversalTxId: "utx-" + id, CreatedAt: createdAt},
+			&uexecutortypes.OutboundTx{Id: id, DestinationChain: "eip155:1", Amount: "1", OutboundStatus: uexecutortypes.Status_PENDING}
+	}
+	e1, o1 := pending("0xa", 100)
+	e2, o2 := pending("0xb", 150)
+	el.storeOutbounds([]*uexecutortypes.PendingOutboundEntry{e1, e2}, []*uexecutortypes.OutboundTx{o1, o2}, unlimitedSignRoom)
+
+	t.Run("observed events raise the rate", func(t *testing.T) {
+		assert.Greater(t, el.Stats().ObservedPerSecond, 0.0)
+	})
+
+	t.Run("backlog and lag follow the oldest unfinished event", func(t *testing.T) {
+		el.refreshStats(200)
+		stats := el.Stats()
+		assert.Equal(t, int64(2), stats.Backlog)
+		assert.Equal(t, uint64(200), stats.ChainHeight)
+		assert.Equal(t, uint64(100), stats.LagBlocks)
+
+		// Finishing the oldest event moves the lag to the next one.
+		require.NoError(t, database.Client().Model(&store.Event{}).
+			Where("event_id = ?", "0xa").Update("status", store.StatusCompleted).Error)
+		el.refreshStats(210)
+		stats = el.Stats()
+		assert.Equal(t, int64(1), stats.Backlog)
+		assert.Equal(t, uint64(60), stats.LagBlocks)
+
+		// A failed height lookup keeps the last height.
+		require.NoError(t, database.Client().Model(&store.Event{}).
+			Where("event_id = ?", "0xb").Update("status", store.StatusCompleted).Error)
+		el.refreshStats(0)
+		stats = el.Stats()
+		assert.Equal(t, uint64(210), stats.ChainHeight)
+		assert.Zero(t, stats.Backlog)
+		assert.Zero(t, stats.LagBlocks, "caught up")
+	})
+
+	t.Run("gauges read the current stats", func(t *testing.T) {
+		gauges := func(stats func() (EventStats, bool)) map[string]float64 {
+			reg := prometheus.NewRegistry()
+			for _, c := range EventStatsCollectors(stats) {
+				require.NoError(t, reg.Register(c))
+			}
+			families, err := reg.Gather()
+			require.NoError(t, err)
+			values := make(map[string]float64)
+			for _, f := range families {
+				values[f.GetName()] = f.GetMetric()[0].GetGauge().GetValue()
+			}
+			return values
+		}
+
+		values := gauges(func() (EventStats, bool) { return el.Stats(), true })
+		assert.Equal(t, 210.0, values["puniversal_push_chain_height"])
+		assert.Zero(t, values["puniversal_push_event_backlog"])
+		assert.Contains(t, values, "puniversal_push_events_observed_per_second")
+		assert.Contains(t, values, "puniversal_push_event_lag_blocks")
+
+		values = gauges(func() (EventStats, bool) { return EventStats{Backlog: 9}, false })
+		assert.Zero(t, values["puniversal_push_event_backlog"], "zero until the client runs")
+	})
+}
@@ -0,0 +1,88 @@
+package push
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+)
+
+// backlogStatuses are the states of an event still being worked on locally.
+var backlogStatuses = []string{
+	store.StatusPending,
+	store.StatusConfirmed,
+	store.StatusInProgress,
+	store.StatusSigned,
+	store.StatusGatewayPaused,
+	store.StatusBroadcasted,
+}
+
+// EventStats shows whether the client keeps up with Push Chain's event stream.
+type EventStats struct {
+	ObservedPerSecond float64 // new events stored, averaged over the last minute
+	Backlog           int64   // stored events not yet in a terminal state
+	ChainHeight       uint64  // latest Push Chain height seen
+	LagBlocks         uint64  // ChainHeight minus the height of the oldest backlog event; 0 when caught up
+}
+
+// Stats returns the listener's current event stream stats. Backlog and lag
+// are as of the last poll.
+func (el *EventListener) Stats() EventStats {
+	el.statsMu.Lock()
+	stats := el.stats
+	el.statsMu.Unlock()
+	stats.ObservedPerSecond = el.observed.Rate(time.Now())
+	return stats
+}
+
+// refreshStats recomputes backlog and lag against chainHeight. A zero
+// chainHeight (the height lookup failed) keeps the last known height.
+func (el *EventListener) refreshStats(chainHeight uint64) {
+	backlog, oldest, err := el.chainStore.BacklogStats(backlogStatuses)
+	if err != nil {
+		el.logger.Warn().Err(err).Msg("failed to query event backlog")
+		return
+	}
+
+	el.statsMu.Lock()
+	defer el.statsMu.Unlock()
+	if chainHeight > 0 {
+		el.stats.ChainHeight = chainHeight
+	}
+	el.stats.Backlog = backlog
+	el.stats.LagBlocks = 0
+	if backlog > 0 && el.stats.ChainHeight > oldest {
+		el.stats.LagBlocks = el.stats.ChainHeight - oldest
+	}
+}
+
+// EventStatsCollectors returns gauges for the metrics endpoint that read
+// stats on every scrape. stats reports false until the Push Chain client is
+// running, in which case the gauges read zero.
+func EventStatsCollectors(stats func() (EventStats, bool)) []prometheus.Collector {
+	gauge := func(name, help string, value func(EventStats) float64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: "puniversal",
+			Subsystem: "push",
+			Name:      name,
+			Help:      help,
+		}, func() float64 {
+			s, ok := stats()
+			if !ok {
+				return 0
+			}
+			return value(s)
+		})
+	}
+	return []prometheus.Collector{
+		gauge("events_observed_per_second", "New Push Chain events stored per second, averaged over the last minute.",
+			func(s EventStats) float64 { return s.ObservedPerSecond }),
+		gauge("event_backlog", "Stored Push Chain events not yet in a terminal state.",
+			func(s EventStats) float64 { return float64(s.Backlog) }),
+		gauge("chain_height", "Latest Push Chain block height seen by the event listener.",
+			func(s EventStats) float64 { return float64(s.ChainHeight) }),
+		gauge("event_lag_blocks", "Blocks between the Push Chain height and the oldest unfinished event.",
+			func(s EventStats) float64 { return float64(s.LagBlocks) }),
+	}
+}
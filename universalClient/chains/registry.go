@@ -0,0 +1,149 @@
+package chains
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+)
+
+// ChainStatus is the lifecycle state of a registered chain client
+type ChainStatus string
+
+const (
+	// ChainStatusRunning: the client is started and serving requests
+	ChainStatusRunning ChainStatus = "running"
+	// ChainStatusStopped: the client was stopped via StopChain and stays
+	// registered (and untouched by config sync) until StartChain
+	ChainStatusStopped ChainStatus = "stopped"
+)
+
+// ChainInfo is a point-in-time snapshot of a registered chain client
+type ChainInfo struct {
+	ChainID         string
+	VmType          uregistrytypes.VmType
+	Status          ChainStatus
+	Healthy         bool // always false while stopped
+	InboundEnabled  bool
+	OutboundEnabled bool
+}
+
+// StopChain stops a single chain client without removing it from the
+// registry. Other chains keep running. Stopping an already stopped chain is a
+// no-op; the Push chain client cannot be stopped.
+func (c *Chains) StopChain(chainID string) error {
+	c.chainOpMu.Lock()
+	defer c.chainOpMu.Unlock()
+
+	if chainID == c.pushChainID {
+		return fmt.Errorf("push chain client cannot be stopped")
+	}
+
+	c.chainsMu.RLock()
+	client, exists := c.chains[chainID]
+	stopped := c.stopped[chainID]
+	c.chainsMu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("chain client not found for chain %s", chainID)
+	}
+	if stopped {
+		return nil
+	}
+
+	// Mark first so callers stop receiving the client while it shuts down
+	c.chainsMu.Lock()
+	c.stopped[chainID] = true
+	c.chainsMu.Unlock()
+
+	if err := client.Stop(); err != nil {
+		c.logger.Error().Err(err).Str("chain", chainID).Msg("error stopping chain client")
+	}
+
+	c.logger.Info().Str("chain", chainID).Msg("chain client stopped")
+	return nil
+}
+
+// StartChain restarts a chain client previously stopped via StopChain.
+// Starting a running chain is a no-op.
+func (c *Chains) StartChain(ctx context.Context, chainID string) error {
+	c.chainOpMu.Lock()
+	defer c.chainOpMu.Unlock()
+
+	c.chainsMu.RLock()
+	client, exists := c.chains[chainID]
+	stopped := c.stopped[chainID]
+	c.chainsMu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("chain client not found for chain %s", chainID)
+	}
+	if !stopped {
+		return nil
+	}
+
+	if err := client.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start chain client: %w", err)
+	}
+
+	c.chainsMu.Lock()
+	delete(c.stopped, chainID)
+	c.chainsMu.Unlock()
+
+	c.logger.Info().Str("chain", chainID).Msg("chain client started")
+	return nil
+}
+
+// Status returns the lifecycle status of a chain client and whether the
+// chain is registered at all.
+func (c *Chains) Status(chainID string) (ChainStatus, bool) {
+	c.chainsMu.RLock()
+	defer c.chainsMu.RUnlock()
+
+	if _, exists := c.chains[chainID]; !exists {
+		return "", false
+	}
+	if c.stopped[chainID] {
+		return ChainStatusStopped, true
+	}
+	return ChainStatusRunning, true
+}
+
+// ListChains returns a snapshot of all registered chain clients, sorted by
+// chain ID.
+func (c *Chains) ListChains() []ChainInfo {
+	c.chainsMu.RLock()
+	defer c.chainsMu.RUnlock()
+
+	infos := make([]ChainInfo, 0, len(c.chains))
+	for chainID, client := range c.chains {
+		info := ChainInfo{
+			ChainID: chainID,
+			Status:  ChainStatusRunning,
+		}
+		if c.stopped[chainID] {
+			info.Status = ChainStatusStopped
+		} else {
+			info.Healthy = client.IsHealthy()
+		}
+		if cfg := c.chainConfigs[chainID]; cfg != nil {
+			info.VmType = cfg.VmType
+			if cfg.Enabled != nil {
+				info.InboundEnabled = cfg.Enabled.IsInboundEnabled
+				info.OutboundEnabled = cfg.Enabled.IsOutboundEnabled
+			}
+		}
+		infos = append(infos, info)
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ChainID < infos[j].ChainID })
+	return infos
+}
+
+// isStopped reports whether chainID was stopped via StopChain
+func (c *Chains) isStopped(chainID string) bool {
+	c.chainsMu.RLock()
+	defer c.chainsMu.RUnlock()
+	return c.stopped[chainID]
+}
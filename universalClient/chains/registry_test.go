@@ -0,0 +1,172 @@
+package chains
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+)
+
+// addTestChain registers a mock client as if addChain had started it.
+func addTestChain(c *Chains, chainID string, vmType uregistrytypes.VmType) *mockChainClient {
+	mock := &mockChainClient{}
+	c.chains[chainID] = mock
+	c.chainConfigs[chainID] = &uregistrytypes.ChainConfig{
+		Chain:  chainID,
+		VmType: vmType,
+		Enabled: &uregistrytypes.ChainEnabled{
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+		},
+	}
+	return mock
+}
+
+func TestStopChain(t *testing.T) {
+	t.Run("stopping one chain leaves others running", func(t *testing.T) {
+		c := newTestChains()
+		evmClient := addTestChain(c, "eip155:1", uregistrytypes.VmType_EVM)
+		svmClient := addTestChain(c, "solana:devnet", uregistrytypes.VmType_SVM)
+
+		require.NoError(t, c.StopChain("eip155:1"))
+
+		assert.True(t, evmClient.stopCalled)
+		assert.False(t, svmClient.stopCalled)
+
+		status, ok := c.Status("eip155:1")
+		require.True(t, ok)
+		assert.Equal(t, ChainStatusStopped, status)
+		status, ok = c.Status("solana:devnet")
+		require.True(t, ok)
+		assert.Equal(t, ChainStatusRunning, status)
+
+		_, err := c.GetClient("eip155:1")
+		assert.ErrorContains(t, err, "stopped")
+		client, err := c.GetClient("solana:devnet")
+		require.NoError(t, err)
+		assert.Equal(t, svmClient, client)
+
+		// Config stays registered while stopped
+		assert.True(t, c.IsEVMChain("eip155:1"))
+	})
+
+	t.Run("stopping a stopped chain is a no-op", func(t *testing.T) {
+		c := newTestChains()
+		mock := addTestChain(c, "eip155:1", uregistrytypes.VmType_EVM)
+		require.NoError(t, c.StopChain("eip155:1"))
+		mock.stopCalled = false
+
+		require.NoError(t, c.StopChain("eip155:1"))
+		assert.False(t, mock.stopCalled)
+	})
+
+	t.Run("unknown chain returns error", func(t *testing.T) {
+		c := newTestChains()
+		assert.Error(t, c.StopChain("eip155:999"))
+	})
+
+	t.Run("push chain cannot be stopped", func(t *testing.T) {
+		c := newTestChains()
+		mock := addTestChain(c, c.pushChainID, uregistrytypes.VmType_EVM)
+
+		assert.Error(t, c.StopChain(c.pushChainID))
+		assert.False(t, mock.stopCalled)
+	})
+}
+
+func TestStartChain(t *testing.T) {
+	t.Run("restarts a stopped chain", func(t *testing.T) {
+		c := newTestChains()
+		mock := addTestChain(c, "eip155:1", uregistrytypes.VmType_EVM)
+		require.NoError(t, c.StopChain("eip155:1"))
+
+		require.NoError(t, c.StartChain(context.Background(), "eip155:1"))
+
+		assert.True(t, mock.startCalled)
+		status, _ := c.Status("eip155:1")
+		assert.Equal(t, ChainStatusRunning, status)
+		_, err := c.GetClient("eip155:1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("starting a running chain is a no-op", func(t *testing.T) {
+		c := newTestChains()
+		mock := addTestChain(c, "eip155:1", uregistrytypes.VmType_EVM)
+
+		require.NoError(t, c.StartChain(context.Background(), "eip155:1"))
+		assert.False(t, mock.startCalled)
+	})
+
+	t.Run("unknown chain returns error", func(t *testing.T) {
+		c := newTestChains()
+		assert.Error(t, c.StartChain(context.Background(), "eip155:999"))
+	})
+}
+
+func TestStatus_UnknownChain(t *testing.T) {
+	c := newTestChains()
+	status, ok := c.Status("eip155:1")
+	assert.False(t, ok)
+	assert.Empty(t, status)
+}
+
+func TestListChains(t *testing.T) {
+	c := newTestChains()
+	addTestChain(c, "solana:devnet", uregistrytypes.VmType_SVM)
+	addTestChain(c, "eip155:1", uregistrytypes.VmType_EVM)
+	c.chainConfigs["eip155:1"].Enabled.IsInboundEnabled = false
+	require.NoError(t, c.StopChain("solana:devnet"))
+
+	infos := c.ListChains()
+
+	require.Len(t, infos, 2)
+	assert.Equal(t, ChainInfo{
+		ChainID:         "eip155:1",
+		VmType:          uregistrytypes.VmType_EVM,
+		Status:          ChainStatusRunning,
+		Healthy:         true,
+		InboundEnabled:  false,
+		OutboundEnabled: true,
+	}, infos[0])
+	assert.Equal(t, ChainInfo{
+		ChainID:         "solana:devnet",
+		VmType:          uregistrytypes.VmType_SVM,
+		Status:          ChainStatusStopped,
+		Healthy:         false,
+		InboundEnabled:  true,
+		OutboundEnabled: true,
+	}, infos[1])
+}
+
+func TestRemoveAndStopAll_SkipStoppedChains(t *testing.T) {
+	t.Run("remove does not stop a stopped chain again", func(t *testing.T) {
+		c := newTestChains()
+		mock := addTestChain(c, "eip155:1", uregistrytypes.VmType_EVM)
+		require.NoError(t, c.StopChain("eip155:1"))
+		mock.stopCalled = false
+
+		require.NoError(t, c.removeChain("eip155:1"))
+
+		assert.False(t, mock.stopCalled)
+		_, ok := c.Status("eip155:1")
+		assert.False(t, ok)
+		assert.False(t, c.isStopped("eip155:1"))
+	})
+
+	t.Run("stop all only stops running chains", func(t *testing.T) {
+		c := newTestChains()
+		stoppedClient := addTestChain(c, "eip155:1", uregistrytypes.VmType_EVM)
+		runningClient := addTestChain(c, "eip155:97", uregistrytypes.VmType_EVM)
+		require.NoError(t, c.StopChain("eip155:1"))
+		stoppedClient.stopCalled = false
+
+		c.StopAll()
+
+		assert.False(t, stoppedClient.stopCalled)
+		assert.True(t, runningClient.stopCalled)
+		assert.Empty(t, c.ListChains())
+	})
+}
@@ -0,0 +1,105 @@
+package svm
+
+import (
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/pushchain/push-chain-node/universalClient/config"
+)
+
+// The gateway CPIs into the target program with the payload's accounts as
+// remaining accounts, in payload order. A payload whose accounts don't match
+// what the target program expects only fails inside the CPI, after the TSS
+// signature and the relayer fee are spent. An ExecuteAccountsValidator
+// registered for a target program catches that before signing.
+//
+// Validators only check; they never reorder. The account list is part of the
+// TSS-signed message, so any rewrite would have to happen identically on
+// every validator and would no longer be what the sender asked for.
+
+// ExecuteAccountsValidator checks an execute-mode payload's accounts for one
+// target program.
+type ExecuteAccountsValidator func(accounts []GatewayAccountMeta) error
+
+// AccountRule requires Pubkey at position Index of the remaining accounts,
+// and with the given writability when Writable is set.
+type AccountRule struct {
+	Index    int
+	Pubkey   solana.PublicKey
+	Writable *bool
+}
+
+// NewAccountRulesValidator returns a validator enforcing every rule.
+func NewAccountRulesValidator(rules []AccountRule) ExecuteAccountsValidator {
+	return func(accounts []GatewayAccountMeta) error {
+		for _, r := range rules {
+			if r.Index < 0 || r.Index >= len(accounts) {
+				return fmt.Errorf("account %s required at index %d, but payload has %d accounts", r.Pubkey, r.Index, len(accounts))
+			}
+			got := accounts[r.Index]
+			if solana.PublicKeyFromBytes(got.Pubkey[:]) != r.Pubkey {
+				return fmt.Errorf("account at index %d is %s, want %s", r.Index, solana.PublicKeyFromBytes(got.Pubkey[:]), r.Pubkey)
+			}
+			if r.Writable != nil && got.IsWritable != *r.Writable {
+				return fmt.Errorf("account %s at index %d has writable=%t, want %t", r.Pubkey, r.Index, got.IsWritable, *r.Writable)
+			}
+		}
+		return nil
+	}
+}
+
+// SetExecuteAccountsValidator registers v for execute-mode outbounds targeting
+// program, replacing any previous one; nil removes it. Not safe to call
+// concurrently with signing.
+func (tb *TxBuilder) SetExecuteAccountsValidator(program solana.PublicKey, v ExecuteAccountsValidator) {
+	if v == nil {
+		delete(tb.executeValidators, program)
+		return
+	}
+	if tb.executeValidators == nil {
+		tb.executeValidators = make(map[solana.PublicKey]ExecuteAccountsValidator)
+	}
+	tb.executeValidators[program] = v
+}
+
+// validateExecuteAccounts runs the validator registered for targetProgram,
+// if any.
+func (tb *TxBuilder) validateExecuteAccounts(targetProgram [32]byte, accounts []GatewayAccountMeta) error {
+	program := solana.PublicKeyFromBytes(targetProgram[:])
+	v, ok := tb.executeValidators[program]
+	if !ok {
+		return nil
+	}
+	if err := v(accounts); err != nil {
+		return fmt.Errorf("execute accounts rejected for target program %s: %w", program, err)
+	}
+	return nil
+}
+
+// loadExecuteAccountRules registers validators for the configured execute
+// account rules. A program with a malformed rule gets no validator; the
+// others are still loaded.
+func (tb *TxBuilder) loadExecuteAccountRules(cfg map[string][]config.ExecuteAccountRule) {
+	for programStr, cfgRules := range cfg {
+		program, err := solana.PublicKeyFromBase58(programStr)
+		if err != nil {
+			tb.logger.Warn().Err(err).Str("program", programStr).Msg("invalid execute account rules program address, skipping")
+			continue
+		}
+		rules := make([]AccountRule, 0, len(cfgRules))
+		for _, r := range cfgRules {
+			pubkey, err := solana.PublicKeyFromBase58(r.Pubkey)
+			if err != nil || r.Index < 0 {
+				tb.logger.Warn().Str("program", programStr).Int("index", r.Index).Str("pubkey", r.Pubkey).
+					Msg("invalid execute account rule, skipping program")
+				rules = nil
+				break
+			}
+			rules = append(rules, AccountRule{Index: r.Index, Pubkey: pubkey, Writable: r.Writable})
+		}
+		if len(rules) > 0 {
+			tb.SetExecuteAccountsValidator(program, NewAccountRulesValidator(rules))
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package svm
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pushchain/push-chain-node/universalClient/config"
+)
+
+func accountMeta(pubkey solana.PublicKey, writable bool) GatewayAccountMeta {
+	var raw [32]byte
+	copy(raw[:], pubkey.Bytes())
+	return GatewayAccountMeta{Pubkey: raw, IsWritable: writable}
+}
+
+func TestAccountRulesValidator(t *testing.T) {
+	state := solana.NewWallet().PublicKey()
+	readOnly := false
+	// Target program expects [state (writable), any, clock sysvar (read-only)].
+	validate := NewAccountRulesValidator([]AccountRule{
+		{Index: 0, Pubkey: state},
+		{Index: 2, Pubkey: solana.SysVarClockPubkey, Writable: &readOnly},
+	})
+	other := solana.NewWallet().PublicKey()
+
+	t.Run("accounts in expected order pass", func(t *testing.T) {
+		err := validate([]GatewayAccountMeta{
+			accountMeta(state, true),
+			accountMeta(other, true),
+			accountMeta(solana.SysVarClockPubkey, false),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("required account missing", func(t *testing.T) {
+		err := validate([]GatewayAccountMeta{
+			accountMeta(state, true),
+			accountMeta(other, true),
+		})
+		assert.ErrorContains(t, err, "required at index 2")
+	})
+
+	t.Run("required account out of position", func(t *testing.T) {
+		err := validate([]GatewayAccountMeta{
+			accountMeta(state, true),
+			accountMeta(solana.SysVarClockPubkey, false),
+			accountMeta(other, true),
+		})
+		assert.ErrorContains(t, err, "account at index 2")
+	})
+
+	t.Run("wrong writability", func(t *testing.T) {
+		err := validate([]GatewayAccountMeta{
+			accountMeta(state, true),
+			accountMeta(other, true),
+			accountMeta(solana.SysVarClockPubkey, true),
+		})
+		assert.ErrorContains(t, err, "writable=true, want false")
+	})
+}
+
+func TestValidateExecuteAccounts(t *testing.T) {
+	program := solana.NewWallet().PublicKey()
+	var target [32]byte
+	copy(target[:], program.Bytes())
+	accounts := []GatewayAccountMeta{accountMeta(solana.NewWallet().PublicKey(), true)}
+
+	builder := newTestBuilder(t)
+	assert.NoError(t, builder.validateExecuteAccounts(target, accounts), "no validator registered")
+
+	builder.SetExecuteAccountsValidator(program, func([]GatewayAccountMeta) error { return errors.New("bad order") })
+	err := builder.validateExecuteAccounts(target, accounts)
+	assert.ErrorContains(t, err, "bad order")
+	assert.ErrorContains(t, err, program.String())
+
+	var otherTarget [32]byte
+	copy(otherTarget[:], solana.NewWallet().PublicKey().Bytes())
+	assert.NoError(t, builder.validateExecuteAccounts(otherTarget, accounts), "validators are keyed by target program")
+
+	builder.SetExecuteAccountsValidator(program, nil)
+	assert.NoError(t, builder.validateExecuteAccounts(target, accounts), "nil removes the validator")
+}
+
+func TestNewTxBuilder_ExecuteAccountRules(t *testing.T) {
+	program := solana.NewWallet().PublicKey()
+	broken := solana.NewWallet().PublicKey()
+	cfg := &config.ChainSpecificConfig{
+		ExecuteAccountRules: map[string][]config.ExecuteAccountRule{
+			program.String(): {{Index: 0, Pubkey: solana.SysVarRentPubkey.String()}},
+			broken.String():  {{Index: 0, Pubkey: "not-base58!"}},
+			"not-a-program":  {{Index: 0, Pubkey: solana.SysVarRentPubkey.String()}},
+		},
+	}
+	builder, err := NewTxBuilder(&RPCClient{}, "solana:devnet", testGatewayAddress, "/tmp", zerolog.Nop(), cfg)
+	require.NoError(t, err)
+
+	require.Len(t, builder.executeValidators, 1)
+	var target [32]byte
+	copy(target[:], program.Bytes())
+	assert.Error(t, builder.validateExecuteAccounts(target, []GatewayAccountMeta{accountMeta(solana.SystemProgramID, false)}))
+	assert.NoError(t, builder.validateExecuteAccounts(target, []GatewayAccountMeta{accountMeta(solana.SysVarRentPubkey, false)}))
+}
@@ -3,6 +3,7 @@ package svm
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
@@ -26,7 +27,7 @@ type Client struct {
 
 	// Infrastructure
 	rpcClient *RPCClient
-	database  *db.DB
+	database  db.Store
 	ctx       context.Context
 	cancel    context.CancelFunc
 
@@ -38,16 +39,20 @@ type Client struct {
 	chainMetaOracle *ChainMetaOracle
 	txBuilder       *TxBuilder
 	rentReclaimer   *RentReclaimer
+	balanceMonitor  *common.BalanceMonitor
 
 	// Dependencies
 	pushSigner *pushsigner.Signer
 	nodeHome   string
+
+	// dryRun builds outbound txs without broadcasting them; see SetDryRun
+	dryRun bool
 }
 
 // NewClient creates a new Solana chain client
 func NewClient(
 	config *uregistrytypes.ChainConfig,
-	database *db.DB,
+	database db.Store,
 	chainConfig *config.ChainSpecificConfig,
 	pushSigner *pushsigner.Signer,
 	nodeHome string,
@@ -93,6 +98,7 @@ func NewClient(
 		chainIDStr,
 		log,
 	)
+	client.eventCleaner.SetRawEventRetention(chainConfig.RawEventRetentionSeconds)
 
 	// Initialize components that don't require RPC client
 	if pushSigner != nil {
@@ -111,6 +117,21 @@ func NewClient(
 	return client, nil
 }
 
+// SetDryRun makes the client build and sign outbound txs as usual but log
+// them instead of broadcasting. Call it before Start.
+func (c *Client) SetDryRun(enabled bool) {
+	c.dryRun = enabled
+}
+
+// SetReportTurn restricts this chain's outbound result votes to the
+// validators whose turn it is; see common.EventProcessor.SetReportTurn.
+// Call it before Start.
+func (c *Client) SetReportTurn(reportTurn common.ReportTurnFunc) {
+	if c.eventProcessor != nil {
+		c.eventProcessor.SetReportTurn(reportTurn)
+	}
+}
+
 // Start initializes and starts the Solana chain client
 func (c *Client) Start(ctx context.Context) error {
 	c.ctx, c.cancel = context.WithCancel(context.Background())
@@ -237,6 +258,9 @@ func (c *Client) initializeComponents() error {
 		if err != nil {
 			return fmt.Errorf("failed to create event listener: %w", err)
 		}
+		if c.chainConfig != nil && c.chainConfig.RawEventRetentionSeconds != nil && *c.chainConfig.RawEventRetentionSeconds > 0 {
+			eventListener.SetRawEventCapture(true)
+		}
 		c.eventListener = eventListener
 	}
 
@@ -281,12 +305,22 @@ func (c *Client) initializeComponents() error {
 		}
 		c.txBuilder = txBuilder
 
+		// Catch a gateway redeployed with different PDA seeds early; an RPC
+		// hiccup here shouldn't block startup, so this only warns.
+		selfTestCtx, selfTestCancel := context.WithTimeout(c.ctx, 15*time.Second)
+		if err := txBuilder.SelfTest(selfTestCtx); err != nil {
+			c.logger.Warn().Err(err).Msg("SVM gateway self-test failed; PDA seeds may not match the deployed gateway")
+		}
+		selfTestCancel()
+
 		c.rentReclaimer = NewRentReclaimer(
 			c.txBuilder,
 			config.rentReclaimSweepInterval,
 			config.rentReclaimMinPDAAge,
 			c.logger,
 		)
+
+		c.balanceMonitor = c.newBalanceMonitor(c.relayerBalance)
 	}
 
 	return nil
@@ -322,6 +356,10 @@ func (c *Client) startComponents() error {
 		c.rentReclaimer.Start(c.ctx)
 	}
 
+	if c.balanceMonitor != nil {
+		c.balanceMonitor.Start(c.ctx)
+	}
+
 	if c.eventCleaner != nil {
 		if err := c.eventCleaner.Start(c.ctx); err != nil {
 			return fmt.Errorf("failed to start event cleaner: %w", err)
@@ -331,12 +369,64 @@ func (c *Client) startComponents() error {
 	return nil
 }
 
+// newBalanceMonitor creates the relayer balance monitor from the chain config.
+func (c *Client) newBalanceMonitor(fetch common.BalanceFetcher) *common.BalanceMonitor {
+	var threshold *big.Int
+	if t := c.chainConfig.RelayerBalanceThreshold; t != nil {
+		parsed, err := common.ParseBalanceThreshold(*t)
+		if err != nil {
+			c.logger.Warn().Err(err).Msg("invalid relayer balance threshold, exporting the balance without warnings")
+		} else {
+			threshold = parsed
+		}
+	}
+	var interval time.Duration
+	if s := c.chainConfig.RelayerBalanceCheckIntervalSeconds; s != nil {
+		interval = time.Duration(*s) * time.Second
+	}
+	return common.NewBalanceMonitor(fetch, interval, threshold, c.logger)
+}
+
+// EndpointStats reports the state and recent latency of each RPC endpoint,
+// or nil before the RPC client is created.
+func (c *Client) EndpointStats() []common.EndpointStats {
+	if c.rpcClient == nil {
+		return nil
+	}
+	return c.rpcClient.EndpointStats()
+}
+
+// RelayerBalance returns the last fee-payer balance read by the balance
+// monitor, or false if none has been read yet.
+func (c *Client) RelayerBalance() (common.RelayerBalance, bool) {
+	if c.balanceMonitor == nil {
+		return common.RelayerBalance{}, false
+	}
+	return c.balanceMonitor.Balance()
+}
+
+// relayerBalance reads the relayer keypair's balance in lamports.
+func (c *Client) relayerBalance(ctx context.Context) (string, *big.Int, error) {
+	signer, err := c.txBuilder.loadRelayerSigner()
+	if err != nil {
+		return "", nil, err
+	}
+	lamports, err := c.rpcClient.GetBalance(ctx, signer.PublicKey())
+	if err != nil {
+		return "", nil, err
+	}
+	return signer.PublicKey().String(), new(big.Int).SetUint64(lamports), nil
+}
+
 // createRPCClient creates and initializes the RPC client
 func (c *Client) createRPCClient() error {
-	rpcURLs := c.chainConfig.RPCURLs
-	if len(rpcURLs) == 0 {
+	if len(c.chainConfig.RPCURLs) == 0 {
 		return fmt.Errorf("no RPC URLs configured")
 	}
+	rpcURLs := common.FilterEndpoints(c.chainConfig.RPCURLs, c.chainConfig.RPCAllowlist, c.chainConfig.RPCBlacklist)
+	if len(rpcURLs) == 0 {
+		return fmt.Errorf("no RPC URLs left after applying rpc_allowlist/rpc_blacklist")
+	}
 
 	// Create RPC client from URLs with genesis hash validation
 	rpcClient, err := NewRPCClient(rpcURLs, c.genesisHash, c.logger)
@@ -344,6 +434,23 @@ func (c *Client) createRPCClient() error {
 		return fmt.Errorf("failed to create RPC client: %w", err)
 	}
 
+	// Pace each endpoint to the operator's RPS budget (public RPCs throttle hard)
+	if rps := c.chainConfig.RPCRequestsPerSecond; rps != nil && *rps > 0 {
+		burst := 0
+		if c.chainConfig.RPCBurst != nil {
+			burst = *c.chainConfig.RPCBurst
+		}
+		rpcClient.SetRateLimit(*rps, burst)
+	}
+	if n := c.chainConfig.RPCRecoveryStreak; n != nil {
+		rpcClient.SetRecoveryStreak(*n)
+	}
+	if err := rpcClient.SetHealthCheck(c.chainConfig.RPCHealthCheck); err != nil {
+		rpcClient.Close()
+		return fmt.Errorf("invalid rpc_health_check: %w", err)
+	}
+	rpcClient.SetDryRun(c.dryRun)
+
 	c.rpcClient = rpcClient
 	c.logger.Info().Int("connected_count", len(rpcClient.clients)).Msg("RPC clients initialized successfully")
 	return nil
@@ -15,6 +15,7 @@ import (
 
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
 	"github.com/pushchain/push-chain-node/universalClient/db"
+	"github.com/pushchain/push-chain-node/universalClient/store"
 	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
 )
 
@@ -36,7 +37,7 @@ type EventListener struct {
 	// Core dependencies
 	rpcClient  rpcClientInterface
 	chainStore *common.ChainStore
-	database   *db.DB
+	database   db.Store
 
 	// Configuration
 	gatewayAddress           string
@@ -44,6 +45,8 @@ type EventListener struct {
 	discriminatorToEventType map[string]string
 	eventPollingSeconds      int
 	eventStartFrom           *int64
+	maxGapBackfill           uint64
+	captureRawEvents         bool // keep the raw program log behind each inbound
 
 	// State
 	logger  zerolog.Logger
@@ -58,7 +61,7 @@ func NewEventListener(
 	gatewayAddress string,
 	chainID string,
 	gatewayMethods []*uregistrytypes.GatewayMethods,
-	database *db.DB,
+	database db.Store,
 	eventPollingSeconds int,
 	eventStartFrom *int64,
 	logger zerolog.Logger,
@@ -96,6 +99,7 @@ func NewEventListener(
 		discriminatorToEventType: discriminatorToEventType,
 		eventPollingSeconds:      eventPollingSeconds,
 		eventStartFrom:           eventStartFrom,
+		maxGapBackfill:           common.DefaultMaxGapBackfill,
 		logger:                   logger.With().Str("component", "svm_event_listener").Str("chain", chainID).Logger(),
 		stopCh:                   make(chan struct{}),
 	}, nil
@@ -142,11 +146,19 @@ func (el *EventListener) listen(ctx context.Context) {
 	// Get polling interval from config
 	pollInterval := el.getPollingInterval()
 
-	// Get starting slot
+	// Get starting slot; a freshly enabled chain may need the tip, so retry
+	// until the RPC answers rather than giving up on the chain.
 	fromSlot, err := el.getStartSlot(ctx)
-	if err != nil {
-		el.logger.Error().Err(err).Msg("failed to get start slot")
-		return
+	for err != nil {
+		el.logger.Error().Err(err).Msg("failed to get start slot, retrying")
+		select {
+		case <-ctx.Done():
+			return
+		case <-el.stopCh:
+			return
+		case <-time.After(pollInterval):
+		}
+		fromSlot, err = el.getStartSlot(ctx)
 	}
 
 	el.logger.Debug().
@@ -186,6 +198,12 @@ func (el *EventListener) processNewSlots(
 		return fmt.Errorf("failed to get latest slot: %w", err)
 	}
 
+	// Close any gap behind the cursor before scanning new slots, so the
+	// checkpoint never advances past slots that were not scanned.
+	if closed, err := el.backfillGap(ctx, *currentSlot); err != nil || !closed {
+		return err
+	}
+
 	// Skip if no new slots
 	if *currentSlot >= latestSlot {
 		return nil
@@ -207,6 +225,35 @@ func (el *EventListener) processNewSlots(
 	return nil
 }
 
+// backfillGap rescans slots between the persisted checkpoint and the cursor
+// that were never checkpointed, at most maxGapBackfill per call. It reports
+// whether the gap is fully closed; if not, the caller retries on the next poll.
+func (el *EventListener) backfillGap(ctx context.Context, nextSlot uint64) (bool, error) {
+	checkpoint, err := el.chainStore.GetChainHeight()
+	if err != nil {
+		return false, fmt.Errorf("failed to get chain height: %w", err)
+	}
+	from, to, ok := common.DetectGap(checkpoint, nextSlot, el.maxGapBackfill)
+	if !ok {
+		return true, nil
+	}
+
+	el.logger.Warn().
+		Uint64("checkpoint", checkpoint).
+		Uint64("next_slot", nextSlot).
+		Uint64("backfill_from", from).
+		Uint64("backfill_to", to).
+		Msg("gap detected behind cursor, backfilling missed slots")
+
+	if err := el.processSlotRange(ctx, from, to); err != nil {
+		return false, fmt.Errorf("failed to backfill slots %d-%d: %w", from, to, err)
+	}
+	if err := el.updateLastProcessedSlot(to); err != nil {
+		el.logger.Error().Err(err).Uint64("slot", to).Msg("failed to update last processed slot")
+	}
+	return to+1 >= nextSlot, nil
+}
+
 // processSlotRange processes events in a range of slots
 func (el *EventListener) processSlotRange(
 	ctx context.Context,
@@ -322,6 +369,9 @@ func (el *EventListener) processSignatureBatch(
 							Uint64("slot", event.BlockHeight).
 							Str("confirmation_type", event.ConfirmationType).
 							Msg("stored new event")
+						if el.captureRawEvents && event.Type == store.EventTypeInbound {
+							el.storeRawEvent(event, log)
+						}
 					}
 				}
 			}
@@ -331,6 +381,26 @@ func (el *EventListener) processSignatureBatch(
 	return processed, nil
 }
 
+// SetRawEventCapture makes the listener store the raw program log behind each
+// new inbound event alongside it.
+func (el *EventListener) SetRawEventCapture(enabled bool) {
+	el.captureRawEvents = enabled
+}
+
+// storeRawEvent persists the program log line an inbound event was decoded
+// from. Failures are logged only; the decoded event is already stored.
+func (el *EventListener) storeRawEvent(event *store.Event, log string) {
+	if err := el.chainStore.InsertRawEvent(&store.RawEvent{
+		EventID:     event.EventID,
+		BlockHeight: event.BlockHeight,
+		Payload:     []byte(log),
+	}); err != nil {
+		el.logger.Warn().Err(err).
+			Str("event_id", event.EventID).
+			Msg("failed to store raw event")
+	}
+}
+
 // getStartSlot returns the slot to start watching from
 func (el *EventListener) getStartSlot(ctx context.Context) (uint64, error) {
 	// Get chain height from store
@@ -351,35 +421,45 @@ func (el *EventListener) getStartSlot(ctx context.Context) (uint64, error) {
 	return blockHeight, nil
 }
 
-// getStartSlotFromConfig determines start slot from configuration
+// getStartSlotFromConfig determines where a freshly enabled chain starts watching:
+// the enable slot recorded in the checkpoint store if there is one, otherwise
+// EventStartFrom (>= 0), or the latest slot when it is -1 or unset. The
+// resolved slot is recorded so a restart before any progress resumes from the
+// same point instead of re-reading the tip; a failed tip lookup is returned
+// rather than falling back to genesis.
 func (el *EventListener) getStartSlotFromConfig(ctx context.Context) (uint64, error) {
-	// Check config for EventStartFrom
-	if el.eventStartFrom != nil {
-		if *el.eventStartFrom >= 0 {
-			startSlot := uint64(*el.eventStartFrom)
-			el.logger.Info().
-				Uint64("slot", startSlot).
-				Msg("no previous state found, starting from configured EventStartFrom")
-			return startSlot, nil
-		}
+	enableSlot, ok, err := el.chainStore.GetEnableHeight()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get enable slot: %w", err)
+	}
+	if ok {
+		el.logger.Info().
+			Uint64("slot", enableSlot).
+			Msg("no slot processed yet, starting from recorded enable slot")
+		return enableSlot, nil
+	}
 
-		// -1 means start from latest slot
-		if *el.eventStartFrom == -1 {
-			latestSlot, err := el.rpcClient.GetLatestSlot(ctx)
-			if err != nil {
-				el.logger.Warn().Err(err).Msg("failed to get latest slot, starting from 0")
-				return 0, nil
-			}
-			el.logger.Info().
-				Uint64("slot", latestSlot).
-				Msg("no previous state found, starting from latest slot (EventStartFrom=-1)")
-			return latestSlot, nil
+	var startSlot uint64
+	if el.eventStartFrom != nil && *el.eventStartFrom >= 0 {
+		startSlot = uint64(*el.eventStartFrom)
+		el.logger.Info().
+			Uint64("slot", startSlot).
+			Msg("no previous state found, starting from configured EventStartFrom")
+	} else {
+		latestSlot, err := el.rpcClient.GetLatestSlot(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get latest slot: %w", err)
 		}
+		startSlot = latestSlot
+		el.logger.Info().
+			Uint64("slot", startSlot).
+			Msg("no previous state found, starting from latest slot")
 	}
 
-	// No config, get latest slot
-	el.logger.Info().Msg("no last processed slot found, starting from latest")
-	return el.rpcClient.GetLatestSlot(ctx)
+	if err := el.chainStore.SetEnableHeight(startSlot); err != nil {
+		return 0, fmt.Errorf("failed to record enable slot: %w", err)
+	}
+	return startSlot, nil
 }
 
 // updateLastProcessedSlot updates the last processed slot in the database
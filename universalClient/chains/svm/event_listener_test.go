@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -26,13 +27,14 @@ import (
 // tests focused on pagination/cursor behavior.
 type mockRPCClient struct {
 	latestSlot     uint64
+	latestSlotErr  error
 	signaturePages [][]*solanarpc.TransactionSignature
 	sigCallCursors []solana.Signature
 	txCalls        []solana.Signature
 }
 
 func (m *mockRPCClient) GetLatestSlot(ctx context.Context) (uint64, error) {
-	return m.latestSlot, nil
+	return m.latestSlot, m.latestSlotErr
 }
 
 func (m *mockRPCClient) GetSignaturesForAddress(ctx context.Context, address solana.PublicKey, before solana.Signature) ([]*solanarpc.TransactionSignature, error) {
@@ -676,3 +678,145 @@ func TestEventListener_StartWhileRunning(t *testing.T) {
 	cancel()
 	el.wg.Wait()
 }
+
+func TestEventListener_GetStartSlotCheckpoint(t *testing.T) {
+	logger := zerolog.Nop()
+	startFrom := int64(5000)
+
+	t.Run("first run uses the configured start slot", func(t *testing.T) {
+		database, err := db.OpenInMemoryDB(true)
+		require.NoError(t, err)
+		defer database.Close()
+
+		el, err := NewEventListener(nil, "GatewayAddr", "solana:test", nil, database, 5, &startFrom, logger)
+		require.NoError(t, err)
+
+		slot, err := el.getStartSlot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(5000), slot)
+	})
+
+	t.Run("restart resumes from the stored checkpoint over the config", func(t *testing.T) {
+		database, err := db.OpenInMemoryDB(true)
+		require.NoError(t, err)
+		defer database.Close()
+
+		el, err := NewEventListener(nil, "GatewayAddr", "solana:test", nil, database, 5, &startFrom, logger)
+		require.NoError(t, err)
+		require.NoError(t, el.updateLastProcessedSlot(9100))
+
+		restarted, err := NewEventListener(nil, "GatewayAddr", "solana:test", nil, database, 5, &startFrom, logger)
+		require.NoError(t, err)
+		slot, err := restarted.getStartSlot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(9100), slot)
+	})
+}
+
+func TestEventListener_FreshChainStartsFromEnableSlot(t *testing.T) {
+	logger := zerolog.Nop()
+
+	t.Run("defaults to the tip and records it", func(t *testing.T) {
+		database, err := db.OpenInMemoryDB(true)
+		require.NoError(t, err)
+		defer database.Close()
+
+		mock := &mockRPCClient{latestSlot: 8000}
+		el, err := NewEventListener(mock, "GatewayAddr", "solana:test", nil, database, 5, nil, logger)
+		require.NoError(t, err)
+		slot, err := el.getStartSlot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8000), slot)
+
+		// Restarted before any slot was processed: the tip has moved on but
+		// the recorded enable slot still wins, so nothing in between is skipped.
+		mock.latestSlot = 8500
+		restarted, err := NewEventListener(mock, "GatewayAddr", "solana:test", nil, database, 5, nil, logger)
+		require.NoError(t, err)
+		slot, err = restarted.getStartSlot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8000), slot)
+	})
+
+	t.Run("configured enable slot overrides the tip", func(t *testing.T) {
+		database, err := db.OpenInMemoryDB(true)
+		require.NoError(t, err)
+		defer database.Close()
+
+		startFrom := int64(7000)
+		el, err := NewEventListener(&mockRPCClient{latestSlot: 8000}, "GatewayAddr", "solana:test", nil, database, 5, &startFrom, logger)
+		require.NoError(t, err)
+		slot, err := el.getStartSlot(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7000), slot)
+
+		enableSlot, ok, err := el.chainStore.GetEnableHeight()
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, uint64(7000), enableSlot)
+	})
+
+	t.Run("tip lookup failure does not fall back to genesis", func(t *testing.T) {
+		database, err := db.OpenInMemoryDB(true)
+		require.NoError(t, err)
+		defer database.Close()
+
+		mock := &mockRPCClient{latestSlotErr: errors.New("rpc down")}
+		el, err := NewEventListener(mock, "GatewayAddr", "solana:test", nil, database, 5, nil, logger)
+		require.NoError(t, err)
+		_, err = el.getStartSlot(context.Background())
+		require.ErrorContains(t, err, "rpc down")
+
+		_, ok, err := el.chainStore.GetEnableHeight()
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestEventListener_ProcessNewSlotsBackfillsGap(t *testing.T) {
+	database, err := db.OpenInMemoryDB(true)
+	require.NoError(t, err)
+	defer database.Close()
+
+	// Checkpoint at 40 but the cursor already at 100: slots 41-99 were never scanned.
+	mock := &mockRPCClient{
+		latestSlot: 150,
+		signaturePages: [][]*solanarpc.TransactionSignature{
+			{mkSigInfo(90, 3), mkSigInfo(60, 4), mkSigInfo(30, 5)},   // backfill [41, 99]
+			{mkSigInfo(145, 1), mkSigInfo(120, 2), mkSigInfo(30, 5)}, // new range [100, 150]
+		},
+	}
+	el, err := NewEventListener(mock, solana.SystemProgramID.String(), "solana:test", nil, database, 5, nil, zerolog.Nop())
+	require.NoError(t, err)
+	require.NoError(t, el.updateLastProcessedSlot(40))
+
+	cursor := uint64(100)
+	require.NoError(t, el.processNewSlots(context.Background(), &cursor))
+
+	// The missed slots are rescanned before the new range.
+	assert.Equal(t, []solana.Signature{mkSig(3), mkSig(4), mkSig(1), mkSig(2)}, mock.txCalls)
+	assert.Equal(t, uint64(151), cursor)
+	height, err := el.chainStore.GetChainHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(150), height)
+}
+
+func TestEventListener_ProcessNewSlotsBoundedBackfill(t *testing.T) {
+	database, err := db.OpenInMemoryDB(true)
+	require.NoError(t, err)
+	defer database.Close()
+
+	mock := &mockRPCClient{latestSlot: 500}
+	el, err := NewEventListener(mock, solana.SystemProgramID.String(), "solana:test", nil, database, 5, nil, zerolog.Nop())
+	require.NoError(t, err)
+	el.maxGapBackfill = 100
+	require.NoError(t, el.updateLastProcessedSlot(40))
+
+	// Gap 41-399 exceeds the cap: one slice per poll, no new range until closed.
+	cursor := uint64(400)
+	require.NoError(t, el.processNewSlots(context.Background(), &cursor))
+	assert.Equal(t, uint64(400), cursor)
+	height, err := el.chainStore.GetChainHeight()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(140), height)
+}
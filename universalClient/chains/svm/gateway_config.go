@@ -0,0 +1,165 @@
+package svm
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// gatewayConfigDiscriminator is the Anchor account discriminator of the
+// gateway's Config account: sha256("account:Config")[:8].
+var gatewayConfigDiscriminator = func() []byte {
+	h := sha256.Sum256([]byte("account:Config"))
+	return h[:8]
+}()
+
+// gatewayConfigLayout lists the fields of the gateway's Config account in
+// Borsh order with their Anchor IDL types. TestGatewayConfigLayoutMatchesIDL
+// checks it against the account in testdata/gateway_config_idl.json; update
+// both from the gateway IDL when the account changes.
+var gatewayConfigLayout = []idlField{
+	{Name: "admin", Type: "pubkey"},
+	{Name: "tss_address", Type: "pubkey"},
+	{Name: "pauser", Type: "pubkey"},
+	{Name: "min_cap_universal_tx_usd", Type: "u128"},
+	{Name: "max_cap_universal_tx_usd", Type: "u128"},
+	{Name: "paused", Type: "bool"},
+	{Name: "bump", Type: "u8"},
+	{Name: "vault_bump", Type: "u8"},
+	{Name: "pyth_price_feed", Type: "pubkey"},
+	{Name: "pyth_confidence_threshold", Type: "u64"},
+}
+
+// idlField is a fixed-size field of an Anchor account, as named and typed in
+// the program IDL.
+type idlField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// borshSizes are the encoded sizes of the fixed-size IDL types.
+var borshSizes = map[string]int{
+	"bool":   1,
+	"u8":     1,
+	"u64":    8,
+	"u128":   16,
+	"pubkey": 32,
+}
+
+// borshOffsets returns the offset of each field of layout after the 8-byte
+// Anchor discriminator, and the encoded size of the whole account.
+func borshOffsets(layout []idlField) (map[string]int, int) {
+	offsets := make(map[string]int, len(layout))
+	off := 8
+	for _, f := range layout {
+		size, ok := borshSizes[f.Type]
+		if !ok {
+			panic("svm: no Borsh size for IDL type " + f.Type)
+		}
+		offsets[f.Name] = off
+		off += size
+	}
+	return offsets, off
+}
+
+// gatewayConfigOffsets and gatewayConfigLen (discriminator included) are
+// derived from gatewayConfigLayout.
+var gatewayConfigOffsets, gatewayConfigLen = borshOffsets(gatewayConfigLayout)
+
+// GatewayConfig is the gateway's singleton Config account (PDA ["config"]).
+type GatewayConfig struct {
+	Address                 solana.PublicKey `json:"address"` // the config PDA
+	Admin                   solana.PublicKey `json:"admin"`
+	TSSAddress              solana.PublicKey `json:"tss_address"`
+	Pauser                  solana.PublicKey `json:"pauser"`
+	MinCapUniversalTxUSD    *big.Int         `json:"min_cap_universal_tx_usd"` // 1e18-scaled USD
+	MaxCapUniversalTxUSD    *big.Int         `json:"max_cap_universal_tx_usd"` // 1e18-scaled USD
+	Paused                  bool             `json:"paused"`
+	Bump                    uint8            `json:"bump"`
+	VaultBump               uint8            `json:"vault_bump"`
+	PythPriceFeed           solana.PublicKey `json:"pyth_price_feed"`
+	PythConfidenceThreshold uint64           `json:"pyth_confidence_threshold"`
+}
+
+// FetchGatewayConfig reads and decodes the Config account of the gateway
+// program.
+func FetchGatewayConfig(ctx context.Context, rpcClient *RPCClient, gateway solana.PublicKey) (*GatewayConfig, error) {
+	configPDA, _, err := solana.FindProgramAddress([][]byte{configSeed}, gateway)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive config PDA: %w", err)
+	}
+	data, err := rpcClient.GetAccountData(ctx, configPDA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch config PDA %s: %w", configPDA, err)
+	}
+	cfg, err := decodeGatewayConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("config PDA %s: %w", configPDA, err)
+	}
+	cfg.Address = configPDA
+	return cfg, nil
+}
+
+// GatewayConfig reads and decodes the Config account of this builder's gateway.
+func (tb *TxBuilder) GatewayConfig(ctx context.Context) (*GatewayConfig, error) {
+	return FetchGatewayConfig(ctx, tb.rpcClient, tb.gatewayAddress)
+}
+
+// IsGatewayPaused reports whether the gateway's Config PDA has paused set.
+func (tb *TxBuilder) IsGatewayPaused(ctx context.Context) (bool, error) {
+	cfg, err := tb.GatewayConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+	return cfg.Paused, nil
+}
+
+// decodeGatewayConfig decodes a Config account: the Anchor discriminator
+// sha256("account:Config")[:8], then the Borsh-encoded fields of
+// gatewayConfigLayout. Trailing bytes (account space reserved for later
+// fields) are ignored.
+func decodeGatewayConfig(data []byte) (*GatewayConfig, error) {
+	if len(data) < gatewayConfigLen {
+		return nil, fmt.Errorf("invalid config account data: too short (%d bytes, want %d)", len(data), gatewayConfigLen)
+	}
+	if !bytes.Equal(data[:8], gatewayConfigDiscriminator) {
+		return nil, fmt.Errorf("invalid config account data: discriminator %x is not Config", data[:8])
+	}
+	field := func(name string, size int) []byte {
+		off := gatewayConfigOffsets[name]
+		return data[off : off+size]
+	}
+
+	var cfg GatewayConfig
+	copy(cfg.Admin[:], field("admin", 32))
+	copy(cfg.TSSAddress[:], field("tss_address", 32))
+	copy(cfg.Pauser[:], field("pauser", 32))
+	cfg.MinCapUniversalTxUSD = decodeU128(field("min_cap_universal_tx_usd", 16))
+	cfg.MaxCapUniversalTxUSD = decodeU128(field("max_cap_universal_tx_usd", 16))
+	switch paused := field("paused", 1)[0]; paused {
+	case 0:
+	case 1:
+		cfg.Paused = true
+	default:
+		return nil, fmt.Errorf("invalid config account data: paused flag %d", paused)
+	}
+	cfg.Bump = field("bump", 1)[0]
+	cfg.VaultBump = field("vault_bump", 1)[0]
+	copy(cfg.PythPriceFeed[:], field("pyth_price_feed", 32))
+	cfg.PythConfidenceThreshold = binary.LittleEndian.Uint64(field("pyth_confidence_threshold", 8))
+	return &cfg, nil
+}
+
+// decodeU128 decodes a little-endian Borsh u128.
+func decodeU128(le []byte) *big.Int {
+	be := make([]byte, len(le))
+	for i, b := range le {
+		be[len(le)-1-i] = b
+	}
+	return new(big.Int).SetBytes(be)
+}
@@ -0,0 +1,110 @@
+package svm
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeGatewayConfig(t *testing.T) {
+	admin := solana.NewWallet().PublicKey()
+	tss := solana.NewWallet().PublicKey()
+	pauser := solana.NewWallet().PublicKey()
+	feed := solana.NewWallet().PublicKey()
+	minCap, _ := new(big.Int).SetString("1000000000000000000", 10)     // $1
+	maxCap, _ := new(big.Int).SetString("10000000000000000000000", 10) // $10,000
+	u128 := func(v *big.Int) []byte {
+		le := make([]byte, 16)
+		be := v.FillBytes(make([]byte, 16))
+		for i, b := range be {
+			le[15-i] = b
+		}
+		return le
+	}
+
+	// Mock config PDA data laid out as the on-chain Config account.
+	data := append([]byte{}, gatewayConfigDiscriminator...)
+	data = append(data, admin[:]...)
+	data = append(data, tss[:]...)
+	data = append(data, pauser[:]...)
+	data = append(data, u128(minCap)...)
+	data = append(data, u128(maxCap)...)
+	data = append(data, 1, 254, 253) // paused, bump, vault_bump
+	data = append(data, feed[:]...)
+	data = binary.LittleEndian.AppendUint64(data, 100)
+	data = append(data, make([]byte, 64)...) // reserved space
+	require.Len(t, data, gatewayConfigLen+64)
+
+	cfg, err := decodeGatewayConfig(data)
+	require.NoError(t, err)
+	assert.Equal(t, &GatewayConfig{
+		Admin:                   admin,
+		TSSAddress:              tss,
+		Pauser:                  pauser,
+		MinCapUniversalTxUSD:    minCap,
+		MaxCapUniversalTxUSD:    maxCap,
+		Paused:                  true,
+		Bump:                    254,
+		VaultBump:               253,
+		PythPriceFeed:           feed,
+		PythConfidenceThreshold: 100,
+	}, cfg)
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := decodeGatewayConfig(data[:gatewayConfigLen-1])
+		assert.ErrorContains(t, err, "too short")
+	})
+
+	t.Run("other account type", func(t *testing.T) {
+		other := append([]byte{}, data...)
+		copy(other, anchorDiscriminator("tss_pda"))
+		_, err := decodeGatewayConfig(other)
+		assert.ErrorContains(t, err, "discriminator")
+	})
+
+	t.Run("invalid paused flag", func(t *testing.T) {
+		bad := append([]byte{}, data...)
+		bad[gatewayConfigOffsets["paused"]] = 2
+		_, err := decodeGatewayConfig(bad)
+		assert.ErrorContains(t, err, "paused flag")
+	})
+}
+
+func TestGatewayConfigLayoutMatchesIDL(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "gateway_config_idl.json"))
+	require.NoError(t, err)
+	var idl struct {
+		Accounts []struct {
+			Name          string `json:"name"`
+			Discriminator []byte `json:"discriminator"`
+		} `json:"accounts"`
+		Types []struct {
+			Name string `json:"name"`
+			Type struct {
+				Kind   string     `json:"kind"`
+				Fields []idlField `json:"fields"`
+			} `json:"type"`
+		} `json:"types"`
+	}
+	require.NoError(t, json.Unmarshal(raw, &idl))
+
+	require.Len(t, idl.Accounts, 1)
+	assert.Equal(t, "Config", idl.Accounts[0].Name)
+	assert.Equal(t, gatewayConfigDiscriminator, idl.Accounts[0].Discriminator)
+
+	require.Len(t, idl.Types, 1)
+	assert.Equal(t, "struct", idl.Types[0].Type.Kind)
+	assert.Equal(t, gatewayConfigLayout, idl.Types[0].Type.Fields)
+
+	// The layout the decoder reads, pinned so an IDL change shows up here.
+	assert.Equal(t, 179, gatewayConfigLen)
+	assert.Equal(t, 136, gatewayConfigOffsets["paused"])
+	assert.Equal(t, 171, gatewayConfigOffsets["pyth_confidence_threshold"])
+}
@@ -0,0 +1,148 @@
+package svm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gagliardetto/solana-go"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	uetypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite testdata golden files from the current encoder output")
+
+// goldenVectorsFile pins the TSS message hash and the gateway instruction data
+// for canonical outbound events. Regenerate with
+//
+//	go test ./universalClient/chains/svm -run TestOutboundGoldenVectors -update
+//
+// only when an encoding change is intended, and review the resulting diff.
+const goldenVectorsFile = "outbound_vectors.json"
+
+const (
+	// goldenTSSKey signs every vector, so the signature embedded in the
+	// instruction data is deterministic (RFC 6979).
+	goldenTSSKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	// goldenChainID is the chain ID stored in the mocked TSS PDA.
+	goldenChainID = "EtWTRABZaYq6iMfeYKouRu166VU2xqa1"
+)
+
+// outboundVector is one golden case: the event as emitted by uexecutor and the
+// expected encoder output (hex, 0x-prefixed).
+type outboundVector struct {
+	Name            string                       `json:"name"`
+	Event           uetypes.OutboundCreatedEvent `json:"event"`
+	SigningHash     string                       `json:"signing_hash"`
+	InstructionData string                       `json:"instruction_data"`
+}
+
+func TestOutboundGoldenVectors(t *testing.T) {
+	path := filepath.Join("testdata", goldenVectorsFile)
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var vectors []outboundVector
+	require.NoError(t, json.Unmarshal(raw, &vectors))
+	require.NotEmpty(t, vectors)
+
+	key, err := crypto.HexToECDSA(goldenTSSKey)
+	require.NoError(t, err)
+	var tssAddr [20]byte
+	copy(tssAddr[:], crypto.PubkeyToAddress(key.PublicKey).Bytes())
+	builder := newGoldenTxBuilder(t, buildMockTSSPDAData(tssAddr, goldenChainID, 255))
+
+	for i := range vectors {
+		v := &vectors[i]
+		t.Run(v.Name, func(t *testing.T) {
+			ctx := context.Background()
+			req, err := builder.GetOutboundSigningRequest(ctx, &v.Event, 0)
+			require.NoError(t, err)
+			sig, err := crypto.Sign(req.SigningHash, key)
+			require.NoError(t, err)
+			tx, _, err := builder.BuildOutboundTransaction(ctx, req, &v.Event, sig)
+			require.NoError(t, err)
+
+			// The gateway instruction is always last.
+			instructions := tx.Message.Instructions
+			require.NotEmpty(t, instructions)
+			gatewayIx := instructions[len(instructions)-1]
+			programID, err := tx.Message.Program(gatewayIx.ProgramIDIndex)
+			require.NoError(t, err)
+			require.Equal(t, builder.gatewayAddress, programID)
+
+			signingHash := "0x" + hex.EncodeToString(req.SigningHash)
+			ixData := "0x" + hex.EncodeToString(gatewayIx.Data)
+			if *updateGolden {
+				v.SigningHash, v.InstructionData = signingHash, ixData
+				return
+			}
+			requireGoldenHex(t, "signing_hash", v.SigningHash, signingHash)
+			requireGoldenHex(t, "instruction_data", v.InstructionData, ixData)
+		})
+	}
+
+	if *updateGolden {
+		out, err := json.MarshalIndent(vectors, "", "  ")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, append(out, '\n'), 0o644))
+	}
+}
+
+// newGoldenTxBuilder returns a builder with a relayer keypair whose RPC serves
+// tssPDAData for every account and a fixed blockhash.
+func newGoldenTxBuilder(t *testing.T, tssPDAData []byte) *TxBuilder {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		reply := `null`
+		switch req.Method {
+		case "getHealth":
+			reply = `"ok"`
+		case "getAccountInfo":
+			reply = `{"context":{"slot":1},"value":{"data":["` + base64.StdEncoding.EncodeToString(tssPDAData) +
+				`","base64"],"executable":false,"lamports":1000000,"owner":"` + testGatewayAddress + `","rentEpoch":0,"space":0}}`
+		case "getLatestBlockhash":
+			reply = `{"context":{"slot":1},"value":{"blockhash":"` + solana.Hash{1}.String() + `","lastValidBlockHeight":100}}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + reply + `}`))
+	}))
+	t.Cleanup(server.Close)
+	rc, err := NewRPCClient([]string{server.URL}, "", zerolog.Nop())
+	require.NoError(t, err)
+
+	builder := newTestBuilderWithKeypair(t)
+	builder.rpcClient = rc
+	return builder
+}
+
+// requireGoldenHex fails with the first differing byte so an encoding
+// regression points at the field that moved.
+func requireGoldenHex(t *testing.T, field, want, got string) {
+	t.Helper()
+	if want == got {
+		return
+	}
+	wantBytes, _ := hex.DecodeString(removeHexPrefix(want))
+	gotBytes, _ := hex.DecodeString(removeHexPrefix(got))
+	offset := 0
+	for offset < len(wantBytes) && offset < len(gotBytes) && wantBytes[offset] == gotBytes[offset] {
+		offset++
+	}
+	t.Fatalf("%s differs from golden vector at byte %d (want %d bytes, got %d)\nwant: %s\ngot:  %s\nrerun with -update only if the encoding change is intended",
+		field, offset, len(wantBytes), len(gotBytes), want, got)
+}
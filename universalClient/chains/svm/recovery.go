@@ -0,0 +1,119 @@
+package svm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+)
+
+// recoveryIDMismatches counts TSS signatures that recovered to none of the
+// four candidate addresses. Any non-zero value means the signing key does not
+// match the TSS address stored on-chain (wrong key, reshare not yet synced).
+var recoveryIDMismatches atomic.Uint64
+
+// RecoveryIDMismatches returns how many signatures failed recovery-ID
+// determination since process start.
+func RecoveryIDMismatches() uint64 {
+	return recoveryIDMismatches.Load()
+}
+
+// RecoveryIDMismatchesCollector returns a counter for the metrics endpoint
+// that reads RecoveryIDMismatches on every scrape.
+func RecoveryIDMismatchesCollector() prometheus.Collector {
+	return prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "puniversal",
+		Subsystem: "svm",
+		Name:      "recovery_id_mismatches_total",
+		Help:      "TSS signatures that recovered to none of the candidate addresses; non-zero means the signing key does not match the on-chain TSS address.",
+	}, func() float64 { return float64(RecoveryIDMismatches()) })
+}
+
+// RecoveryIDError is returned when no recovery ID maps a signature back to the
+// expected TSS address. Recovered holds the outcome of each attempt v=0..3:
+// the recovered address, or the recovery error.
+type RecoveryIDError struct {
+	Expected  ethcommon.Address
+	Recovered [4]string
+}
+
+func (e *RecoveryIDError) Error() string {
+	attempts := make([]string, len(e.Recovered))
+	for v, addr := range e.Recovered {
+		attempts[v] = fmt.Sprintf("v=%d→%s", v, addr)
+	}
+	return fmt.Sprintf("signature does not recover to TSS address %s (%s)", e.Expected.Hex(), strings.Join(attempts, ", "))
+}
+
+// determineRecoveryID finds the recovery ID under which the 64-byte r||s
+// signature over hash recovers to expected. hint (the signer's v byte) is
+// tried first; the remaining IDs are tried in order.
+func determineRecoveryID(hash, signature []byte, expected ethcommon.Address, hint byte) (byte, error) {
+	if len(signature) != 64 {
+		return 0, fmt.Errorf("signature must be 64 bytes (r||s), got %d", len(signature))
+	}
+	v, recovered, ok := common.FindRecoveryID(hash, signature, expected, hint)
+	if !ok {
+		return 0, &RecoveryIDError{Expected: expected, Recovered: recovered}
+	}
+	return v, nil
+}
+
+// fetchTSSEthAddress reads tss_eth_address from the TSS PDA (see
+// fetchTSSChainID for the account layout).
+func (tb *TxBuilder) fetchTSSEthAddress(ctx context.Context, tssPDA solana.PublicKey) (ethcommon.Address, error) {
+	accountData, err := tb.rpcClient.GetAccountData(ctx, tssPDA)
+	if err != nil {
+		return ethcommon.Address{}, fmt.Errorf("failed to fetch TSS PDA account: %w", err)
+	}
+	if len(accountData) < 28 {
+		return ethcommon.Address{}, fmt.Errorf("invalid TSS PDA account data: too short (%d bytes)", len(accountData))
+	}
+	return ethcommon.BytesToAddress(accountData[8:28]), nil
+}
+
+// resolveRecoveryID determines the recovery ID for signature against the TSS
+// address on-chain. A recovery ID already cached on req is used as-is;
+// otherwise the determined one is cached there for the caller to persist.
+func (tb *TxBuilder) resolveRecoveryID(ctx context.Context, tssPDA solana.PublicKey, req *common.UnsignedSigningReq, signature []byte, hint byte) (byte, error) {
+	if req.RecoveryID != nil {
+		return *req.RecoveryID, nil
+	}
+	expected, err := tb.fetchTSSEthAddress(ctx, tssPDA)
+	if err != nil {
+		return 0, err
+	}
+	v, err := tb.recoveryIDFor(req.SigningHash, signature, expected, hint)
+	if err != nil {
+		return 0, err
+	}
+	req.RecoveryID = &v
+	return v, nil
+}
+
+// recoveryIDFor wraps determineRecoveryID, counting and logging a mismatch with
+// every recovered address, since it almost always means the node signed with
+// the wrong key.
+func (tb *TxBuilder) recoveryIDFor(signingHash, signature []byte, expected ethcommon.Address, hint byte) (byte, error) {
+	v, err := determineRecoveryID(signingHash, signature, expected, hint)
+	if err != nil {
+		var mismatch *RecoveryIDError
+		if errors.As(err, &mismatch) {
+			recoveryIDMismatches.Add(1)
+			tb.logger.Error().
+				Str("expected_tss_address", mismatch.Expected.Hex()).
+				Strs("recovered_addresses", mismatch.Recovered[:]).
+				Str("signing_hash", fmt.Sprintf("%x", signingHash)).
+				Msg("TSS signature does not match on-chain TSS address; possible key or reshare mismatch")
+		}
+		return 0, fmt.Errorf("failed to determine recovery ID: %w", err)
+	}
+	return v, nil
+}
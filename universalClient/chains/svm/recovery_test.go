@@ -0,0 +1,102 @@
+package svm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+)
+
+func TestDetermineRecoveryID(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	tssAddr := crypto.PubkeyToAddress(key.PublicKey)
+	hash := crypto.Keccak256([]byte("outbound"))
+	sig, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	v := sig[64]
+
+	t.Run("hint matches", func(t *testing.T) {
+		got, err := determineRecoveryID(hash, sig[:64], tssAddr, v)
+		require.NoError(t, err)
+		assert.Equal(t, v, got)
+	})
+
+	t.Run("wrong hint falls back to search", func(t *testing.T) {
+		got, err := determineRecoveryID(hash, sig[:64], tssAddr, v^1)
+		require.NoError(t, err)
+		assert.Equal(t, v, got)
+	})
+
+	t.Run("rejects non r||s signatures", func(t *testing.T) {
+		_, err := determineRecoveryID(hash, sig, tssAddr, v)
+		require.Error(t, err)
+	})
+}
+
+func TestRecoveryIDFor_MismatchDiagnostic(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	expected := crypto.PubkeyToAddress(other.PublicKey)
+	hash := crypto.Keccak256([]byte("outbound"))
+	sig, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+
+	var logs bytes.Buffer
+	tb := newTestBuilder(t)
+	tb.logger = zerolog.New(&logs)
+	before := RecoveryIDMismatches()
+
+	_, err = tb.recoveryIDFor(hash, sig[:64], expected, sig[64])
+	require.Error(t, err)
+
+	var mismatch *RecoveryIDError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, expected, mismatch.Expected)
+	// The signer's own address is among the four attempts.
+	assert.Contains(t, mismatch.Recovered[:], crypto.PubkeyToAddress(key.PublicKey).Hex())
+	for v, attempt := range mismatch.Recovered {
+		assert.NotEmpty(t, attempt, "attempt v=%d missing", v)
+		assert.Contains(t, err.Error(), attempt)
+	}
+
+	assert.Equal(t, before+1, RecoveryIDMismatches())
+
+	var line struct {
+		Level     string   `json:"level"`
+		Expected  string   `json:"expected_tss_address"`
+		Recovered []string `json:"recovered_addresses"`
+	}
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &line))
+	assert.Equal(t, "error", line.Level)
+	assert.Equal(t, expected.Hex(), line.Expected)
+	assert.Equal(t, mismatch.Recovered[:], line.Recovered)
+}
+
+func TestResolveRecoveryID_UsesCachedValue(t *testing.T) {
+	// The zero RPCClient has no endpoints, so any on-chain lookup would fail.
+	tb := newTestBuilder(t)
+	tssPDA, err := tb.deriveTSSPDA()
+	require.NoError(t, err)
+
+	cached := uint8(1)
+	req := &common.UnsignedSigningReq{SigningHash: make([]byte, 32), RecoveryID: &cached}
+	v, err := tb.resolveRecoveryID(context.Background(), tssPDA, req, make([]byte, 64), 0)
+	require.NoError(t, err)
+	assert.Equal(t, cached, v)
+
+	req.RecoveryID = nil
+	_, err = tb.resolveRecoveryID(context.Background(), tssPDA, req, make([]byte, 64), 0)
+	require.Error(t, err, "without a cached value the TSS address must be fetched")
+	assert.Nil(t, req.RecoveryID)
+}
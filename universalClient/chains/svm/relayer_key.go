@@ -0,0 +1,173 @@
+package svm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/99designs/keyring"
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/pushchain/push-chain-node/universalClient/config"
+)
+
+// Relayer key backends accepted in relayer_key_backend.
+const (
+	RelayerKeyBackendFile    = "file"
+	RelayerKeyBackendKeyring = "keyring"
+)
+
+// RelayerKeyringService is the OS keyring service relayer keys are stored under.
+const RelayerKeyringService = "puniversald-relayer"
+
+// RelayerSigner signs Solana transaction messages as the relayer (fee payer).
+// Implementations backed by an HSM or KMS can sign without ever handing the
+// private key to this process.
+type RelayerSigner interface {
+	PublicKey() solana.PublicKey
+	Sign(message []byte) (solana.Signature, error)
+}
+
+// RelayerKeyLoader resolves the relayer signer for a chain namespace
+// (e.g. "solana").
+type RelayerKeyLoader interface {
+	LoadRelayerSigner(namespace string) (RelayerSigner, error)
+}
+
+// keypairSigner signs with an in-memory Ed25519 keypair.
+type keypairSigner struct {
+	key solana.PrivateKey
+}
+
+// NewKeypairSigner wraps a 64-byte Solana keypair as a RelayerSigner.
+func NewKeypairSigner(key solana.PrivateKey) RelayerSigner {
+	return keypairSigner{key: key}
+}
+
+func (s keypairSigner) PublicKey() solana.PublicKey { return s.key.PublicKey() }
+
+func (s keypairSigner) Sign(message []byte) (solana.Signature, error) {
+	return s.key.Sign(message)
+}
+
+// FileRelayerKeyLoader reads <NodeHome>/relayer/<namespace>.json. It is the
+// default loader.
+type FileRelayerKeyLoader struct {
+	NodeHome string
+}
+
+func (l FileRelayerKeyLoader) LoadRelayerSigner(namespace string) (RelayerSigner, error) {
+	keyPath := filepath.Join(l.NodeHome, config.RelayerSubdir, namespace+".json")
+
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read relayer key file %s: %w", keyPath, err)
+	}
+	key, err := parseRelayerKeypair(keyData)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeypairSigner(key), nil
+}
+
+// KeyringRelayerKeyLoader reads the item relayer/<namespace> from a keyring.
+// The item holds the same JSON array as the relayer key file, so an existing
+// file can be imported verbatim.
+type KeyringRelayerKeyLoader struct {
+	Keyring keyring.Keyring
+}
+
+// NewKeyringRelayerKeyLoader opens the OS keyring (macOS Keychain, Secret
+// Service, KWallet, Windows Credential Manager or pass). The encrypted-file
+// backend is not offered: it would need a password in the config, which is
+// no better than the plaintext key file.
+func NewKeyringRelayerKeyLoader() (*KeyringRelayerKeyLoader, error) {
+	kr, err := keyring.Open(keyring.Config{
+		ServiceName: RelayerKeyringService,
+		AllowedBackends: []keyring.BackendType{
+			keyring.KeychainBackend,
+			keyring.SecretServiceBackend,
+			keyring.KWalletBackend,
+			keyring.WinCredBackend,
+			keyring.PassBackend,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open relayer keyring: %w", err)
+	}
+	return &KeyringRelayerKeyLoader{Keyring: kr}, nil
+}
+
+// RelayerKeyringItem is the keyring item name holding a namespace's relayer key.
+func RelayerKeyringItem(namespace string) string {
+	return config.RelayerSubdir + "/" + namespace
+}
+
+func (l *KeyringRelayerKeyLoader) LoadRelayerSigner(namespace string) (RelayerSigner, error) {
+	item, err := l.Keyring.Get(RelayerKeyringItem(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read relayer key %s from keyring: %w", RelayerKeyringItem(namespace), err)
+	}
+	key, err := parseRelayerKeypair(item.Data)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeypairSigner(key), nil
+}
+
+// newRelayerKeyLoader returns the loader for a relayer_key_backend value.
+func newRelayerKeyLoader(backend, nodeHome string) (RelayerKeyLoader, error) {
+	switch backend {
+	case "", RelayerKeyBackendFile:
+		return FileRelayerKeyLoader{NodeHome: nodeHome}, nil
+	case RelayerKeyBackendKeyring:
+		return NewKeyringRelayerKeyLoader()
+	default:
+		return nil, fmt.Errorf("relayer key backend must be %q or %q, got: %s", RelayerKeyBackendFile, RelayerKeyBackendKeyring, backend)
+	}
+}
+
+// parseRelayerKeypair decodes Solana's standard keypair format: a JSON array
+// of 64 bytes, the Ed25519 private key seed followed by the public key.
+func parseRelayerKeypair(keyData []byte) (solana.PrivateKey, error) {
+	var keyBytes []byte
+	if err := json.Unmarshal(keyData, &keyBytes); err != nil {
+		return nil, fmt.Errorf("failed to parse key file as JSON array: %w", err)
+	}
+
+	if len(keyBytes) != 64 {
+		return nil, fmt.Errorf("invalid key length: expected 64 bytes, got %d", len(keyBytes))
+	}
+
+	return solana.PrivateKey(keyBytes), nil
+}
+
+// signAsRelayer adds the relayer's signature to tx. Like tx.Sign it fails if
+// the message needs any other signer, but the key itself stays behind signer.
+func signAsRelayer(tx *solana.Transaction, signer RelayerSigner) error {
+	n := int(tx.Message.Header.NumRequiredSignatures)
+	if n > len(tx.Message.AccountKeys) {
+		return fmt.Errorf("message requires %d signers but has %d accounts", n, len(tx.Message.AccountKeys))
+	}
+	relayer := signer.PublicKey()
+	for _, key := range tx.Message.AccountKeys[:n] {
+		if !key.Equals(relayer) {
+			return fmt.Errorf("transaction requires signer %s other than the relayer", key.String())
+		}
+	}
+
+	message, err := tx.Message.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("unable to encode message for signing: %w", err)
+	}
+	sig, err := signer.Sign(message)
+	if err != nil {
+		return fmt.Errorf("failed to sign with key %q: %w", relayer.String(), err)
+	}
+	tx.Signatures = make([]solana.Signature, n)
+	for i := range tx.Signatures {
+		tx.Signatures[i] = sig
+	}
+	return nil
+}
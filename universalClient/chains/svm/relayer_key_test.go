@@ -0,0 +1,74 @@
+package svm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/99designs/keyring"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayerKeyLoaders(t *testing.T) {
+	expected, err := parseRelayerKeypair([]byte(testSolanaKeypairJSON))
+	require.NoError(t, err)
+
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "relayer"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "relayer", "solana.json"), []byte(testSolanaKeypairJSON), 0o600))
+
+	loaders := map[string]RelayerKeyLoader{
+		"file": FileRelayerKeyLoader{NodeHome: home},
+		"keyring": &KeyringRelayerKeyLoader{Keyring: keyring.NewArrayKeyring([]keyring.Item{
+			{Key: RelayerKeyringItem("solana"), Data: []byte(testSolanaKeypairJSON)},
+		})},
+	}
+
+	for name, loader := range loaders {
+		t.Run(name, func(t *testing.T) {
+			signer, err := loader.LoadRelayerSigner("solana")
+			require.NoError(t, err)
+			assert.Equal(t, expected.PublicKey(), signer.PublicKey())
+
+			// A transfer paid by the relayer carries a valid relayer signature.
+			tx, err := solana.NewTransaction(
+				[]solana.Instruction{system.NewTransferInstruction(1, signer.PublicKey(), solana.SystemProgramID).Build()},
+				solana.Hash{},
+				solana.TransactionPayer(signer.PublicKey()),
+			)
+			require.NoError(t, err)
+			require.NoError(t, signAsRelayer(tx, signer))
+			require.Len(t, tx.Signatures, 1)
+			assert.NoError(t, tx.VerifySignatures())
+
+			_, err = loader.LoadRelayerSigner("eip155")
+			assert.Error(t, err, "missing namespace key")
+		})
+	}
+}
+
+func TestSignAsRelayer_RejectsForeignSigner(t *testing.T) {
+	relayer, err := parseRelayerKeypair([]byte(testSolanaKeypairJSON))
+	require.NoError(t, err)
+	other := solana.NewWallet().PublicKey()
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{system.NewTransferInstruction(1, other, relayer.PublicKey()).Build()},
+		solana.Hash{},
+		solana.TransactionPayer(relayer.PublicKey()),
+	)
+	require.NoError(t, err)
+	assert.Error(t, signAsRelayer(tx, NewKeypairSigner(relayer)))
+}
+
+func TestNewRelayerKeyLoader(t *testing.T) {
+	_, err := newRelayerKeyLoader("kms", t.TempDir())
+	assert.Error(t, err)
+
+	loader, err := newRelayerKeyLoader("", "/home")
+	require.NoError(t, err)
+	assert.Equal(t, FileRelayerKeyLoader{NodeHome: "/home"}, loader)
+}
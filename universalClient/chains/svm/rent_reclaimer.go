@@ -78,7 +78,7 @@ func (r *RentReclaimer) run(ctx context.Context) {
 }
 
 func (r *RentReclaimer) runOnce(ctx context.Context) {
-	relayer, err := r.builder.loadRelayerKeypair()
+	relayer, err := r.builder.loadRelayerSigner()
 	if err != nil {
 		r.logger.Warn().Err(err).Msg("failed to load relayer keypair; skipping sweep")
 		return
@@ -208,7 +208,7 @@ func (r *RentReclaimer) isOldEnough(ctx context.Context, addr solana.PublicKey)
 }
 
 // closeOrphan builds and broadcasts an arg-free close_stored_ix_data tx.
-func (r *RentReclaimer) closeOrphan(ctx context.Context, o orphanPDA, relayer solana.PrivateKey) error {
+func (r *RentReclaimer) closeOrphan(ctx context.Context, o orphanPDA, relayer RelayerSigner) error {
 	executedSubTxPDA, _, err := solana.FindProgramAddress(
 		[][]byte{executedSubTxSeed, o.subTxID[:]},
 		r.builder.gatewayAddress,
@@ -233,13 +233,7 @@ func (r *RentReclaimer) closeOrphan(ctx context.Context, o orphanPDA, relayer so
 	if err != nil {
 		return fmt.Errorf("build close tx: %w", err)
 	}
-	if _, err := tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
-		if key.Equals(relayer.PublicKey()) {
-			priv := relayer
-			return &priv
-		}
-		return nil
-	}); err != nil {
+	if err := signAsRelayer(tx, relayer); err != nil {
 		return fmt.Errorf("sign close tx: %w", err)
 	}
 
@@ -2,6 +2,8 @@ package svm
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
@@ -12,14 +14,29 @@ import (
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/rs/zerolog"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+)
+
+// Health-check methods selectable per chain via rpc_health_check.
+const (
+	HealthCheckSlot   = "slot"   // getSlot answers (default)
+	HealthCheckHealth = "health" // getHealth reports the node is not behind the cluster
 )
 
 // RPCClient provides SVM-specific RPC operations
 type RPCClient struct {
-	clients []*rpc.Client
-	index   uint64
-	mu      sync.RWMutex
-	logger  zerolog.Logger
+	clients   []*rpc.Client
+	limiters  []*common.EndpointLimiter // aligned with clients; tracks pacing and 429 cooldowns
+	endpoints []string                  // aligned with clients; redacted URLs for attribution
+	latencies []*common.LatencyWindow   // aligned with clients; recent call latencies for stats
+	index     uint64
+	streak    int    // consecutive successes a degraded endpoint needs; see SetRecoveryStreak
+	probe     string // IsHealthy method, one of the HealthCheck* constants; empty means the default
+	dryRun    bool   // BroadcastTransaction logs instead of sending; see SetDryRun
+	mu        sync.RWMutex
+	gate      common.DrainGate // lets Close wait out in-flight calls
+	logger    zerolog.Logger
 }
 
 // NewRPCClient creates a new SVM RPC client from RPC URLs and validates genesis hash
@@ -30,6 +47,7 @@ func NewRPCClient(rpcURLs []string, expectedGenesisHash string, logger zerolog.L
 
 	log := logger.With().Str("component", "svm_rpc_client").Logger()
 	clients := make([]*rpc.Client, 0, len(rpcURLs))
+	endpoints := make([]string, 0, len(rpcURLs))
 
 	// Create a temporary context for initial connection and genesis hash verification
 	// Use longer timeout for genesis hash verification (30 seconds)
@@ -66,6 +84,7 @@ func NewRPCClient(rpcURLs []string, expectedGenesisHash string, logger zerolog.L
 					Str("expected_genesis_hash", expectedGenesisHash).
 					Msg("failed to verify genesis hash (timeout or error), proceeding with client anyway")
 				clients = append(clients, client)
+				endpoints = append(endpoints, common.RedactEndpoint(url))
 				continue
 			}
 
@@ -85,6 +104,7 @@ func NewRPCClient(rpcURLs []string, expectedGenesisHash string, logger zerolog.L
 		}
 
 		clients = append(clients, client)
+		endpoints = append(endpoints, common.RedactEndpoint(url))
 		log.Debug().Int("index", i).Msg("RPC client added to pool")
 	}
 
@@ -93,15 +113,26 @@ func NewRPCClient(rpcURLs []string, expectedGenesisHash string, logger zerolog.L
 	}
 
 	return &RPCClient{
-		clients: clients,
-		logger:  log,
+		clients:   clients,
+		limiters:  newEndpointLimiters(len(clients), 0, 0),
+		endpoints: endpoints,
+		latencies: newLatencyWindows(len(clients)),
+		logger:    log,
 	}, nil
 }
 
 // executeWithFailover executes a function with round-robin failover
 func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string, fn func(*rpc.Client) error) error {
+	if err := rc.gate.Enter(); err != nil {
+		return fmt.Errorf("%s: %w", operation, err)
+	}
+	defer rc.gate.Leave()
+
 	rc.mu.RLock()
 	clients := rc.clients
+	limiters := rc.limiters
+	endpoints := rc.endpoints
+	latencies := rc.latencies
 	rc.mu.RUnlock()
 
 	if len(clients) == 0 {
@@ -113,7 +144,7 @@ func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string,
 	// counter advances and retry the same failing endpoint.
 	startIndex := atomic.AddUint64(&rc.index, 1) - 1
 	var lastErr error
-	for attempt := 0; attempt < maxAttempts; attempt++ {
+	for attempt, idx := range common.OrderEndpoints(len(clients), startIndex, limiters) {
 		if ctx != nil {
 			select {
 			case <-ctx.Done():
@@ -122,18 +153,45 @@ func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string,
 			}
 		}
 
-		client := clients[(startIndex+uint64(attempt))%uint64(len(clients))]
+		client := clients[idx]
 
 		if client == nil {
 			continue
 		}
 
+		var limiter *common.EndpointLimiter
+		if idx < len(limiters) {
+			limiter = limiters[idx]
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		callStart := time.Now()
 		err := fn(client)
+		if idx < len(latencies) {
+			latencies[idx].Observe(time.Since(callStart))
+		}
 		if err == nil {
+			limiter.RecordSuccess()
+			if idx < len(endpoints) {
+				common.RecordEndpoint(ctx, operation, endpoints[idx])
+			}
 			return nil
 		}
 		lastErr = err
 
+		// A throttled endpoint is healthy, just busy: park it briefly so the
+		// next calls prefer its peers, and don't report it as a failure.
+		if common.IsRateLimitError(err) {
+			limiter.Cooldown(common.DefaultRateLimitCooldown)
+			rc.logger.Debug().
+				Str("operation", operation).
+				Int("endpoint", idx).
+				Msg("endpoint rate limited, cooling down")
+			continue
+		}
+
 		rc.logger.Warn().
 			Str("operation", operation).
 			Int("attempt", attempt+1).
@@ -147,18 +205,125 @@ func (rc *RPCClient) executeWithFailover(ctx context.Context, operation string,
 	return fmt.Errorf("operation %s failed after trying %d endpoints", operation, maxAttempts)
 }
 
+// SetRateLimit paces every endpoint with its own token bucket admitting
+// requestsPerSecond with the given burst. A non-positive rate removes pacing;
+// 429 cooldowns apply either way.
+func (rc *RPCClient) SetRateLimit(requestsPerSecond float64, burst int) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.limiters = newEndpointLimiters(len(rc.clients), requestsPerSecond, burst)
+	for _, limiter := range rc.limiters {
+		limiter.SetRecoveryStreak(rc.streak)
+	}
+}
+
+// SetDryRun makes BroadcastTransaction log each signed tx and return
+// common.ErrBroadcastSkipped instead of sending it.
+func (rc *RPCClient) SetDryRun(enabled bool) {
+	rc.mu.Lock()
+	rc.dryRun = enabled
+	rc.mu.Unlock()
+}
+
+// SetHealthCheck selects the RPC method IsHealthy probes. An empty method
+// keeps the default (HealthCheckSlot).
+func (rc *RPCClient) SetHealthCheck(method string) error {
+	switch method {
+	case "", HealthCheckSlot, HealthCheckHealth:
+	default:
+		return fmt.Errorf("unknown health check %q (want %q or %q)", method, HealthCheckSlot, HealthCheckHealth)
+	}
+	rc.mu.Lock()
+	rc.probe = method
+	rc.mu.Unlock()
+	return nil
+}
+
+// SetRecoveryStreak sets how many consecutive successful calls an endpoint
+// that was rate limited needs before it counts as healthy again. A
+// non-positive n uses common.DefaultRecoveryStreak.
+func (rc *RPCClient) SetRecoveryStreak(n int) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.streak = n
+	for _, limiter := range rc.limiters {
+		limiter.SetRecoveryStreak(n)
+	}
+}
+
+func newLatencyWindows(n int) []*common.LatencyWindow {
+	windows := make([]*common.LatencyWindow, n)
+	for i := range windows {
+		windows[i] = common.NewLatencyWindow(common.DefaultLatencyWindow)
+	}
+	return windows
+}
+
+// EndpointStats reports each endpoint's throttling state and its p50/p95/p99
+// latency over recent calls, in configuration order.
+func (rc *RPCClient) EndpointStats() []common.EndpointStats {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	stats := make([]common.EndpointStats, len(rc.clients))
+	for i := range rc.clients {
+		if i < len(rc.endpoints) {
+			stats[i].Endpoint = rc.endpoints[i]
+		}
+		if i < len(rc.limiters) {
+			stats[i].InCooldown = rc.limiters[i].InCooldown()
+			stats[i].Degraded = rc.limiters[i].Degraded()
+		}
+		if i < len(rc.latencies) {
+			stats[i].Latency = rc.latencies[i].Percentiles()
+		}
+	}
+	return stats
+}
+
+func newEndpointLimiters(n int, requestsPerSecond float64, burst int) []*common.EndpointLimiter {
+	limiters := make([]*common.EndpointLimiter, n)
+	for i := range limiters {
+		limiters[i] = common.NewEndpointLimiter(requestsPerSecond, burst)
+	}
+	return limiters
+}
+
 // IsHealthy checks if any RPC in the pool is healthy by pinging it
 func (rc *RPCClient) IsHealthy(ctx context.Context) bool {
 	rc.mu.RLock()
 	hasClients := len(rc.clients) > 0
+	probe := rc.probe
 	rc.mu.RUnlock()
 
 	if !hasClients {
 		return false
 	}
 
-	_, err := rc.GetLatestSlot(ctx)
-	return err == nil
+	var err error
+	switch probe {
+	case HealthCheckHealth:
+		err = rc.checkNodeHealth(ctx)
+	default:
+		_, err = rc.GetLatestSlot(ctx)
+	}
+	// A pool that only answers 429s is throttled, not down.
+	return err == nil || common.IsRateLimitError(err)
+}
+
+// checkNodeHealth fails unless getHealth answers "ok"; a node lagging the
+// cluster answers with an error instead.
+func (rc *RPCClient) checkNodeHealth(ctx context.Context) error {
+	return rc.executeWithFailover(ctx, "get_health", func(client *rpc.Client) error {
+		status, err := client.GetHealth(ctx)
+		if err != nil {
+			return err
+		}
+		if status != rpc.HealthOk {
+			return fmt.Errorf("node unhealthy: %s", status)
+		}
+		return nil
+	})
 }
 
 // GetLatestSlot returns the latest slot number
@@ -336,6 +501,21 @@ func (rc *RPCClient) BroadcastTransaction(ctx context.Context, tx *solana.Transa
 	}
 	txHash := tx.Signatures[0].String()
 
+	rc.mu.RLock()
+	dryRun := rc.dryRun
+	rc.mu.RUnlock()
+	if dryRun {
+		raw, err := tx.MarshalBinary()
+		if err != nil {
+			return "", fmt.Errorf("failed to encode transaction: %w", err)
+		}
+		rc.logger.Info().
+			Str("tx_hash", txHash).
+			Str("raw_tx", base64.StdEncoding.EncodeToString(raw)).
+			Msg("dry run: transaction built but not broadcast")
+		return txHash, common.ErrBroadcastSkipped
+	}
+
 	err := rc.executeWithFailover(ctx, "send_transaction", func(client *rpc.Client) error {
 		_, innerErr := client.SendTransaction(ctx, tx)
 		return innerErr
@@ -367,6 +547,128 @@ func (rc *RPCClient) SimulateTransaction(ctx context.Context, tx *solana.Transac
 	return result.Value, nil
 }
 
+// EstimateFee returns the total lamports the fee payer spends on tx: the
+// network fee for its message (base fee plus compute-budget priority fee, per
+// getFeeForMessage) and rent for every account the tx creates. Created
+// accounts are the writable non-signer accounts absent before a simulated run
+// and present after it. Each is charged the rent-exempt minimum for its size
+// rather than its balance, so an amount transferred into a new account is not
+// counted; this errs high when the transfer itself covers the rent.
+func (rc *RPCClient) EstimateFee(ctx context.Context, tx *solana.Transaction) (uint64, error) {
+	var fee uint64
+	err := rc.executeWithFailover(ctx, "get_fee_for_message", func(client *rpc.Client) error {
+		resp, innerErr := client.GetFeeForMessage(ctx, tx.Message.ToBase64(), rpc.CommitmentConfirmed)
+		if innerErr != nil {
+			return innerErr
+		}
+		if resp == nil || resp.Value == nil {
+			return fmt.Errorf("no fee for message: blockhash %s not found", tx.Message.RecentBlockhash)
+		}
+		fee = *resp.Value
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get fee for message: %w", err)
+	}
+
+	metas, err := tx.Message.AccountMetaList()
+	if err != nil {
+		return 0, fmt.Errorf("failed to list transaction accounts: %w", err)
+	}
+	var candidates []solana.PublicKey
+	for _, meta := range metas {
+		if meta.IsWritable && !meta.IsSigner {
+			candidates = append(candidates, meta.PublicKey)
+		}
+	}
+	if len(candidates) == 0 {
+		return fee, nil
+	}
+
+	var missing []solana.PublicKey
+	err = rc.executeWithFailover(ctx, "get_multiple_accounts", func(client *rpc.Client) error {
+		resp, innerErr := client.GetMultipleAccounts(ctx, candidates...)
+		if innerErr != nil {
+			return innerErr
+		}
+		missing = missing[:0]
+		for i, account := range resp.Value {
+			if account == nil && i < len(candidates) {
+				missing = append(missing, candidates[i])
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get transaction accounts: %w", err)
+	}
+	if len(missing) == 0 {
+		return fee, nil
+	}
+
+	var created []*rpc.Account
+	err = rc.executeWithFailover(ctx, "simulate_transaction", func(client *rpc.Client) error {
+		resp, innerErr := client.SimulateTransactionWithOpts(ctx, tx, &rpc.SimulateTransactionOpts{
+			SigVerify: false,
+			Accounts: &rpc.SimulateTransactionAccountsOpts{
+				Encoding:  solana.EncodingBase64,
+				Addresses: missing,
+			},
+		})
+		if innerErr != nil {
+			return innerErr
+		}
+		if resp == nil || resp.Value == nil {
+			return fmt.Errorf("empty simulation result")
+		}
+		if resp.Value.Err != nil {
+			return fmt.Errorf("simulation failed: %v", resp.Value.Err)
+		}
+		created = resp.Value.Accounts
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to simulate transaction: %w", err)
+	}
+
+	rentBySize := make(map[uint64]uint64)
+	total := fee
+	for _, account := range created {
+		if account == nil {
+			continue
+		}
+		size := uint64(len(account.Data.GetBinary()))
+		rent, ok := rentBySize[size]
+		if !ok {
+			err := rc.executeWithFailover(ctx, "get_minimum_balance_for_rent_exemption", func(client *rpc.Client) error {
+				var innerErr error
+				rent, innerErr = client.GetMinimumBalanceForRentExemption(ctx, size, rpc.CommitmentConfirmed)
+				return innerErr
+			})
+			if err != nil {
+				return 0, fmt.Errorf("failed to get rent exemption for %d bytes: %w", size, err)
+			}
+			rentBySize[size] = rent
+		}
+		total += rent
+	}
+	return total, nil
+}
+
+// GetBalance returns the lamport balance of an account at confirmed commitment.
+func (rc *RPCClient) GetBalance(ctx context.Context, pubkey solana.PublicKey) (uint64, error) {
+	var balance uint64
+	err := rc.executeWithFailover(ctx, "get_balance", func(client *rpc.Client) error {
+		resp, innerErr := client.GetBalance(ctx, pubkey, rpc.CommitmentConfirmed)
+		if innerErr != nil {
+			return innerErr
+		}
+		balance = resp.Value
+		return nil
+	})
+	return balance, err
+}
+
 // GetAccountData fetches account data for a given public key. Uses Solana
 // RPC's default commitment (`finalized`, per the JSON-RPC spec) — reorg-safe
 // for both terminal decisions and race-recovery probes.
@@ -386,8 +688,33 @@ func (rc *RPCClient) GetAccountData(ctx context.Context, pubkey solana.PublicKey
 	return accountData, err
 }
 
-// Close closes all RPC connections
+// GetAccount fetches an account's metadata and data at finalized commitment.
+// Returns (nil, nil) if the account does not exist.
+func (rc *RPCClient) GetAccount(ctx context.Context, pubkey solana.PublicKey) (*rpc.Account, error) {
+	var account *rpc.Account
+	err := rc.executeWithFailover(ctx, "get_account", func(client *rpc.Client) error {
+		accountInfo, innerErr := client.GetAccountInfo(ctx, pubkey)
+		if errors.Is(innerErr, rpc.ErrNotFound) {
+			return nil
+		}
+		if innerErr != nil {
+			return innerErr
+		}
+		account = accountInfo.Value
+		return nil
+	})
+	return account, err
+}
+
+// Close stops admitting calls, waits up to DefaultDrainTimeout for calls in
+// flight to finish, then closes all RPC connections.
 func (rc *RPCClient) Close() {
+	if !rc.gate.Drain(common.DefaultDrainTimeout) {
+		rc.logger.Warn().
+			Dur("timeout", common.DefaultDrainTimeout).
+			Msg("in-flight RPC calls did not finish before close")
+	}
+
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
 
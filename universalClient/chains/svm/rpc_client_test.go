@@ -2,13 +2,24 @@ package svm
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/rs/zerolog"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
 )
 
 func TestCalculateMedian(t *testing.T) {
@@ -133,6 +144,51 @@ func TestClose_EmptyClients(t *testing.T) {
 	}
 }
 
+// TestClose_DrainsInFlightCalls verifies Close lets a call already in
+// flight finish and rejects calls started afterwards.
+func TestClose_DrainsInFlightCalls(t *testing.T) {
+	rc := &RPCClient{clients: []*rpc.Client{{}}, logger: zerolog.Nop()}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	callErr := make(chan error, 1)
+	go func() {
+		callErr <- rc.executeWithFailover(context.Background(), "test", func(c *rpc.Client) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	closed := make(chan struct{})
+	go func() {
+		rc.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+		t.Fatal("Close returned while a call was still in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	err := rc.executeWithFailover(context.Background(), "test", func(c *rpc.Client) error { return nil })
+	if !errors.Is(err, common.ErrRPCClientClosed) {
+		t.Errorf("expected ErrRPCClientClosed for a call after Close began, got %v", err)
+	}
+
+	close(release)
+	if err := <-callErr; err != nil {
+		t.Errorf("in-flight call should complete during Close, got %v", err)
+	}
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close did not return after the in-flight call finished")
+	}
+}
+
 // TestExecuteWithFailover_ConcurrentRotation verifies F-2026-16960 is fixed:
 // under concurrent load, every caller must visit every endpoint exactly once,
 // even when all endpoints fail (forcing the loop to run to completion).
@@ -217,3 +273,395 @@ func TestExecuteWithFailover_SequentialRotation(t *testing.T) {
 		}
 	}
 }
+
+// feeServer serves the RPC calls EstimateFee makes: a fixed message fee,
+// getMultipleAccounts reporting the accounts in existing, a simulation in
+// which the accounts in created appear with the given data sizes, and rent
+// exemption of 1000 lamports plus 10 per byte. getBalance returns balance.
+func feeServer(t *testing.T, fee string, balance uint64, existing map[solana.PublicKey]bool, created map[solana.PublicKey]int) *RPCClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []json.RawMessage
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		reply := func(result string) {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+		}
+		account := func(size int) string {
+			data := base64.StdEncoding.EncodeToString(make([]byte, size))
+			return `{"data":["` + data + `","base64"],"executable":false,"lamports":1,"owner":"11111111111111111111111111111111","rentEpoch":0,"space":0}`
+		}
+		switch req.Method {
+		case "getHealth":
+			reply(`"ok"`)
+		case "getBalance":
+			out, _ := json.Marshal(balance)
+			reply(`{"context":{"slot":1},"value":` + string(out) + `}`)
+		case "getFeeForMessage":
+			reply(`{"context":{"slot":1},"value":` + fee + `}`)
+		case "getMultipleAccounts":
+			var keys []string
+			_ = json.Unmarshal(req.Params[0], &keys)
+			var values []string
+			for _, k := range keys {
+				if existing[solana.MustPublicKeyFromBase58(k)] {
+					values = append(values, account(8))
+				} else {
+					values = append(values, "null")
+				}
+			}
+			reply(`{"context":{"slot":1},"value":[` + strings.Join(values, ",") + `]}`)
+		case "simulateTransaction":
+			var opts struct {
+				Accounts struct {
+					Addresses []string `json:"addresses"`
+				} `json:"accounts"`
+			}
+			_ = json.Unmarshal(req.Params[1], &opts)
+			var values []string
+			for _, k := range opts.Accounts.Addresses {
+				if size, ok := created[solana.MustPublicKeyFromBase58(k)]; ok {
+					values = append(values, account(size))
+				} else {
+					values = append(values, "null")
+				}
+			}
+			reply(`{"context":{"slot":1},"value":{"err":null,"logs":[],"accounts":[` + strings.Join(values, ",") + `]}}`)
+		case "getMinimumBalanceForRentExemption":
+			var size int
+			_ = json.Unmarshal(req.Params[0], &size)
+			out, _ := json.Marshal(1000 + 10*size)
+			reply(string(out))
+		default:
+			reply(`null`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	rc, err := NewRPCClient([]string{server.URL}, "", zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewRPCClient: %v", err)
+	}
+	return rc
+}
+
+func feeTestTx(t *testing.T, payer solana.PublicKey, writable ...solana.PublicKey) *solana.Transaction {
+	t.Helper()
+	accounts := []*solana.AccountMeta{{PublicKey: payer, IsWritable: true, IsSigner: true}}
+	for _, w := range writable {
+		accounts = append(accounts, &solana.AccountMeta{PublicKey: w, IsWritable: true})
+	}
+	ix := solana.NewInstruction(solana.SystemProgramID, accounts, []byte{0})
+	tx, err := solana.NewTransaction([]solana.Instruction{ix}, solana.Hash{1}, solana.TransactionPayer(payer))
+	if err != nil {
+		t.Fatalf("NewTransaction: %v", err)
+	}
+	return tx
+}
+
+func TestEstimateFee(t *testing.T) {
+	payer := solana.NewWallet().PublicKey()
+	existing := solana.NewWallet().PublicKey()
+	ata := solana.NewWallet().PublicKey()
+	pda := solana.NewWallet().PublicKey()
+	recipient := solana.NewWallet().PublicKey()
+
+	t.Run("fee plus rent for created accounts", func(t *testing.T) {
+		rc := feeServer(t, "5000", 0,
+			map[solana.PublicKey]bool{existing: true},
+			map[solana.PublicKey]int{ata: 165, pda: 9, recipient: 0})
+		got, err := rc.EstimateFee(context.Background(), feeTestTx(t, payer, existing, ata, pda, recipient))
+		if err != nil {
+			t.Fatalf("EstimateFee: %v", err)
+		}
+		// 5000 fee + rent(165) + rent(9) + rent(0)
+		want := uint64(5000 + (1000 + 1650) + (1000 + 90) + 1000)
+		if got != want {
+			t.Errorf("EstimateFee = %d, want %d", got, want)
+		}
+	})
+
+	t.Run("fee only when nothing is created", func(t *testing.T) {
+		rc := feeServer(t, "15000", 0, map[solana.PublicKey]bool{existing: true}, nil)
+		got, err := rc.EstimateFee(context.Background(), feeTestTx(t, payer, existing))
+		if err != nil {
+			t.Fatalf("EstimateFee: %v", err)
+		}
+		if got != 15000 {
+			t.Errorf("EstimateFee = %d, want 15000", got)
+		}
+	})
+
+	t.Run("unknown blockhash", func(t *testing.T) {
+		rc := feeServer(t, "null", 0, nil, nil)
+		if _, err := rc.EstimateFee(context.Background(), feeTestTx(t, payer)); err == nil {
+			t.Error("expected error for a message with no fee")
+		}
+	})
+}
+
+// TestIsHealthy_HealthCheckMethod verifies IsHealthy probes the configured
+// method: getSlot by default, getHealth when selected.
+func TestIsHealthy_HealthCheckMethod(t *testing.T) {
+	var behind atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.Method == "getHealth" && behind.Load():
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32005,"message":"Node is behind by 120 slots"}}`, req.ID)
+		case req.Method == "getHealth":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"ok"}`, req.ID)
+		default:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":42}`, req.ID)
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, "", zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewRPCClient: %v", err)
+	}
+
+	// The node falls behind after the client connected.
+	behind.Store(true)
+	if !rc.IsHealthy(context.Background()) {
+		t.Error("default slot probe should pass while getSlot answers")
+	}
+
+	if err := rc.SetHealthCheck(HealthCheckHealth); err != nil {
+		t.Fatalf("SetHealthCheck: %v", err)
+	}
+	if rc.IsHealthy(context.Background()) {
+		t.Error("health probe should fail while the node is behind")
+	}
+	behind.Store(false)
+	if !rc.IsHealthy(context.Background()) {
+		t.Error("health probe should pass once getHealth answers ok")
+	}
+
+	if err := rc.SetHealthCheck("syncing"); err == nil {
+		t.Error("expected error for an EVM-only health check")
+	}
+}
+
+func TestBroadcastTransaction_DryRun(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{solana.NewInstruction(solana.SystemProgramID, solana.AccountMetaSlice{solana.Meta(payer.PublicKey()).SIGNER().WRITE()}, nil)},
+		solana.Hash{},
+		solana.TransactionPayer(payer.PublicKey()),
+	)
+	if err != nil {
+		t.Fatalf("NewTransaction: %v", err)
+	}
+	if err := signAsRelayer(tx, NewKeypairSigner(payer)); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	var sends atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Method {
+		case "sendTransaction":
+			sends.Add(1)
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%q}`, req.ID, tx.Signatures[0].String())
+		case "getHealth":
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"ok"}`, req.ID)
+		default:
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":42}`, req.ID)
+		}
+	}))
+	defer server.Close()
+
+	rc, err := NewRPCClient([]string{server.URL}, "", zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewRPCClient: %v", err)
+	}
+	defer rc.Close()
+
+	rc.SetDryRun(true)
+	hash, err := rc.BroadcastTransaction(context.Background(), tx)
+	if !errors.Is(err, common.ErrBroadcastSkipped) {
+		t.Fatalf("expected ErrBroadcastSkipped, got %v", err)
+	}
+	if hash != tx.Signatures[0].String() {
+		t.Errorf("dry run returned hash %s, want %s", hash, tx.Signatures[0])
+	}
+	if n := sends.Load(); n != 0 {
+		t.Errorf("dry run sent %d transactions", n)
+	}
+
+	rc.SetDryRun(false)
+	if _, err := rc.BroadcastTransaction(context.Background(), tx); err != nil {
+		t.Fatalf("BroadcastTransaction: %v", err)
+	}
+	if n := sends.Load(); n != 1 {
+		t.Errorf("expected 1 send after disabling dry run, got %d", n)
+	}
+}
+
+// TestRPCClient_FailsOverFromFailingEndpoint verifies each call the chain
+// depends on moves past a failing endpoint to a healthy one.
+func TestRPCClient_FailsOverFromFailingEndpoint(t *testing.T) {
+	payer := solana.NewWallet().PrivateKey
+	tx := feeTestTx(t, payer.PublicKey())
+	if err := signAsRelayer(tx, NewKeypairSigner(payer)); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	blockhash := solana.Hash{7}
+
+	var failing atomic.Int32
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failing.Add(1)
+		http.Error(w, "upstream unavailable", http.StatusBadGateway)
+	}))
+	t.Cleanup(bad.Close)
+
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		reply := func(result string) {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+		}
+		switch req.Method {
+		case "getAccountInfo":
+			reply(`{"context":{"slot":1},"value":{"data":["AQID","base64"],"executable":false,"lamports":1,"owner":"11111111111111111111111111111111","rentEpoch":0}}`)
+		case "getLatestBlockhash":
+			reply(`{"context":{"slot":1},"value":{"blockhash":"` + blockhash.String() + `","lastValidBlockHeight":100}}`)
+		case "sendTransaction":
+			reply(`"` + tx.Signatures[0].String() + `"`)
+		case "getTransaction":
+			reply(`{"slot":9,"blockTime":null,"meta":null,"transaction":["` + base64.StdEncoding.EncodeToString(rawTx) + `","base64"]}`)
+		case "getSlot":
+			reply(`42`)
+		case "simulateTransaction":
+			reply(`{"context":{"slot":1},"value":{"err":null,"logs":["ok"],"accounts":null,"unitsConsumed":100}}`)
+		default:
+			reply(`null`)
+		}
+	}))
+	t.Cleanup(good.Close)
+
+	calls := []struct {
+		name string
+		call func(rc *RPCClient) error
+	}{
+		{name: "GetAccountData", call: func(rc *RPCClient) error {
+			data, err := rc.GetAccountData(context.Background(), payer.PublicKey())
+			if err == nil && string(data) != "\x01\x02\x03" {
+				return fmt.Errorf("data = %x", data)
+			}
+			return err
+		}},
+		{name: "GetRecentBlockhash", call: func(rc *RPCClient) error {
+			got, err := rc.GetRecentBlockhash(context.Background())
+			if err == nil && got != blockhash {
+				return fmt.Errorf("blockhash = %s", got)
+			}
+			return err
+		}},
+		{name: "BroadcastTransaction", call: func(rc *RPCClient) error {
+			_, err := rc.BroadcastTransaction(context.Background(), tx)
+			return err
+		}},
+		{name: "GetTransaction", call: func(rc *RPCClient) error {
+			got, err := rc.GetTransaction(context.Background(), tx.Signatures[0])
+			if err == nil && got.Slot != 9 {
+				return fmt.Errorf("slot = %d", got.Slot)
+			}
+			return err
+		}},
+		{name: "GetLatestSlot", call: func(rc *RPCClient) error {
+			slot, err := rc.GetLatestSlot(context.Background())
+			if err == nil && slot != 42 {
+				return fmt.Errorf("slot = %d", slot)
+			}
+			return err
+		}},
+		{name: "SimulateTransaction", call: func(rc *RPCClient) error {
+			res, err := rc.SimulateTransaction(context.Background(), tx)
+			if err == nil && res.Err != nil {
+				return fmt.Errorf("simulation err = %v", res.Err)
+			}
+			return err
+		}},
+	}
+
+	for _, tc := range calls {
+		t.Run(tc.name, func(t *testing.T) {
+			// The failing endpoint comes first, so every call starts there.
+			rc := &RPCClient{
+				clients:   []*rpc.Client{rpc.New(bad.URL), rpc.New(good.URL)},
+				limiters:  newEndpointLimiters(2, 0, 0),
+				endpoints: []string{"bad", "good"},
+				latencies: newLatencyWindows(2),
+				logger:    zerolog.Nop(),
+			}
+			before := failing.Load()
+			if err := tc.call(rc); err != nil {
+				t.Fatalf("%s: %v", tc.name, err)
+			}
+			if failing.Load() == before {
+				t.Errorf("%s never tried the failing endpoint", tc.name)
+			}
+		})
+	}
+}
+
+// TestRPCClient_ThrottledEndpointCoolsDown checks that the 429 a Solana RPC
+// answers with (HTTP status and error code) is classified as throttling.
+func TestRPCClient_ThrottledEndpointCoolsDown(t *testing.T) {
+	for name, body := range map[string]string{
+		"json error": `{"jsonrpc":"2.0","id":1,"error":{"code":429,"message":"Too many requests for a specific RPC call"}}`,
+		"plain body": `Too Many Requests`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			throttled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(body))
+			}))
+			t.Cleanup(throttled.Close)
+			good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":42}`))
+			}))
+			t.Cleanup(good.Close)
+
+			rc := &RPCClient{
+				clients:   []*rpc.Client{rpc.New(throttled.URL), rpc.New(good.URL)},
+				limiters:  newEndpointLimiters(2, 0, 0),
+				endpoints: []string{"throttled", "good"},
+				latencies: newLatencyWindows(2),
+				logger:    zerolog.Nop(),
+			}
+			if _, err := rc.GetLatestSlot(context.Background()); err != nil {
+				t.Fatalf("GetLatestSlot: %v", err)
+			}
+			if !rc.limiters[0].InCooldown() {
+				t.Error("throttled endpoint should be cooling down")
+			}
+		})
+	}
+}
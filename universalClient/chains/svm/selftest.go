@@ -0,0 +1,54 @@
+package svm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// gatewayPDA is one singleton gateway account checked by SelfTest.
+type gatewayPDA struct {
+	name string
+	seed []byte
+	// programOwned is set for Anchor state accounts. Lamport vaults are plain
+	// system accounts, so only their existence is checked.
+	programOwned bool
+}
+
+var selfTestPDAs = []gatewayPDA{
+	{name: "config", seed: configSeed, programOwned: true},
+	{name: "tss", seed: tssSeed, programOwned: true},
+	{name: "vault", seed: vaultSeed},
+	{name: "fee_vault", seed: feeVaultSeed},
+}
+
+// SelfTest derives the gateway's singleton PDAs from the seeds compiled into
+// this client and checks them against the deployed gateway: each must exist,
+// and state accounts must be owned by the gateway program. A failure usually
+// means the gateway was redeployed with changed seeds, in which case every
+// outbound built by this client would be rejected on-chain.
+//
+// Per-tx PDAs (executed_sub_tx, stored_ix_data) only exist once a tx lands and
+// are not checked. All failures are reported together.
+func (tb *TxBuilder) SelfTest(ctx context.Context) error {
+	var errs []error
+	for _, pda := range selfTestPDAs {
+		address, _, err := solana.FindProgramAddress([][]byte{pda.seed}, tb.gatewayAddress)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s PDA: derive: %w", pda.name, err))
+			continue
+		}
+		account, err := tb.rpcClient.GetAccount(ctx, address)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("%s PDA %s: %w", pda.name, address, err))
+		case account == nil:
+			errs = append(errs, fmt.Errorf("%s PDA %s: account not found", pda.name, address))
+		case pda.programOwned && !account.Owner.Equals(tb.gatewayAddress):
+			errs = append(errs, fmt.Errorf("%s PDA %s: owned by %s, want gateway %s", pda.name, address, account.Owner, tb.gatewayAddress))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,102 @@
+package svm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accountServer serves getAccountInfo from owners (address → owner); other
+// addresses are reported as absent.
+func accountServer(t *testing.T, owners map[solana.PublicKey]solana.PublicKey) *RPCClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []any           `json:"params"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		reply := func(result string) {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + result + `}`))
+		}
+		switch req.Method {
+		case "getHealth":
+			reply(`"ok"`)
+		case "getAccountInfo":
+			owner, ok := owners[solana.MustPublicKeyFromBase58(req.Params[0].(string))]
+			if !ok {
+				reply(`{"context":{"slot":1},"value":null}`)
+				return
+			}
+			reply(`{"context":{"slot":1},"value":{"data":["","base64"],"executable":false,"lamports":1000000,"owner":"` +
+				owner.String() + `","rentEpoch":0,"space":0}}`)
+		default:
+			reply(`null`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	rc, err := NewRPCClient([]string{server.URL}, "", zerolog.Nop())
+	require.NoError(t, err)
+	return rc
+}
+
+func TestSelfTest(t *testing.T) {
+	gateway := solana.MustPublicKeyFromBase58(testGatewayAddress)
+	pda := func(seed []byte) solana.PublicKey {
+		addr, _, err := solana.FindProgramAddress([][]byte{seed}, gateway)
+		require.NoError(t, err)
+		return addr
+	}
+	healthy := func() map[solana.PublicKey]solana.PublicKey {
+		return map[solana.PublicKey]solana.PublicKey{
+			pda(configSeed):   gateway,
+			pda(tssSeed):      gateway,
+			pda(vaultSeed):    solana.SystemProgramID,
+			pda(feeVaultSeed): solana.SystemProgramID,
+		}
+	}
+	selfTest := func(owners map[solana.PublicKey]solana.PublicKey) error {
+		tb, err := NewTxBuilder(accountServer(t, owners), "solana:devnet", testGatewayAddress, "/tmp", zerolog.Nop(), nil)
+		require.NoError(t, err)
+		return tb.SelfTest(context.Background())
+	}
+
+	t.Run("all PDAs present and owned", func(t *testing.T) {
+		assert.NoError(t, selfTest(healthy()))
+	})
+
+	t.Run("missing PDA is reported", func(t *testing.T) {
+		owners := healthy()
+		delete(owners, pda(tssSeed))
+		err := selfTest(owners)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "tss PDA "+pda(tssSeed).String()+": account not found")
+	})
+
+	t.Run("state PDA owned by another program is reported", func(t *testing.T) {
+		owners := healthy()
+		owners[pda(configSeed)] = solana.SystemProgramID
+		err := selfTest(owners)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "config PDA")
+		assert.Contains(t, err.Error(), "owned by "+solana.SystemProgramID.String())
+	})
+
+	t.Run("every failure is reported", func(t *testing.T) {
+		err := selfTest(nil)
+		require.Error(t, err)
+		for _, name := range []string{"config", "tss", "vault", "fee_vault"} {
+			assert.Contains(t, err.Error(), name+" PDA")
+		}
+	})
+}
@@ -29,11 +29,9 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -82,9 +80,23 @@ const (
 	solanaTxMaxBytes        = 1232            // Solana hard tx-size limit (legacy and v0)
 	maxDirectTxSize         = 1180            // fall back to ref-route above this; margin absorbs blockhash-encoding variance
 	maxRefRouteIxData       = 921             // ix_data ceiling — store tx itself must fit under solanaTxMaxBytes
-	defaultComputeUnitLimit = uint32(400_000) // CU budget per gateway tx; covers all flows including CEA execute
+	defaultComputeUnitLimit = uint32(400_000) // default CU budget per gateway tx (compute_unit_limit overrides); covers all flows including CEA execute
+	maxComputeUnitLimit     = 1_400_000       // Solana per-tx compute unit cap
 )
 
+// revert_msg caps. keccak256(revert_msg) is bound into the TSS message, so
+// these decide what every validator signs and are not configurable. Each is
+// the longest revert_msg a legacy revert tx of that kind fits under
+// solanaTxMaxBytes: shorter messages are signed unchanged, and longer ones
+// could not land as a legacy tx before they were clamped either.
+const (
+	maxRevertMsgLenSOL = 557 // SOL revert: compute budget + gateway ix
+	maxRevertMsgLenSPL = 387 // SPL revert: also creates the recipient ATA and passes token accounts
+)
+
+// revertMsgTruncatedMarker ends a revert message clamped by clampRevertMsg.
+var revertMsgTruncatedMarker = []byte("...[truncated]")
+
 // =============================================================================
 //  Types
 // =============================================================================
@@ -104,6 +116,19 @@ type TxBuilder struct {
 	logger         zerolog.Logger
 	protocolALT    solana.PublicKey                      // zero if not configured
 	tokenALTs      map[solana.PublicKey]solana.PublicKey // mint → token ALT
+	valuePolicy    common.ValueConfirmationPolicy
+	computeUnits   uint32 // SetComputeUnitLimit value for every gateway tx
+
+	// cuMarginPercent, when positive, sizes the compute unit limit of direct
+	// gateway txs from a simulation: consumed units plus this percentage.
+	cuMarginPercent int
+
+	// executeValidators check execute-mode payload accounts per target
+	// program before signing; see account_rules.go.
+	executeValidators map[solana.PublicKey]ExecuteAccountsValidator
+
+	// relayerKeys resolves the fee payer's signer; see relayer_key.go.
+	relayerKeys RelayerKeyLoader
 }
 
 // NewTxBuilder creates a new Solana transaction builder.
@@ -139,10 +164,29 @@ func NewTxBuilder(
 		nodeHome:       nodeHome,
 		logger:         logger.With().Str("component", "svm_tx_builder").Str("chain", chainID).Logger(),
 		tokenALTs:      make(map[solana.PublicKey]solana.PublicKey),
+		computeUnits:   defaultComputeUnitLimit,
+		relayerKeys:    FileRelayerKeyLoader{NodeHome: nodeHome},
 	}
 
 	// Parse ALT config if provided
 	if chainConfig != nil {
+		if chainConfig.RelayerKeyBackend != "" {
+			loader, err := newRelayerKeyLoader(chainConfig.RelayerKeyBackend, nodeHome)
+			if err != nil {
+				return nil, err
+			}
+			tb.relayerKeys = loader
+		}
+		if cu := chainConfig.ComputeUnitLimit; cu != nil {
+			if *cu > 0 && *cu <= maxComputeUnitLimit {
+				tb.computeUnits = uint32(*cu)
+			} else {
+				tb.logger.Warn().Int("compute_unit_limit", *cu).Msg("compute unit limit out of range, using default")
+			}
+		}
+		if m := chainConfig.ComputeUnitMarginPercent; m != nil && *m > 0 {
+			tb.cuMarginPercent = *m
+		}
 		if chainConfig.ProtocolALT != "" {
 			protocolALT, err := solana.PublicKeyFromBase58(chainConfig.ProtocolALT)
 			if err != nil {
@@ -151,6 +195,15 @@ func NewTxBuilder(
 				tb.protocolALT = protocolALT
 			}
 		}
+		tb.loadExecuteAccountRules(chainConfig.ExecuteAccountRules)
+		if len(chainConfig.HighValueThresholds) > 0 && chainConfig.HighValueConfirmations != nil {
+			policy, err := common.NewValueConfirmationPolicy(chainConfig.HighValueThresholds, *chainConfig.HighValueConfirmations)
+			if err != nil {
+				tb.logger.Warn().Err(err).Msg("invalid high-value confirmation policy, using standard confirmations")
+			} else {
+				tb.valuePolicy = policy
+			}
+		}
 		for mint, altAddr := range chainConfig.TokenALTs {
 			mintPubkey, err := solana.PublicKeyFromBase58(mint)
 			if err != nil {
@@ -336,9 +389,7 @@ func (tb *TxBuilder) GetOutboundSigningRequest(
 		// Rescue (id=4) doesn't carry a revert reason. Treat decode failure
 		// as empty so the signing hash is still deterministic.
 		if instructionID == 3 {
-			if decoded, decErr := hex.DecodeString(removeHexPrefix(data.RevertMsg)); decErr == nil {
-				revertMsg = decoded
-			}
+			revertMsg = decodeRevertMsg(data.RevertMsg, isNative)
 		}
 	} else {
 		// Non-revert flows: decode payload to get instruction_id.
@@ -358,6 +409,9 @@ func (tb *TxBuilder) GetOutboundSigningRequest(
 				if err != nil {
 					return nil, fmt.Errorf("failed to decode payload: %w", err)
 				}
+				tb.logger.Debug().Str("tx_id", data.TxID).
+					Object("payload", newPayloadView(accounts, ixData, payloadInstructionID, payloadTargetProgram)).
+					Msg("decoded outbound payload")
 				instructionID = payloadInstructionID
 				if payloadTargetProgram != ([32]byte{}) {
 					targetProgram = payloadTargetProgram
@@ -389,6 +443,9 @@ func (tb *TxBuilder) GetOutboundSigningRequest(
 			if targetProgram == ([32]byte{}) {
 				copy(targetProgram[:], recipientPubkey.Bytes())
 			}
+			if err := tb.validateExecuteAccounts(targetProgram, accounts); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -503,6 +560,23 @@ func (tb *TxBuilder) VerifyBroadcastedTx(ctx context.Context, txHash string) (fo
 	return true, tx.Slot, confs, 1, nil
 }
 
+// SetValueConfirmationPolicy sets the confirmation depth required for
+// high-value outbounds.
+func (tb *TxBuilder) SetValueConfirmationPolicy(p common.ValueConfirmationPolicy) {
+	tb.valuePolicy = p
+}
+
+// RequiredConfirmations returns base, or the high-value depth when the
+// outbound amount reaches its asset's configured threshold. VerifyBroadcastedTx only
+// sees finalized transactions and counts finalized slots, so on Solana the
+// escalation is extra slots on top of finality.
+func (tb *TxBuilder) RequiredConfirmations(data *uetypes.OutboundCreatedEvent, base uint64) uint64 {
+	if data == nil {
+		return base
+	}
+	return tb.valuePolicy.RequiredConfirmations(data.AssetAddr, data.Amount, base)
+}
+
 // =============================================================================
 //  STEP 2: BroadcastOutboundSigningRequest
 //
@@ -516,9 +590,9 @@ func (tb *TxBuilder) VerifyBroadcastedTx(ctx context.Context, txHash string) (fo
 //    6. Creates a Solana transaction with compute budget + gateway instruction
 //    7. Signs with the relayer's Ed25519 key and broadcasts to the network
 //
-//  The signature parameter is the 64-byte TSS signature (r||s, no v byte).
-//  The recovery ID is determined by trying all 4 possible values and checking
-//  which one recovers to the TSS ETH address stored on-chain.
+//  The signature parameter is the 65-byte TSS signature (r||s||v). The v byte
+//  is checked first, then the other recovery IDs, against the TSS ETH address
+//  stored on-chain.
 // =============================================================================
 
 // BroadcastOutboundSigningRequest assembles a complete Solana transaction with the
@@ -555,9 +629,13 @@ func (tb *TxBuilder) BroadcastOutboundSigningRequest(
 		}
 	}
 
+	if err := tb.checkRelayerBalance(ctx, tx); err != nil {
+		return "", err
+	}
+
 	txHash, err := tb.rpcClient.BroadcastTransaction(ctx, tx)
 	if err != nil {
-		return "", fmt.Errorf("failed to broadcast transaction: %w", err)
+		return txHash, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
 
 	tb.logger.Info().
@@ -568,6 +646,67 @@ func (tb *TxBuilder) BroadcastOutboundSigningRequest(
 	return txHash, nil
 }
 
+// SimulateOutbound builds the direct finalize tx for an outbound with the
+// given TSS signature and simulates it without broadcasting. A failed
+// simulation is reported in the result; build and RPC failures are returned
+// as errors. Outbounds that need the 2-tx ref-finalize route cannot be
+// simulated, since the finalize tx reads state the store tx has yet to write.
+func (tb *TxBuilder) SimulateOutbound(
+	ctx context.Context,
+	req *common.UnsignedSigningReq,
+	data *uetypes.OutboundCreatedEvent,
+	signature []byte,
+) (*common.SimulationResult, error) {
+	tx, instructionID, err := tb.BuildOutboundTransaction(ctx, req, data, signature)
+	if err != nil {
+		return nil, err
+	}
+	if instructionID == 2 {
+		if txBytes, mErr := tx.MarshalBinary(); mErr == nil && len(txBytes) > maxDirectTxSize {
+			return nil, fmt.Errorf("direct finalize tx is %d bytes (limit %d); ref-finalize route cannot be simulated", len(txBytes), maxDirectTxSize)
+		}
+	}
+
+	sim, err := tb.rpcClient.SimulateTransaction(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate transaction: %w", err)
+	}
+
+	result := &common.SimulationResult{
+		Success: sim.Err == nil,
+		Logs:    sim.Logs,
+	}
+	if sim.Err != nil {
+		result.Err = fmt.Sprintf("%v", sim.Err)
+	}
+	if sim.UnitsConsumed != nil {
+		result.Units = *sim.UnitsConsumed
+	}
+	return result, nil
+}
+
+// checkRelayerBalance returns common.ErrInsufficientRelayerBalance if the
+// fee payer of tx (the relayer) cannot cover its estimated fee and rent, so
+// the TSS signature is not spent on a tx that fails for lack of funds. A
+// failed estimate or balance lookup does not block the broadcast.
+func (tb *TxBuilder) checkRelayerBalance(ctx context.Context, tx *solana.Transaction) error {
+	if len(tx.Message.AccountKeys) == 0 {
+		return nil
+	}
+	payer := tx.Message.AccountKeys[0]
+	fee, err := tb.rpcClient.EstimateFee(ctx, tx)
+	if err != nil {
+		tb.logger.Warn().Err(err).Msg("failed to estimate tx fee, broadcasting without relayer balance check")
+		return nil
+	}
+	balance, err := tb.rpcClient.GetBalance(ctx, payer)
+	if err != nil {
+		tb.logger.Warn().Err(err).Str("relayer", payer.String()).Msg("failed to check relayer balance, broadcasting anyway")
+		return nil
+	}
+	return common.CheckRelayerBalance(payer.String(), new(big.Int).SetUint64(balance), new(big.Int).SetUint64(fee))
+}
+
 // storedPDAExists is the race-recovery probe — if the PDA is on-chain we can
 // proceed to finalize regardless of whose store_execute_ix_data put it there.
 func (tb *TxBuilder) storedPDAExists(ctx context.Context, storedPDA solana.PublicKey) bool {
@@ -579,10 +718,14 @@ func (tb *TxBuilder) storedPDAExists(ctx context.Context, storedPDA solana.Publi
 // machine — at most ONE action per broadcaster tick:
 //
 //   - PDA exists on-chain → broadcast finalize, return tx hash.
-//   - PDA absent          → broadcast store, return non-nil error so the
-//     broadcaster counts it as a failed attempt and retries next tick. The
-//     happy path: tick N broadcasts store, tick N+1 (15s later, after ~13s
-//     Finalized) sees the PDA and broadcasts finalize.
+//   - PDA absent          → broadcast store, return its hash with a non-nil
+//     error so the broadcaster counts it as a failed attempt and retries next
+//     tick. The happy path: tick N broadcasts store, tick N+1 (15s later, after
+//     ~13s Finalized) sees the PDA and broadcasts finalize.
+//
+// In dry run the store tx never lands, so the finalize tx is dry-run in the
+// same tick and its hash is returned with common.ErrBroadcastSkipped; the
+// event is only marked DRY_RUN once both steps were built.
 //
 // PDA is content-addressed by (sub_tx_id, keccak256(ix_data)); every validator
 // derives the same address. Only one store wins on-chain (Anchor `init` dedups);
@@ -599,26 +742,52 @@ func (tb *TxBuilder) broadcastRefRoute(
 	}
 
 	if tb.storedPDAExists(ctx, storedPDA) {
-		refHash, err := tb.rpcClient.BroadcastTransaction(ctx, refTx)
-		if err != nil {
-			return "", fmt.Errorf("failed to broadcast finalize_universal_tx_with_ix_data_ref: %w", err)
-		}
-		tb.logger.Info().
-			Str("tx_hash", refHash).
-			Str("stored_pda", storedPDA.String()).
-			Msg("ref-finalize broadcast successfully")
-		return refHash, nil
+		return tb.broadcastRefFinalize(ctx, refTx, storedPDA)
 	}
 
+	if err := tb.checkRelayerBalance(ctx, storeTx); err != nil {
+		return "", err
+	}
 	storeHash, broadcastErr := tb.rpcClient.BroadcastTransaction(ctx, storeTx)
+	if errors.Is(broadcastErr, common.ErrBroadcastSkipped) {
+		tb.logger.Info().
+			Str("store_tx_hash", storeHash).
+			Str("stored_pda", storedPDA.String()).
+			Msg("dry run: store_execute_ix_data skipped, dry-running finalize")
+		refHash, refErr := tb.broadcastRefFinalize(ctx, refTx, storedPDA)
+		if refErr != nil && !errors.Is(refErr, common.ErrBroadcastSkipped) {
+			return storeHash, refErr
+		}
+		return refHash, refErr
+	}
 	if broadcastErr != nil {
-		return "", fmt.Errorf("failed to broadcast store_execute_ix_data: %w", broadcastErr)
+		return storeHash, fmt.Errorf("failed to broadcast store_execute_ix_data: %w", broadcastErr)
 	}
 	tb.logger.Info().
 		Str("store_tx_hash", storeHash).
 		Str("stored_pda", storedPDA.String()).
 		Msg("store_execute_ix_data broadcast; finalize deferred to next tick")
-	return "", fmt.Errorf("store_execute_ix_data broadcast; finalize will be attempted on next broadcaster tick")
+	return storeHash, fmt.Errorf("store_execute_ix_data broadcast; finalize will be attempted on next broadcaster tick")
+}
+
+// broadcastRefFinalize broadcasts the finalize step of the ref route. A dry
+// run returns the finalize hash with common.ErrBroadcastSkipped unwrapped.
+func (tb *TxBuilder) broadcastRefFinalize(ctx context.Context, refTx *solana.Transaction, storedPDA solana.PublicKey) (string, error) {
+	if err := tb.checkRelayerBalance(ctx, refTx); err != nil {
+		return "", err
+	}
+	refHash, err := tb.rpcClient.BroadcastTransaction(ctx, refTx)
+	if errors.Is(err, common.ErrBroadcastSkipped) {
+		return refHash, err
+	}
+	if err != nil {
+		return refHash, fmt.Errorf("failed to broadcast finalize_universal_tx_with_ix_data_ref: %w", err)
+	}
+	tb.logger.Info().
+		Str("tx_hash", refHash).
+		Str("stored_pda", storedPDA.String()).
+		Msg("ref-finalize broadcast successfully")
+	return refHash, nil
 }
 
 // fetchAddressTables fetches Address Lookup Table state for V0 transactions.
@@ -682,15 +851,20 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		return nil, 0, fmt.Errorf("signature must be 65 bytes [r(32)|s(32)|v(1)], got %d", len(signature))
 	}
 
-	// DKLS TSS produces [r(32)|s(32)|v(1)] — extract recovery ID and use r||s for the instruction
+	// DKLS TSS produces [r(32)|s(32)|v(1)] — normalize to low-S, then extract
+	// the recovery ID and use r||s for the instruction
+	signature, err := common.NormalizeLowS(signature)
+	if err != nil {
+		return nil, 0, err
+	}
 	recoveryID := signature[64]
 	signature = signature[:64]
 
-	// Load the relayer's Solana keypair from disk.
+	// Load the relayer's Solana signer (key file or keyring).
 	// The relayer is the entity that pays for Solana transaction fees (gas).
 	// Its Ed25519 signature authorizes the Solana transaction itself.
 	// (This is separate from the TSS secp256k1 signature that authorizes the cross-chain operation.)
-	relayerKeypair, err := tb.loadRelayerKeypair()
+	relayerSigner, err := tb.loadRelayerSigner()
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to load relayer keypair: %w", err)
 	}
@@ -776,10 +950,8 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		recipientPubkey = solana.PublicKeyFromBytes(hexBytes)
 	}
 
-	revertMsgBytes, err := hex.DecodeString(removeHexPrefix(data.RevertMsg))
-	if err != nil {
-		revertMsgBytes = []byte{}
-	}
+	// Must match the bytes bound into the TSS message by GetOutboundSigningRequest.
+	revertMsgBytes := decodeRevertMsg(data.RevertMsg, isNative)
 
 	// --- Determine instruction ID and decode payload ---
 	var instructionID uint8
@@ -802,10 +974,14 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 				return nil, 0, fmt.Errorf("failed to decode payload hex: %w", decErr)
 			}
 			if len(payloadBytes) > 0 {
-				execAccounts, ixData, instructionID, _, err = decodePayload(payloadBytes)
+				var targetProgram [32]byte
+				execAccounts, ixData, instructionID, targetProgram, err = decodePayload(payloadBytes)
 				if err != nil {
 					return nil, 0, fmt.Errorf("failed to decode payload: %w", err)
 				}
+				tb.logger.Debug().Str("tx_id", data.TxID).
+					Object("payload", newPayloadView(execAccounts, ixData, instructionID, targetProgram)).
+					Msg("decoded outbound payload")
 			}
 		}
 
@@ -846,6 +1022,12 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		return nil, 0, fmt.Errorf("failed to derive fee_vault PDA: %w", err)
 	}
 
+	// Confirm the signer's v byte against the TSS address on-chain.
+	recoveryID, err = tb.resolveRecoveryID(ctx, tssPDA, req, signature, recoveryID)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// --- Build instruction data and accounts list ---
 	var instructionData []byte
 	var accounts []*solana.AccountMeta
@@ -879,7 +1061,7 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		)
 
 		accounts = tb.buildWithdrawAndExecuteAccounts(
-			relayerKeypair.PublicKey(),
+			relayerSigner.PublicKey(),
 			configPDA, vaultPDA, ceaAuthorityPDA, tssPDA, executedTxPDA,
 			targetProgram,
 			isNative, instructionID,
@@ -887,6 +1069,11 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 			execAccounts,
 			solana.PublicKey{}, solana.PublicKey{}, // direct route: None sentinels for stored_ix_data + store_refund_recipient
 		)
+		if instructionID == 2 {
+			if err := validateWritableFlags(writableFlags, accounts[len(accounts)-len(execAccounts):]); err != nil {
+				return nil, 0, err
+			}
+		}
 
 	case instructionID == 3:
 		// ---- revert_universal_tx (unified for SOL and SPL) ----
@@ -897,7 +1084,7 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		)
 		accounts = tb.buildRevertAccounts(
 			configPDA, vaultPDA, feeVaultPDA, tssPDA, recipientPubkey,
-			executedTxPDA, relayerKeypair.PublicKey(),
+			executedTxPDA, relayerSigner.PublicKey(),
 			isNative, mintPubkey,
 		)
 
@@ -909,7 +1096,7 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		)
 		accounts = tb.buildRescueAccounts(
 			configPDA, vaultPDA, feeVaultPDA, tssPDA, recipientPubkey,
-			executedTxPDA, relayerKeypair.PublicKey(),
+			executedTxPDA, relayerSigner.PublicKey(),
 			isNative, mintPubkey,
 		)
 	}
@@ -926,24 +1113,7 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		instructionData,
 	)
 
-	// Event's gasLimit is a fee parameter (gasFee = gasPrice × gasLimit), not
-	// actual compute units; we always allocate defaultComputeUnitLimit instead.
-	computeLimitIx := tb.buildSetComputeUnitLimitInstruction(defaultComputeUnitLimit)
-
-	// Build the instruction list.
-	instructions := []solana.Instruction{computeLimitIx}
-
 	needsRecipientATA := (instructionID == 1 && !isNative) || ((instructionID == 3 || instructionID == 4) && !isNative)
-	if needsRecipientATA {
-		createATAInstruction := tb.buildCreateATAIdempotentInstruction(
-			relayerKeypair.PublicKey(),
-			recipientPubkey,
-			mintPubkey,
-		)
-		instructions = append(instructions, createATAInstruction)
-	}
-
-	instructions = append(instructions, gatewayInstruction)
 
 	// Get a recent blockhash — Solana uses this instead of nonces for transaction expiry.
 	// Transactions expire after ~60-90 seconds if not confirmed.
@@ -952,29 +1122,50 @@ func (tb *TxBuilder) BuildOutboundTransaction(
 		return nil, 0, fmt.Errorf("failed to get recent blockhash: %w", err)
 	}
 
-	opts := []solana.TransactionOption{solana.TransactionPayer(relayerKeypair.PublicKey())}
+	opts := []solana.TransactionOption{solana.TransactionPayer(relayerSigner.PublicKey())}
 	addressTables, err := tb.fetchAddressTables(ctx, mintPubkey, isNative)
 	if err != nil {
 		tb.logger.Warn().Err(err).Msg("failed to fetch ALTs, falling back to legacy tx")
 	} else if len(addressTables) > 0 {
 		opts = append(opts, solana.TransactionAddressTables(addressTables))
 	}
-	tx, err := solana.NewTransaction(instructions, recentBlockhash, opts...)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to create transaction: %w", err)
-	}
 
-	// Sign the transaction with the relayer's Ed25519 key.
-	// This is the standard Solana transaction signature (NOT the TSS signature).
-	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
-		if key.Equals(relayerKeypair.PublicKey()) {
-			privKey := relayerKeypair
-			return &privKey
+	// assemble builds and relayer-signs the tx with the given compute unit limit.
+	assemble := func(computeUnits uint32) (*solana.Transaction, error) {
+		instructions := []solana.Instruction{tb.buildSetComputeUnitLimitInstruction(computeUnits)}
+		if needsRecipientATA {
+			instructions = append(instructions, tb.buildCreateATAIdempotentInstruction(
+				relayerSigner.PublicKey(),
+				recipientPubkey,
+				mintPubkey,
+			))
 		}
-		return nil
-	})
+		instructions = append(instructions, gatewayInstruction)
+
+		tx, err := solana.NewTransaction(instructions, recentBlockhash, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create transaction: %w", err)
+		}
+
+		// Sign the transaction with the relayer's Ed25519 key.
+		// This is the standard Solana transaction signature (NOT the TSS signature).
+		if err := signAsRelayer(tx, relayerSigner); err != nil {
+			return nil, fmt.Errorf("failed to sign transaction: %w", err)
+		}
+		return tx, nil
+	}
+
+	// Event's gasLimit is a fee parameter (gasFee = gasPrice × gasLimit), not
+	// actual compute units; we allocate the configured budget instead, or a
+	// simulated one when a margin is configured.
+	tx, err := assemble(tb.computeUnits)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to sign transaction: %w", err)
+		return nil, 0, err
+	}
+	if units, ok := tb.simulatedComputeUnits(ctx, tx); ok && units != tb.computeUnits {
+		if tx, err = assemble(units); err != nil {
+			return nil, 0, err
+		}
 	}
 
 	// Warn if transaction exceeds Solana's raw tx limit.
@@ -1032,10 +1223,14 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("signature must be 65 bytes, got %d", len(signature))
 	}
 
+	signature, err := common.NormalizeLowS(signature)
+	if err != nil {
+		return nil, nil, solana.PublicKey{}, err
+	}
 	recoveryID := signature[64]
 	signature = signature[:64]
 
-	relayerKeypair, err := tb.loadRelayerKeypair()
+	relayerSigner, err := tb.loadRelayerSigner()
 	if err != nil {
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to load relayer keypair: %w", err)
 	}
@@ -1124,10 +1319,14 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 			return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to decode payload hex: %w", decErr)
 		}
 		if len(payloadBytes) > 0 {
-			execAccounts, ixData, instructionID, _, err = decodePayload(payloadBytes)
+			var targetProgram [32]byte
+			execAccounts, ixData, instructionID, targetProgram, err = decodePayload(payloadBytes)
 			if err != nil {
 				return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to decode payload: %w", err)
 			}
+			tb.logger.Debug().Str("tx_id", data.TxID).
+				Object("payload", newPayloadView(execAccounts, ixData, instructionID, targetProgram)).
+				Msg("decoded outbound payload")
 		}
 	}
 	if instructionID != 2 {
@@ -1158,6 +1357,10 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 	if err != nil {
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to derive executed_tx PDA: %w", err)
 	}
+	recoveryID, err = tb.resolveRecoveryID(ctx, tssPDA, req, signature, recoveryID)
+	if err != nil {
+		return nil, nil, solana.PublicKey{}, err
+	}
 	ceaAuthorityPDA, _, err := solana.FindProgramAddress([][]byte{ceaAuthoritySeed, sender[:]}, tb.gatewayAddress)
 	if err != nil {
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to derive cea_authority PDA: %w", err)
@@ -1177,7 +1380,7 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 	//     race), the contract enforces store_refund_recipient.key() == stored
 	//     value, so we must echo whatever's already stored — not our own key.
 	//   - Otherwise we'll be the one creating the PDA, so our relayer is right.
-	storeRefundRecipient := relayerKeypair.PublicKey()
+	storeRefundRecipient := relayerSigner.PublicKey()
 	if existing, _ := tb.rpcClient.GetAccountData(ctx, storedIxDataPDA); len(existing) >= storedIxDataRefundRecipientOffset+32 {
 		copy(storeRefundRecipient[:], existing[storedIxDataRefundRecipientOffset:storedIxDataRefundRecipientOffset+32])
 	}
@@ -1190,24 +1393,18 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 	}
 
 	storeData := tb.buildStoreIxDataData(txID, ixDataHash, ixData)
-	storeAccounts := tb.buildStoreIxDataAccounts(relayerKeypair.PublicKey(), storedIxDataPDA)
+	storeAccounts := tb.buildStoreIxDataAccounts(relayerSigner.PublicKey(), storedIxDataPDA)
 	storeInstruction := solana.NewInstruction(tb.gatewayAddress, storeAccounts, storeData)
 
 	storeTx, err := solana.NewTransaction(
 		[]solana.Instruction{storeInstruction},
 		recentBlockhash,
-		solana.TransactionPayer(relayerKeypair.PublicKey()),
+		solana.TransactionPayer(relayerSigner.PublicKey()),
 	)
 	if err != nil {
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to create store tx: %w", err)
 	}
-	if _, err := storeTx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
-		if key.Equals(relayerKeypair.PublicKey()) {
-			priv := relayerKeypair
-			return &priv
-		}
-		return nil
-	}); err != nil {
+	if err := signAsRelayer(storeTx, relayerSigner); err != nil {
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to sign store tx: %w", err)
 	}
 
@@ -1225,7 +1422,7 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 	)
 
 	refAccounts := tb.buildWithdrawAndExecuteAccounts(
-		relayerKeypair.PublicKey(),
+		relayerSigner.PublicKey(),
 		configPDA, vaultPDA, ceaAuthorityPDA, tssPDA, executedTxPDA,
 		recipientPubkey, // destination_program (target of CPI)
 		isNative, 2,     // execute
@@ -1233,20 +1430,23 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 		execAccounts,
 		storedIxDataPDA, storeRefundRecipient, // ref route: real values
 	)
+	if err := validateWritableFlags(writableFlags, refAccounts[len(refAccounts)-len(execAccounts):]); err != nil {
+		return nil, nil, solana.PublicKey{}, err
+	}
 
 	refInstruction := solana.NewInstruction(tb.gatewayAddress, refAccounts, refInstructionData)
-	computeLimitIx := tb.buildSetComputeUnitLimitInstruction(defaultComputeUnitLimit)
+	computeLimitIx := tb.buildSetComputeUnitLimitInstruction(tb.computeUnits)
 
 	instructions := []solana.Instruction{computeLimitIx}
 	needsRecipientATA := !isNative && false // execute mode (id=2) doesn't create recipient ATA; gateway handles cea_ata internally
 	if needsRecipientATA {
 		instructions = append(instructions, tb.buildCreateATAIdempotentInstruction(
-			relayerKeypair.PublicKey(), recipientPubkey, mintPubkey,
+			relayerSigner.PublicKey(), recipientPubkey, mintPubkey,
 		))
 	}
 	instructions = append(instructions, refInstruction)
 
-	refOpts := []solana.TransactionOption{solana.TransactionPayer(relayerKeypair.PublicKey())}
+	refOpts := []solana.TransactionOption{solana.TransactionPayer(relayerSigner.PublicKey())}
 	addressTables, altErr := tb.fetchAddressTables(ctx, mintPubkey, isNative)
 	if altErr != nil {
 		tb.logger.Warn().Err(altErr).Msg("failed to fetch ALTs for ref-finalize, falling back to legacy tx")
@@ -1257,13 +1457,7 @@ func (tb *TxBuilder) BuildRefRouteTransactions(
 	if err != nil {
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to create ref-finalize tx: %w", err)
 	}
-	if _, err := refTx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
-		if key.Equals(relayerKeypair.PublicKey()) {
-			priv := relayerKeypair
-			return &priv
-		}
-		return nil
-	}); err != nil {
+	if err := signAsRelayer(refTx, relayerSigner); err != nil {
 		return nil, nil, solana.PublicKey{}, fmt.Errorf("failed to sign ref-finalize tx: %w", err)
 	}
 
@@ -1282,6 +1476,36 @@ func removeHexPrefix(s string) string {
 	return s
 }
 
+// decodeRevertMsg decodes the hex revert message, treating a decode failure as
+// empty, and clamps it to the revert_msg cap for SOL or SPL reverts. The
+// signing request and the instruction data both go through here so the
+// keccak256(revert_msg) bound into the TSS message matches what the gateway
+// hashes on-chain.
+func decodeRevertMsg(revertMsgHex string, isNative bool) []byte {
+	decoded, err := hex.DecodeString(removeHexPrefix(revertMsgHex))
+	if err != nil {
+		return []byte{}
+	}
+	limit := maxRevertMsgLenSPL
+	if isNative {
+		limit = maxRevertMsgLenSOL
+	}
+	return clampRevertMsg(decoded, limit)
+}
+
+// clampRevertMsg truncates msg to at most limit bytes, ending it with
+// revertMsgTruncatedMarker when there is room for it.
+func clampRevertMsg(msg []byte, limit int) []byte {
+	if len(msg) <= limit {
+		return msg
+	}
+	if limit <= len(revertMsgTruncatedMarker) {
+		return msg[:limit]
+	}
+	keep := limit - len(revertMsgTruncatedMarker)
+	return append(append(make([]byte, 0, limit), msg[:keep]...), revertMsgTruncatedMarker...)
+}
+
 // =============================================================================
 //  PDA Derivation & On-Chain Data
 // =============================================================================
@@ -1520,15 +1744,11 @@ func parseTxType(txTypeStr string) (uetypes.TxType, error) {
 //  Relayer Keypair
 // =============================================================================
 
-// loadRelayerKeypair loads the Solana relayer keypair from disk.
-//
-// The file is a JSON array of 64 bytes (Solana's standard keypair format):
-//   - First 32 bytes: Ed25519 private key seed
-//   - Last 32 bytes: Ed25519 public key
-//
-// Located at: <nodeHome>/relayer/solana.json
+// loadRelayerSigner resolves the Solana relayer signer for this chain's
+// namespace through the configured RelayerKeyLoader (by default the key file
+// <nodeHome>/relayer/solana.json).
 // The relayer pays for Solana transaction fees and signs the transaction envelope.
-func (tb *TxBuilder) loadRelayerKeypair() (solana.PrivateKey, error) {
+func (tb *TxBuilder) loadRelayerSigner() (RelayerSigner, error) {
 	chainParts := strings.Split(tb.chainID, ":")
 	if len(chainParts) == 0 {
 		return nil, fmt.Errorf("invalid chain ID format: %s", tb.chainID)
@@ -1538,25 +1758,13 @@ func (tb *TxBuilder) loadRelayerKeypair() (solana.PrivateKey, error) {
 		return nil, fmt.Errorf("empty namespace in chain ID: %s", tb.chainID)
 	}
 
-	keyPath := filepath.Join(tb.nodeHome, config.RelayerSubdir, namespace+".json")
-
-	keyData, err := os.ReadFile(keyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read relayer key file %s: %w", keyPath, err)
-	}
-
-	var keyBytes []byte
-	if err := json.Unmarshal(keyData, &keyBytes); err != nil {
-		return nil, fmt.Errorf("failed to parse key file as JSON array: %w", err)
-	}
-
-	if len(keyBytes) != 64 {
-		return nil, fmt.Errorf("invalid key length: expected 64 bytes, got %d", len(keyBytes))
-	}
-
-	privateKey := solana.PrivateKey(keyBytes)
+	return tb.relayerKeys.LoadRelayerSigner(namespace)
+}
 
-	return privateKey, nil
+// SetRelayerKeyLoader replaces how the relayer signer is resolved, e.g. with
+// an HSM-backed RelayerSigner.
+func (tb *TxBuilder) SetRelayerKeyLoader(loader RelayerKeyLoader) {
+	tb.relayerKeys = loader
 }
 
 // =============================================================================
@@ -1669,6 +1877,82 @@ func accountsToWritableFlags(accounts []GatewayAccountMeta) []byte {
 	return flags
 }
 
+// validateWritableFlags checks the signed writable flags against the
+// remaining accounts the finalize instruction actually passes: ceil(n/8)
+// bytes, each account's bit matching its meta and the padding bits of the
+// last byte clear. The gateway checks the same bits against the remaining
+// accounts on chain, so a mismatch here would only surface as a failed tx.
+func validateWritableFlags(flags []byte, accounts []*solana.AccountMeta) error {
+	if want := (len(accounts) + 7) / 8; len(flags) != want {
+		return fmt.Errorf("writable flags cover %d bytes, need %d for %d accounts", len(flags), want, len(accounts))
+	}
+	for i := 0; i < len(flags)*8; i++ {
+		set := flags[i/8]&(1<<uint(7-i%8)) != 0
+		switch {
+		case i >= len(accounts):
+			if set {
+				return fmt.Errorf("writable flag bit %d set beyond %d accounts", i, len(accounts))
+			}
+		case set != accounts[i].IsWritable:
+			return fmt.Errorf("writable flag bit %d is %t, account %d is writable=%t", i, set, i, accounts[i].IsWritable)
+		}
+	}
+	return nil
+}
+
+// payloadView is a readable view of a decoded payload for logs: which accounts
+// an execute outbound touches and with what permissions.
+type payloadView struct {
+	instructionID uint8
+	targetProgram [32]byte
+	accounts      []GatewayAccountMeta
+	ixDataLen     int
+}
+
+func newPayloadView(accounts []GatewayAccountMeta, ixData []byte, instructionID uint8, targetProgram [32]byte) payloadView {
+	return payloadView{
+		instructionID: instructionID,
+		targetProgram: targetProgram,
+		accounts:      accounts,
+		ixDataLen:     len(ixData),
+	}
+}
+
+// String renders the view as
+// "instruction_id=2 target_program=<pubkey> ix_data_len=N accounts=[<pubkey>:w <pubkey>:r]".
+func (v payloadView) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "instruction_id=%d target_program=%s ix_data_len=%d accounts=[",
+		v.instructionID, solana.PublicKeyFromBytes(v.targetProgram[:]), v.ixDataLen)
+	for i, acc := range v.accounts {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(solana.PublicKeyFromBytes(acc.Pubkey[:]).String())
+		if acc.IsWritable {
+			b.WriteString(":w")
+		} else {
+			b.WriteString(":r")
+		}
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// MarshalZerologObject implements zerolog.LogObjectMarshaler.
+func (v payloadView) MarshalZerologObject(e *zerolog.Event) {
+	accounts := zerolog.Arr()
+	for _, acc := range v.accounts {
+		accounts.Dict(zerolog.Dict().
+			Str("pubkey", solana.PublicKeyFromBytes(acc.Pubkey[:]).String()).
+			Bool("writable", acc.IsWritable))
+	}
+	e.Uint8("instruction_id", v.instructionID).
+		Str("target_program", solana.PublicKeyFromBytes(v.targetProgram[:]).String()).
+		Int("ix_data_len", v.ixDataLen).
+		Array("accounts", accounts)
+}
+
 // =============================================================================
 //  Anchor Discriminator
 // =============================================================================
@@ -2140,6 +2424,43 @@ func (tb *TxBuilder) buildRescueAccounts(
 //  Compute Budget
 // =============================================================================
 
+// simulatedComputeUnits simulates tx and returns the compute unit limit to
+// request instead of the configured one: units consumed plus cuMarginPercent.
+// Reports false when no margin is configured or the simulation fails or
+// reports no consumption, in which case the configured limit stands.
+func (tb *TxBuilder) simulatedComputeUnits(ctx context.Context, tx *solana.Transaction) (uint32, bool) {
+	if tb.cuMarginPercent <= 0 {
+		return 0, false
+	}
+	result, err := tb.rpcClient.SimulateTransaction(ctx, tx)
+	switch {
+	case err != nil:
+		tb.logger.Debug().Err(err).Msg("compute unit simulation failed, using configured limit")
+		return 0, false
+	case result.Err != nil:
+		tb.logger.Debug().Interface("sim_err", result.Err).Msg("compute unit simulation errored, using configured limit")
+		return 0, false
+	case result.UnitsConsumed == nil || *result.UnitsConsumed == 0:
+		return 0, false
+	}
+	units := computeUnitsWithMargin(*result.UnitsConsumed, tb.cuMarginPercent)
+	tb.logger.Debug().
+		Uint64("units_consumed", *result.UnitsConsumed).
+		Uint32("compute_unit_limit", units).
+		Msg("sized compute unit limit from simulation")
+	return units, true
+}
+
+// computeUnitsWithMargin returns consumed plus marginPercent, capped at
+// Solana's per-tx limit.
+func computeUnitsWithMargin(consumed uint64, marginPercent int) uint32 {
+	units := consumed * uint64(100+marginPercent) / 100
+	if units > maxComputeUnitLimit {
+		units = maxComputeUnitLimit
+	}
+	return uint32(units)
+}
+
 // buildSetComputeUnitLimitInstruction creates a Solana Compute Budget instruction
 // that tells the runtime how many compute units to allocate for this transaction.
 //
@@ -1,16 +1,21 @@
 package svm
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	crand "crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -938,6 +943,117 @@ func TestAccountsToWritableFlags(t *testing.T) {
 	})
 }
 
+func TestValidateWritableFlags(t *testing.T) {
+	metas := func(writable ...bool) []*solana.AccountMeta {
+		accs := make([]*solana.AccountMeta, len(writable))
+		for i, w := range writable {
+			accs[i] = &solana.AccountMeta{IsWritable: w}
+		}
+		return accs
+	}
+
+	t.Run("flags match the account metas", func(t *testing.T) {
+		for _, tc := range []struct {
+			flags []byte
+			accs  []*solana.AccountMeta
+		}{
+			{[]byte{}, nil},
+			{[]byte{0x80}, metas(true)},
+			{[]byte{0xA0}, metas(true, false, true, false, false, false, false, false)},
+			{[]byte{0x80, 0x80}, metas(true, false, false, false, false, false, false, false, true)},
+		} {
+			assert.NoError(t, validateWritableFlags(tc.flags, tc.accs), "flags %x", tc.flags)
+		}
+	})
+
+	t.Run("too few bytes", func(t *testing.T) {
+		accs := metas(true, false, false, false, false, false, false, false, true)
+		assert.ErrorContains(t, validateWritableFlags([]byte{0x80}, accs), "need 2 for 9 accounts")
+	})
+
+	t.Run("too many bytes", func(t *testing.T) {
+		assert.Error(t, validateWritableFlags([]byte{0x80, 0x00}, metas(true)))
+	})
+
+	t.Run("flags for empty accounts", func(t *testing.T) {
+		assert.Error(t, validateWritableFlags([]byte{0x00}, nil))
+	})
+
+	t.Run("padding bit set", func(t *testing.T) {
+		// 2 accounts use bits 7 and 6; bit 5 is padding.
+		assert.ErrorContains(t, validateWritableFlags([]byte{0xA0}, metas(true, false)), "beyond 2 accounts")
+	})
+
+	t.Run("bit disagrees with account", func(t *testing.T) {
+		assert.ErrorContains(t, validateWritableFlags([]byte{0x40}, metas(true, false)), "bit 0")
+	})
+
+	t.Run("instruction remaining accounts carry the payload flags", func(t *testing.T) {
+		builder := newTestBuilder(t)
+		execAccounts := []GatewayAccountMeta{
+			{Pubkey: makeTxID(0x11), IsWritable: true},
+			{Pubkey: makeTxID(0x22), IsWritable: false},
+			{Pubkey: makeTxID(0x33), IsWritable: true},
+		}
+		pk := solana.PublicKey(makeTxID(0x44))
+		accounts := builder.buildWithdrawAndExecuteAccounts(
+			pk, pk, pk, pk, pk, pk, pk,
+			true, 2,
+			pk, solana.PublicKey{},
+			execAccounts,
+			solana.PublicKey{}, solana.PublicKey{},
+		)
+		remaining := accounts[len(accounts)-len(execAccounts):]
+		require.NoError(t, validateWritableFlags([]byte{0xA0}, remaining))
+		assert.Error(t, validateWritableFlags([]byte{0xE0}, remaining), "read-only account flagged writable")
+	})
+}
+
+func TestPayloadView(t *testing.T) {
+	w := makeTxID(0x11)
+	r := makeTxID(0x22)
+	target := makeTxID(0xDD)
+	payload := buildMockPayload([]GatewayAccountMeta{
+		{Pubkey: w, IsWritable: true},
+		{Pubkey: r, IsWritable: false},
+	}, []byte{0xAA, 0xBB, 0xCC}, 2, target)
+	accounts, ixData, instructionID, targetProgram, err := decodePayload(payload)
+	require.NoError(t, err)
+
+	view := newPayloadView(accounts, ixData, instructionID, targetProgram)
+	wKey := solana.PublicKeyFromBytes(w[:]).String()
+	rKey := solana.PublicKeyFromBytes(r[:]).String()
+	targetKey := solana.PublicKeyFromBytes(target[:]).String()
+
+	assert.Equal(t,
+		"instruction_id=2 target_program="+targetKey+" ix_data_len=3 accounts=["+wKey+":w "+rKey+":r]",
+		view.String())
+
+	var buf bytes.Buffer
+	log := zerolog.New(&buf)
+	log.Info().Object("payload", view).Send()
+	var logged struct {
+		Payload struct {
+			InstructionID uint8  `json:"instruction_id"`
+			TargetProgram string `json:"target_program"`
+			IxDataLen     int    `json:"ix_data_len"`
+			Accounts      []struct {
+				Pubkey   string `json:"pubkey"`
+				Writable bool   `json:"writable"`
+			} `json:"accounts"`
+		} `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &logged))
+	assert.Equal(t, uint8(2), logged.Payload.InstructionID)
+	assert.Equal(t, targetKey, logged.Payload.TargetProgram)
+	assert.Equal(t, 3, logged.Payload.IxDataLen)
+	require.Len(t, logged.Payload.Accounts, 2)
+	assert.Equal(t, wKey, logged.Payload.Accounts[0].Pubkey)
+	assert.True(t, logged.Payload.Accounts[0].Writable)
+	assert.Equal(t, rKey, logged.Payload.Accounts[1].Pubkey)
+	assert.False(t, logged.Payload.Accounts[1].Writable)
+}
+
 func TestBuildWithdrawAndExecuteData(t *testing.T) {
 	builder := newTestBuilder(t)
 	txID := makeTxID(0x01)
@@ -1362,6 +1478,71 @@ func TestParseTxType(t *testing.T) {
 	}
 }
 
+func TestComputeUnitsWithMargin(t *testing.T) {
+	assert.Equal(t, uint32(120_000), computeUnitsWithMargin(100_000, 20))
+	assert.Equal(t, uint32(150_500), computeUnitsWithMargin(143_334, 5))
+	// Capped at Solana's per-tx limit.
+	assert.Equal(t, uint32(maxComputeUnitLimit), computeUnitsWithMargin(1_300_000, 20))
+}
+
+// simulationServer answers simulateTransaction with result (the "value" object).
+func simulationServer(t *testing.T, result string) *RPCClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		reply := `null`
+		switch req.Method {
+		case "getHealth":
+			reply = `"ok"`
+		case "simulateTransaction":
+			reply = `{"context":{"slot":1},"value":` + result + `}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,"result":` + reply + `}`))
+	}))
+	t.Cleanup(server.Close)
+	rc, err := NewRPCClient([]string{server.URL}, "", zerolog.Nop())
+	require.NoError(t, err)
+	return rc
+}
+
+func TestSimulatedComputeUnits(t *testing.T) {
+	payer := solana.NewWallet().PublicKey()
+	tx := feeTestTx(t, payer)
+
+	builder := func(t *testing.T, marginPercent int, result string) *TxBuilder {
+		b := newTestBuilder(t)
+		b.cuMarginPercent = marginPercent
+		b.rpcClient = simulationServer(t, result)
+		return b
+	}
+
+	t.Run("consumed units plus margin", func(t *testing.T) {
+		units, ok := builder(t, 20, `{"err":null,"logs":[],"unitsConsumed":50000}`).simulatedComputeUnits(context.Background(), tx)
+		assert.True(t, ok)
+		assert.Equal(t, uint32(60_000), units)
+	})
+
+	t.Run("disabled without margin", func(t *testing.T) {
+		_, ok := builder(t, 0, `{"err":null,"logs":[],"unitsConsumed":50000}`).simulatedComputeUnits(context.Background(), tx)
+		assert.False(t, ok)
+	})
+
+	t.Run("failed simulation falls back", func(t *testing.T) {
+		_, ok := builder(t, 20, `{"err":{"InstructionError":[0,"Custom: 1"]},"logs":[],"unitsConsumed":1200}`).simulatedComputeUnits(context.Background(), tx)
+		assert.False(t, ok)
+	})
+
+	t.Run("no units reported falls back", func(t *testing.T) {
+		_, ok := builder(t, 20, `{"err":null,"logs":[]}`).simulatedComputeUnits(context.Background(), tx)
+		assert.False(t, ok)
+	})
+}
+
 func TestBuildSetComputeUnitLimitInstruction(t *testing.T) {
 	builder := newTestBuilder(t)
 	ix := builder.buildSetComputeUnitLimitInstruction(300000)
@@ -1681,11 +1862,58 @@ func TestNewTxBuilder_ChainConfig(t *testing.T) {
 		assert.Len(t, builder.tokenALTs, 0)
 	})
 
+	t.Run("high-value confirmation policy", func(t *testing.T) {
+		const usdcMint = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+		confs := 64
+		cfg := &config.ChainSpecificConfig{
+			HighValueThresholds:    map[string]string{"native": "1000000000", usdcMint: "1000000"},
+			HighValueConfirmations: &confs,
+		}
+		builder, err := NewTxBuilder(&RPCClient{}, "solana:devnet", testGatewayAddress, "/tmp", logger, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), builder.RequiredConfirmations(&uetypes.OutboundCreatedEvent{Amount: "999999999"}, 0))
+		assert.Equal(t, uint64(64), builder.RequiredConfirmations(&uetypes.OutboundCreatedEvent{Amount: "1000000000"}, 0))
+		assert.Equal(t, uint64(64), builder.RequiredConfirmations(&uetypes.OutboundCreatedEvent{AssetAddr: usdcMint, Amount: "1000000"}, 0), "SPL token uses its own threshold")
+	})
+
+	t.Run("invalid high-value threshold keeps the default", func(t *testing.T) {
+		confs := 64
+		cfg := &config.ChainSpecificConfig{
+			HighValueThresholds:    map[string]string{"native": "1 SOL"},
+			HighValueConfirmations: &confs,
+		}
+		builder, err := NewTxBuilder(&RPCClient{}, "solana:devnet", testGatewayAddress, "/tmp", logger, cfg)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), builder.RequiredConfirmations(&uetypes.OutboundCreatedEvent{Amount: "5000000000"}, 0))
+	})
+
 	t.Run("nil chainConfig is fine", func(t *testing.T) {
 		builder, err := NewTxBuilder(&RPCClient{}, "solana:devnet", testGatewayAddress, "/tmp", logger, nil)
 		require.NoError(t, err)
 		assert.True(t, builder.protocolALT.IsZero())
 		assert.Len(t, builder.tokenALTs, 0)
+		assert.Equal(t, defaultComputeUnitLimit, builder.computeUnits)
+	})
+
+	t.Run("compute unit limit override", func(t *testing.T) {
+		cu := 600_000
+		builder, err := NewTxBuilder(&RPCClient{}, "solana:devnet", testGatewayAddress, "/tmp", logger, &config.ChainSpecificConfig{ComputeUnitLimit: &cu})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(600_000), builder.computeUnits)
+
+		ix := builder.buildSetComputeUnitLimitInstruction(builder.computeUnits)
+		data, err := ix.Data()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(600_000), binary.LittleEndian.Uint32(data[1:5]))
+	})
+
+	t.Run("compute unit limit unset or out of range uses default", func(t *testing.T) {
+		ptr := func(v int) *int { return &v }
+		for _, cu := range []*int{nil, ptr(0), ptr(-1), ptr(maxComputeUnitLimit + 1)} {
+			builder, err := NewTxBuilder(&RPCClient{}, "solana:devnet", testGatewayAddress, "/tmp", logger, &config.ChainSpecificConfig{ComputeUnitLimit: cu})
+			require.NoError(t, err)
+			assert.Equal(t, defaultComputeUnitLimit, builder.computeUnits)
+		}
 	})
 }
 
@@ -1965,7 +2193,7 @@ func TestBuildWithdrawAndExecuteAccounts_RefRouteSlots(t *testing.T) {
 }
 
 // newTestBuilderWithKeypair returns a TxBuilder whose nodeHome contains a
-// valid relayer keypair on disk, so loadRelayerKeypair() succeeds in unit
+// valid relayer keypair on disk, so loadRelayerSigner() succeeds in unit
 // tests that never touch the network. The embedded RPCClient is still a
 // zero-value stub — any code path that actually calls RPC will panic, which
 // is intentional: it forces validation tests to fail *before* they reach RPC.
@@ -2119,6 +2347,83 @@ func TestBuildRefRouteTransactions_Validation(t *testing.T) {
 	})
 }
 
+// refRouteServer answers the RPC calls of a ref-route broadcast with the
+// stored_ix_data PDA absent, counting sendTransaction calls.
+func refRouteServer(t *testing.T, sends *atomic.Int32) *RPCClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		reply := `"result":null`
+		switch req.Method {
+		case "getHealth":
+			reply = `"result":"ok"`
+		case "getAccountInfo":
+			reply = `"result":{"context":{"slot":1},"value":null}`
+		case "getLatestBlockhash":
+			reply = `"result":{"context":{"slot":1},"value":{"blockhash":"` + solana.Hash{1}.String() + `","lastValidBlockHeight":100}}`
+		case "sendTransaction":
+			sends.Add(1)
+			reply = `"result":"` + solana.Signature{}.String() + `"`
+		default:
+			reply = `"error":{"code":-32601,"message":"method not found"}`
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(req.ID) + `,` + reply + `}`))
+	}))
+	t.Cleanup(server.Close)
+	rc, err := NewRPCClient([]string{server.URL}, "", zerolog.Nop())
+	require.NoError(t, err)
+	return rc
+}
+
+func TestBroadcastRefRoute_StorePath(t *testing.T) {
+	target := makeTxID(0x99)
+	payload := buildExecutePayloadForTest(t, []GatewayAccountMeta{}, []byte{0xDE, 0xAD, 0xBE, 0xEF}, 2, target)
+	recoveryID := byte(0)
+
+	setup := func(t *testing.T, dryRun bool) (*TxBuilder, *atomic.Int32) {
+		builder := newTestBuilderWithKeypair(t)
+		sends := &atomic.Int32{}
+		builder.rpcClient = refRouteServer(t, sends)
+		builder.rpcClient.SetDryRun(dryRun)
+		return builder, sends
+	}
+	newReq := func() *common.UnsignedSigningReq {
+		return &common.UnsignedSigningReq{SigningHash: make([]byte, 32), RecoveryID: &recoveryID}
+	}
+
+	t.Run("store broadcast returns the store hash", func(t *testing.T) {
+		builder, sends := setup(t, false)
+		ctx := context.Background()
+		data := newBaseRefRouteEvent(t, payload)
+		storeTx, _, _, err := builder.BuildRefRouteTransactions(ctx, newReq(), data, make([]byte, 65))
+		require.NoError(t, err)
+
+		hash, err := builder.broadcastRefRoute(ctx, newReq(), data, make([]byte, 65))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "finalize will be attempted on next broadcaster tick")
+		require.Equal(t, storeTx.Signatures[0].String(), hash)
+		require.Equal(t, int32(1), sends.Load())
+	})
+
+	t.Run("dry run skips both steps and returns the finalize hash", func(t *testing.T) {
+		builder, sends := setup(t, true)
+		ctx := context.Background()
+		data := newBaseRefRouteEvent(t, payload)
+		_, refTx, _, err := builder.BuildRefRouteTransactions(ctx, newReq(), data, make([]byte, 65))
+		require.NoError(t, err)
+
+		hash, err := builder.broadcastRefRoute(ctx, newReq(), data, make([]byte, 65))
+		require.ErrorIs(t, err, common.ErrBroadcastSkipped)
+		require.Equal(t, refTx.Signatures[0].String(), hash)
+		require.Zero(t, sends.Load())
+	})
+}
+
 // =============================================================================
 //  Devnet Simulation Tests
 //
@@ -2167,7 +2472,7 @@ func setupDevnetSimulation(t *testing.T) (*RPCClient, *TxBuilder) {
 		t.Skipf("skipping: failed to connect to Devnet RPC: %v", err)
 	}
 
-	// Write the hardcoded keypair JSON to the temp dir so loadRelayerKeypair can find it.
+	// Write the hardcoded keypair JSON to the temp dir so loadRelayerSigner can find it.
 	tmpDir := t.TempDir()
 	relayerDir := filepath.Join(tmpDir, "relayer")
 	require.NoError(t, os.MkdirAll(relayerDir, 0o755))
@@ -2441,7 +2746,7 @@ func buildAndSimulateRescue(t *testing.T, rpcClient *RPCClient, builder *TxBuild
 	executedTxPDA, _, err := solana.FindProgramAddress([][]byte{[]byte("executed_sub_tx"), txID[:]}, builder.gatewayAddress)
 	require.NoError(t, err)
 
-	relayerKeypair, err := builder.loadRelayerKeypair()
+	relayerKeypair, err := builder.loadRelayerSigner()
 	require.NoError(t, err)
 
 	accounts := builder.buildRescueAccounts(
@@ -2471,14 +2776,7 @@ func buildAndSimulateRescue(t *testing.T, rpcClient *RPCClient, builder *TxBuild
 	)
 	require.NoError(t, err)
 
-	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
-		if key.Equals(relayerKeypair.PublicKey()) {
-			privKey := relayerKeypair
-			return &privKey
-		}
-		return nil
-	})
-	require.NoError(t, err)
+	require.NoError(t, signAsRelayer(tx, relayerKeypair))
 
 	result, err := rpcClient.SimulateTransaction(ctx, tx)
 	require.NoError(t, err, "SimulateTransaction RPC call failed")
@@ -2581,7 +2879,7 @@ func TestSimulate_CloseStoredIxData_MetaShape(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	relayerKey, err := builder.loadRelayerKeypair()
+	relayerKey, err := builder.loadRelayerSigner()
 	require.NoError(t, err)
 
 	// Deterministic fake sub_tx_id + ix_data so the derived PDAs don't exist.
@@ -2614,14 +2912,7 @@ func TestSimulate_CloseStoredIxData_MetaShape(t *testing.T) {
 		solana.TransactionPayer(relayerKey.PublicKey()),
 	)
 	require.NoError(t, err)
-	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
-		if key.Equals(relayerKey.PublicKey()) {
-			priv := relayerKey
-			return &priv
-		}
-		return nil
-	})
-	require.NoError(t, err)
+	require.NoError(t, signAsRelayer(tx, relayerKey))
 
 	sim, err := rpcClient.SimulateTransaction(ctx, tx)
 	require.NoError(t, err)
@@ -2718,3 +3009,107 @@ func TestSimulate_RefRoute_Execute(t *testing.T) {
 	require.NoError(t, err)
 	requireSimulationSuccess(t, storeSim)
 }
+
+func TestRevertMsgTruncation(t *testing.T) {
+	builder := newTestBuilder(t)
+
+	long := []byte(strings.Repeat("insufficient liquidity in pool; ", 20))
+	msg := decodeRevertMsg("0x"+hex.EncodeToString(long), false)
+	require.Len(t, msg, maxRevertMsgLenSPL)
+	keep := maxRevertMsgLenSPL - len(revertMsgTruncatedMarker)
+	assert.Equal(t, long[:keep], msg[:keep])
+	assert.True(t, bytes.HasSuffix(msg, revertMsgTruncatedMarker))
+
+	// The signed hash must be the one the gateway recomputes from the
+	// revert_msg carried in the instruction.
+	recipient := solana.NewWallet().PublicKey()
+	var revertRecipient [32]byte
+	copy(revertRecipient[:], recipient.Bytes())
+	signingHash := func(revertMsg []byte) []byte {
+		h, err := builder.constructTSSMessage(3, "devnet", 1_700_000_000, 1000, makeTxID(1), makeTxID(2),
+			makeSender(3), [32]byte{}, 10, [32]byte{}, nil, nil, revertRecipient, [32]byte{}, revertMsg)
+		require.NoError(t, err)
+		return h
+	}
+
+	data := builder.buildRevertData(makeTxID(1), makeTxID(2), 1000, recipient, msg, 10, 1_700_000_000,
+		make([]byte, 64), 0, make([]byte, 32))
+	msgLen := binary.LittleEndian.Uint32(data[112:116])
+	require.Equal(t, uint32(maxRevertMsgLenSPL), msgLen)
+	inIx := data[116 : 116+msgLen]
+	assert.Equal(t, msg, inIx)
+	assert.Equal(t, signingHash(msg), signingHash(inIx))
+	assert.NotEqual(t, signingHash(long), signingHash(inIx))
+
+	t.Run("short messages are untouched", func(t *testing.T) {
+		assert.Equal(t, []byte("slippage"), decodeRevertMsg(hex.EncodeToString([]byte("slippage")), false))
+	})
+
+	t.Run("SOL reverts keep more than SPL reverts", func(t *testing.T) {
+		assert.Len(t, decodeRevertMsg("0x"+hex.EncodeToString(long), true), maxRevertMsgLenSOL)
+	})
+
+	t.Run("limit below marker size cuts without marker", func(t *testing.T) {
+		assert.Equal(t, []byte("abcd"), clampRevertMsg([]byte("abcdefgh"), 4))
+	})
+}
+
+// TestRevertMsgCapsFitLegacyTx pins the revert_msg caps to the revert tx
+// layout: a capped message fits a legacy tx and one more byte does not.
+func TestRevertMsgCapsFitLegacyTx(t *testing.T) {
+	builder := newTestBuilder(t)
+	newKey := func() solana.PublicKey { return solana.NewWallet().PublicKey() }
+
+	txSize := func(isNative bool, msgLen int) int {
+		payer, recipient, mint := newKey(), newKey(), newKey()
+		data := builder.buildRevertData(makeTxID(1), makeTxID(2), 1000, recipient, make([]byte, msgLen), 10,
+			1_700_000_000, make([]byte, 64), 0, make([]byte, 32))
+		accounts := builder.buildRevertAccounts(newKey(), newKey(), newKey(), newKey(), recipient, newKey(), payer, isNative, mint)
+		instructions := []solana.Instruction{builder.buildSetComputeUnitLimitInstruction(defaultComputeUnitLimit)}
+		if !isNative {
+			instructions = append(instructions, builder.buildCreateATAIdempotentInstruction(payer, recipient, mint))
+		}
+		instructions = append(instructions, solana.NewInstruction(builder.gatewayAddress, accounts, data))
+		tx, err := solana.NewTransaction(instructions, solana.Hash{}, solana.TransactionPayer(payer))
+		require.NoError(t, err)
+		tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+		raw, err := tx.MarshalBinary()
+		require.NoError(t, err)
+		return len(raw)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		isNative bool
+		limit    int
+	}{
+		{"SOL", true, maxRevertMsgLenSOL},
+		{"SPL", false, maxRevertMsgLenSPL},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.LessOrEqual(t, txSize(tc.isNative, tc.limit), solanaTxMaxBytes)
+			assert.Greater(t, txSize(tc.isNative, tc.limit+1), solanaTxMaxBytes)
+		})
+	}
+}
+
+func TestCheckRelayerBalance(t *testing.T) {
+	payer := solana.NewWallet().PublicKey()
+	ata := solana.NewWallet().PublicKey()
+	// 5000 fee + rent for a new 165-byte account (1000 + 10*165).
+	const required = 5000 + 1000 + 1650
+
+	check := func(t *testing.T, balance uint64) error {
+		builder := newTestBuilder(t)
+		builder.rpcClient = feeServer(t, "5000", balance, nil, map[solana.PublicKey]int{ata: 165})
+		return builder.checkRelayerBalance(context.Background(), feeTestTx(t, payer, ata))
+	}
+
+	t.Run("sufficient", func(t *testing.T) {
+		assert.NoError(t, check(t, required))
+	})
+
+	t.Run("insufficient", func(t *testing.T) {
+		assert.ErrorIs(t, check(t, required-1), common.ErrInsufficientRelayerBalance)
+	})
+}
@@ -4,6 +4,7 @@
 // Directory layout:
 //
 //	<NodeHome>/                        (default: ~/.puniversal)
+//	├── puniversald.pid                (held while the node runs)
 //	├── config/
 //	│   └── pushuv_config.json
 //	├── databases/
@@ -19,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 const (
@@ -27,6 +29,7 @@ const (
 	ConfigFileName  = "pushuv_config.json"
 	DatabasesSubdir = "databases"
 	RelayerSubdir   = "relayer"
+	PIDFileName     = "puniversald.pid"
 )
 
 // DefaultNodeHome returns the default node home directory (~/.puniversal).
@@ -125,6 +128,9 @@ func applyDefaults(cfg *Config, defaults *Config) {
 	if len(cfg.ChainConfigs) == 0 {
 		cfg.ChainConfigs = defaults.ChainConfigs
 	}
+	if cfg.DatabaseDriver == "" {
+		cfg.DatabaseDriver = DatabaseDriverSQLite
+	}
 	if cfg.TSSP2PListen == "" {
 		cfg.TSSP2PListen = "/ip4/0.0.0.0/tcp/39000"
 	}
@@ -148,5 +154,41 @@ func validate(cfg *Config) error {
 	if cfg.KeyringBackend != "" && cfg.KeyringBackend != KeyringBackendFile && cfg.KeyringBackend != KeyringBackendTest {
 		return fmt.Errorf("keyring backend must be 'file' or 'test', got: %s", cfg.KeyringBackend)
 	}
+	switch cfg.DatabaseDriver {
+	case "", DatabaseDriverSQLite:
+	case DatabaseDriverPostgres:
+		if cfg.DatabaseDSN == "" {
+			return fmt.Errorf("database_dsn is required when database_driver is 'postgres'")
+		}
+	default:
+		return fmt.Errorf("database driver must be 'sqlite' or 'postgres', got: %s", cfg.DatabaseDriver)
+	}
+	switch strings.ToUpper(cfg.DatabaseSQLite.JournalMode) {
+	case "", "DELETE", "TRUNCATE", "PERSIST", "MEMORY", "WAL", "OFF":
+	default:
+		return fmt.Errorf("database_sqlite.journal_mode must be DELETE, TRUNCATE, PERSIST, MEMORY, WAL or OFF, got: %s", cfg.DatabaseSQLite.JournalMode)
+	}
+	switch strings.ToUpper(cfg.DatabaseSQLite.Synchronous) {
+	case "", "OFF", "NORMAL", "FULL", "EXTRA":
+	default:
+		return fmt.Errorf("database_sqlite.synchronous must be OFF, NORMAL, FULL or EXTRA, got: %s", cfg.DatabaseSQLite.Synchronous)
+	}
+	for name, v := range map[string]int{
+		"tss_dial_timeout_seconds":          cfg.TSSDialTimeoutSeconds,
+		"tss_io_timeout_seconds":            cfg.TSSIOTimeoutSeconds,
+		"tss_keygen_timeouts.dial_seconds":  cfg.TSSKeygenTimeouts.DialSeconds,
+		"tss_keygen_timeouts.io_seconds":    cfg.TSSKeygenTimeouts.IOSeconds,
+		"tss_reshare_timeouts.dial_seconds": cfg.TSSReshareTimeouts.DialSeconds,
+		"tss_reshare_timeouts.io_seconds":   cfg.TSSReshareTimeouts.IOSeconds,
+		"tss_sign_timeouts.dial_seconds":    cfg.TSSSignTimeouts.DialSeconds,
+		"tss_sign_timeouts.io_seconds":      cfg.TSSSignTimeouts.IOSeconds,
+		"tss_max_concurrent_signs":          cfg.TSSMaxConcurrentSigns,
+		"database_sqlite.busy_timeout_ms":   cfg.DatabaseSQLite.BusyTimeoutMs,
+		"database_sqlite.cache_size_kb":     cfg.DatabaseSQLite.CacheSizeKB,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s must not be negative, got: %d", name, v)
+		}
+	}
 	return nil
 }
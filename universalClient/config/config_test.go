@@ -113,6 +113,10 @@ func TestValidate(t *testing.T) {
 			name:   "valid file backend",
 			config: Config{LogLevel: 1, LogFormat: "console", KeyringBackend: KeyringBackendFile},
 		},
+		{
+			name:   "valid sqlite overrides",
+			config: Config{LogLevel: 1, LogFormat: "console", DatabaseSQLite: SQLiteConfig{JournalMode: "wal", Synchronous: "FULL", BusyTimeoutMs: 10000}},
+		},
 		{
 			name:   "log level too high",
 			config: Config{LogLevel: 6, LogFormat: "json"},
@@ -133,6 +137,26 @@ func TestValidate(t *testing.T) {
 			config: Config{LogLevel: 1, LogFormat: "console", KeyringBackend: "invalid"},
 			errMsg: "keyring backend must be 'file' or 'test'",
 		},
+		{
+			name:   "negative tss timeout",
+			config: Config{LogLevel: 1, LogFormat: "console", TSSSignTimeouts: TSSTimeouts{IOSeconds: -1}},
+			errMsg: "tss_sign_timeouts.io_seconds must not be negative",
+		},
+		{
+			name:   "invalid sqlite journal mode",
+			config: Config{LogLevel: 1, LogFormat: "console", DatabaseSQLite: SQLiteConfig{JournalMode: "wal2"}},
+			errMsg: "database_sqlite.journal_mode must be",
+		},
+		{
+			name:   "invalid sqlite synchronous",
+			config: Config{LogLevel: 1, LogFormat: "console", DatabaseSQLite: SQLiteConfig{Synchronous: "ALWAYS"}},
+			errMsg: "database_sqlite.synchronous must be",
+		},
+		{
+			name:   "negative sqlite busy timeout",
+			config: Config{LogLevel: 1, LogFormat: "console", DatabaseSQLite: SQLiteConfig{BusyTimeoutMs: -1}},
+			errMsg: "database_sqlite.busy_timeout_ms must not be negative",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// EnvPrefix prefixes every environment variable read by LoadLayered.
+const EnvPrefix = "PUNIVERSAL_"
+
+// envRPCURLsPrefix names a chain's RPC pool override: PUNIVERSAL_RPC_URLS_
+// followed by the CAIP-2 chain ID upper-cased with every other character
+// replaced by '_' (eip155:1 → PUNIVERSAL_RPC_URLS_EIP155_1).
+const envRPCURLsPrefix = EnvPrefix + "RPC_URLS_"
+
+// Overrides holds command-line values for LoadLayered. Nil (or empty) fields
+// were not given and leave the lower layers untouched.
+type Overrides struct {
+	LogLevel          *int
+	LogFormat         *string
+	DryRun            *bool
+	PushChainGRPCURLs []string
+	QueryServerPort   *int
+	KeyringBackend    *string
+	DatabaseDriver    *string
+	DatabaseDSN       *string
+	TSSP2PListen      *string
+	ChainRPCURLs      map[string][]string // chain ID → RPC pool
+}
+
+// envVar is one setting readable from the environment.
+type envVar struct {
+	name  string
+	apply func(cfg *Config, value string) error
+}
+
+var envVars = []envVar{
+	{"LOG_LEVEL", func(cfg *Config, v string) error { return parseInt(v, &cfg.LogLevel) }},
+	{"LOG_FORMAT", func(cfg *Config, v string) error { cfg.LogFormat = v; return nil }},
+	{"DRY_RUN", func(cfg *Config, v string) error { return parseBool(v, &cfg.DryRun) }},
+	{"PUSH_CHAIN_ID", func(cfg *Config, v string) error { cfg.PushChainID = v; return nil }},
+	{"PUSH_CHAIN_GRPC_URLS", func(cfg *Config, v string) error { cfg.PushChainGRPCURLs = splitList(v); return nil }},
+	{"PUSH_VALOPER_ADDRESS", func(cfg *Config, v string) error { cfg.PushValoperAddress = v; return nil }},
+	{"QUERY_SERVER_PORT", func(cfg *Config, v string) error { return parseInt(v, &cfg.QueryServerPort) }},
+	{"KEYRING_BACKEND", func(cfg *Config, v string) error { cfg.KeyringBackend = KeyringBackend(v); return nil }},
+	{"KEYRING_PASSWORD", func(cfg *Config, v string) error { cfg.KeyringPassword = v; return nil }},
+	{"DATABASE_DRIVER", func(cfg *Config, v string) error { cfg.DatabaseDriver = v; return nil }},
+	{"DATABASE_DSN", func(cfg *Config, v string) error { cfg.DatabaseDSN = v; return nil }},
+	{"TSS_P2P_LISTEN", func(cfg *Config, v string) error { cfg.TSSP2PListen = v; return nil }},
+	{"TSS_PASSWORD", func(cfg *Config, v string) error { cfg.TSSPassword = v; return nil }},
+	{"TSS_HOME_DIR", func(cfg *Config, v string) error { cfg.TSSHomeDir = v; return nil }},
+	{"TSS_PROTOCOL_ID", func(cfg *Config, v string) error { cfg.TSSProtocolID = v; return nil }},
+	{"TSS_LEGACY_PROTOCOL_FALLBACK", func(cfg *Config, v string) error { return parseBool(v, &cfg.TSSLegacyProtocolFallback) }},
+}
+
+// LoadLayered builds the config for the node at home from three layers, each
+// overriding the one before:
+//
+//  1. <home>/config/pushuv_config.json, or the embedded defaults if the file
+//     does not exist, with defaults applied for missing fields;
+//  2. PUNIVERSAL_* environment variables read through lookupEnv;
+//  3. flags.
+//
+// NodeHome defaults to home. The result is validated.
+func LoadLayered(home string, lookupEnv func(string) (string, bool), flags *Overrides) (Config, error) {
+	cfg, err := loadFileLayer(home)
+	if err != nil {
+		return Config{}, err
+	}
+
+	if lookupEnv != nil {
+		if err := applyEnv(&cfg, lookupEnv); err != nil {
+			return Config{}, err
+		}
+	}
+	if flags != nil {
+		applyOverrides(&cfg, flags)
+	}
+
+	if err := validate(&cfg); err != nil {
+		return Config{}, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadFileLayer reads the config file under home, falling back to the
+// embedded defaults when there is none, and applies defaults.
+func loadFileLayer(home string) (Config, error) {
+	defaults, err := LoadDefaultConfig()
+	if err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	path := filepath.Join(home, ConfigSubdir, ConfigFileName)
+	data, err := os.ReadFile(filepath.Clean(path))
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		cfg = defaults
+	case err != nil:
+		return Config{}, fmt.Errorf("failed to read config file: %w", err)
+	default:
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+	}
+
+	if cfg.NodeHome == "" {
+		cfg.NodeHome = home
+	}
+	applyDefaults(&cfg, &defaults)
+	return cfg, nil
+}
+
+// applyEnv applies every PUNIVERSAL_* variable that is set. RPC pool
+// variables are matched against the chains already configured.
+func applyEnv(cfg *Config, lookupEnv func(string) (string, bool)) error {
+	for _, ev := range envVars {
+		v, ok := lookupEnv(EnvPrefix + ev.name)
+		if !ok {
+			continue
+		}
+		if err := ev.apply(cfg, strings.TrimSpace(v)); err != nil {
+			return fmt.Errorf("invalid %s%s: %w", EnvPrefix, ev.name, err)
+		}
+	}
+
+	chainIDs := make([]string, 0, len(cfg.ChainConfigs))
+	for chainID := range cfg.ChainConfigs {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Strings(chainIDs)
+	for _, chainID := range chainIDs {
+		if v, ok := lookupEnv(ChainRPCURLsEnv(chainID)); ok {
+			setChainRPCURLs(cfg, chainID, splitList(v))
+		}
+	}
+	return nil
+}
+
+// ChainRPCURLsEnv returns the environment variable that overrides a chain's
+// RPC pool.
+func ChainRPCURLsEnv(chainID string) string {
+	return envRPCURLsPrefix + strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, chainID)
+}
+
+// applyOverrides applies every flag that was given.
+func applyOverrides(cfg *Config, o *Overrides) {
+	if o.LogLevel != nil {
+		cfg.LogLevel = *o.LogLevel
+	}
+	if o.LogFormat != nil {
+		cfg.LogFormat = *o.LogFormat
+	}
+	if o.DryRun != nil {
+		cfg.DryRun = *o.DryRun
+	}
+	if len(o.PushChainGRPCURLs) > 0 {
+		cfg.PushChainGRPCURLs = o.PushChainGRPCURLs
+	}
+	if o.QueryServerPort != nil {
+		cfg.QueryServerPort = *o.QueryServerPort
+	}
+	if o.KeyringBackend != nil {
+		cfg.KeyringBackend = KeyringBackend(*o.KeyringBackend)
+	}
+	if o.DatabaseDriver != nil {
+		cfg.DatabaseDriver = *o.DatabaseDriver
+	}
+	if o.DatabaseDSN != nil {
+		cfg.DatabaseDSN = *o.DatabaseDSN
+	}
+	if o.TSSP2PListen != nil {
+		cfg.TSSP2PListen = *o.TSSP2PListen
+	}
+	for chainID, urls := range o.ChainRPCURLs {
+		setChainRPCURLs(cfg, chainID, urls)
+	}
+}
+
+// setChainRPCURLs replaces a chain's RPC pool, adding the chain if needed.
+func setChainRPCURLs(cfg *Config, chainID string, urls []string) {
+	if cfg.ChainConfigs == nil {
+		cfg.ChainConfigs = make(map[string]ChainSpecificConfig)
+	}
+	cc := cfg.ChainConfigs[chainID]
+	cc.RPCURLs = urls
+	cfg.ChainConfigs[chainID] = cc
+}
+
+// splitList splits a comma-separated list, dropping blank entries.
+func splitList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func parseInt(v string, dst *int) error {
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return err
+	}
+	*dst = n
+	return nil
+}
+
+func parseBool(v string, dst *bool) error {
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return err
+	}
+	*dst = b
+	return nil
+}
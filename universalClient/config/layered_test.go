@@ -0,0 +1,103 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func envMap(m map[string]string) func(string) (string, bool) {
+	return func(k string) (string, bool) {
+		v, ok := m[k]
+		return v, ok
+	}
+}
+
+func intOpt(v int) *int          { return &v }
+func stringOpt(v string) *string { return &v }
+
+func TestLoadLayered_MissingFileUsesDefaults(t *testing.T) {
+	home := t.TempDir()
+
+	cfg, err := LoadLayered(home, envMap(nil), nil)
+	require.NoError(t, err)
+
+	defaults, err := LoadDefaultConfig()
+	require.NoError(t, err)
+	assert.Equal(t, home, cfg.NodeHome)
+	assert.Equal(t, defaults.LogLevel, cfg.LogLevel)
+	assert.Equal(t, defaults.QueryServerPort, cfg.QueryServerPort)
+	assert.Equal(t, defaults.PushChainGRPCURLs, cfg.PushChainGRPCURLs)
+	assert.Equal(t, defaults.ChainConfigs, cfg.ChainConfigs)
+	assert.Equal(t, DatabaseDriverSQLite, cfg.DatabaseDriver)
+}
+
+func TestLoadLayered_Precedence(t *testing.T) {
+	home := t.TempDir()
+	fileCfg, err := LoadDefaultConfig()
+	require.NoError(t, err)
+	fileCfg.LogLevel = 1
+	fileCfg.LogFormat = "console"
+	fileCfg.QueryServerPort = 7000
+	fileCfg.ChainConfigs = map[string]ChainSpecificConfig{
+		"eip155:1": {RPCURLs: []string{"https://file"}, CleanupIntervalSeconds: intOpt(60)},
+	}
+	require.NoError(t, Save(&fileCfg, home))
+
+	env := envMap(map[string]string{
+		"PUNIVERSAL_LOG_LEVEL":         "2",
+		"PUNIVERSAL_LOG_FORMAT":        "json",
+		"PUNIVERSAL_RPC_URLS_EIP155_1": "https://env-a, https://env-b",
+	})
+	flags := &Overrides{
+		LogLevel:     intOpt(3),
+		ChainRPCURLs: map[string][]string{"eip155:1": {"https://flag"}},
+	}
+
+	t.Run("file only", func(t *testing.T) {
+		cfg, err := LoadLayered(home, envMap(nil), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, cfg.LogLevel)
+		assert.Equal(t, "console", cfg.LogFormat)
+		assert.Equal(t, 7000, cfg.QueryServerPort)
+		assert.Equal(t, []string{"https://file"}, cfg.ChainConfigs["eip155:1"].RPCURLs)
+	})
+
+	t.Run("env beats file", func(t *testing.T) {
+		cfg, err := LoadLayered(home, env, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, cfg.LogLevel)
+		assert.Equal(t, "json", cfg.LogFormat)
+		assert.Equal(t, 7000, cfg.QueryServerPort)
+		assert.Equal(t, []string{"https://env-a", "https://env-b"}, cfg.ChainConfigs["eip155:1"].RPCURLs)
+		// Overriding the pool keeps the rest of the chain's config.
+		assert.Equal(t, intOpt(60), cfg.ChainConfigs["eip155:1"].CleanupIntervalSeconds)
+	})
+
+	t.Run("flag beats env", func(t *testing.T) {
+		cfg, err := LoadLayered(home, env, flags)
+		require.NoError(t, err)
+		assert.Equal(t, 3, cfg.LogLevel)
+		assert.Equal(t, "json", cfg.LogFormat, "env still applies where no flag is given")
+		assert.Equal(t, []string{"https://flag"}, cfg.ChainConfigs["eip155:1"].RPCURLs)
+	})
+}
+
+func TestLoadLayered_Validation(t *testing.T) {
+	home := t.TempDir()
+
+	_, err := LoadLayered(home, envMap(map[string]string{"PUNIVERSAL_QUERY_SERVER_PORT": "http"}), nil)
+	assert.ErrorContains(t, err, "invalid PUNIVERSAL_QUERY_SERVER_PORT")
+
+	_, err = LoadLayered(home, envMap(nil), &Overrides{LogFormat: stringOpt("xml")})
+	assert.ErrorContains(t, err, "log format")
+
+	_, err = LoadLayered(home, envMap(map[string]string{"PUNIVERSAL_DATABASE_DRIVER": DatabaseDriverPostgres}), nil)
+	assert.ErrorContains(t, err, "database_dsn is required")
+}
+
+func TestChainRPCURLsEnv(t *testing.T) {
+	assert.Equal(t, "PUNIVERSAL_RPC_URLS_EIP155_11155111", ChainRPCURLsEnv("eip155:11155111"))
+	assert.Equal(t, "PUNIVERSAL_RPC_URLS_SOLANA_ETWTRABZAYQ6IMFEYKOURU166VU2XQA1", ChainRPCURLsEnv("solana:EtWTRABZaYq6iMfeYKouRu166VU2xqa1"))
+}
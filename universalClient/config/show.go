@@ -0,0 +1,179 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// SecretMask stands in for secret values in Redacted configs.
+const SecretMask = "***"
+
+// Redacted returns a copy of cfg that is safe to print: passwords, the TSS
+// network key and the database DSN are masked, and chain RPC URLs are cut to
+// scheme://host since providers embed API keys in the path or query.
+func Redacted(cfg Config) Config {
+	mask := func(s *string) {
+		if *s != "" {
+			*s = SecretMask
+		}
+	}
+	mask(&cfg.KeyringPassword)
+	mask(&cfg.TSSPassword)
+	mask(&cfg.TSSP2PPrivateKeyHex)
+	mask(&cfg.DatabaseDSN)
+
+	if cfg.ChainConfigs != nil {
+		chains := make(map[string]ChainSpecificConfig, len(cfg.ChainConfigs))
+		for chainID, cc := range cfg.ChainConfigs {
+			urls := make([]string, len(cc.RPCURLs))
+			for i, u := range cc.RPCURLs {
+				urls[i] = redactURL(u)
+			}
+			cc.RPCURLs = urls
+			chains[chainID] = cc
+		}
+		cfg.ChainConfigs = chains
+	}
+	return cfg
+}
+
+// redactURL keeps only scheme://host, like common.RedactEndpoint.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return SecretMask
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// Entry is one leaf setting of a config.
+type Entry struct {
+	Key   string // dotted JSON path, e.g. chain_configs.eip155:1.rpc_urls
+	Value string // JSON-encoded value
+}
+
+// Entries flattens cfg into its leaf settings sorted by key, using the same
+// dotted keys SetValue accepts.
+func Entries(cfg Config) ([]Entry, error) {
+	tree, err := configTree(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	var walk func(prefix string, node map[string]any) error
+	walk = func(prefix string, node map[string]any) error {
+		for k, v := range node {
+			key := prefix + k
+			if child, ok := v.(map[string]any); ok && len(child) > 0 {
+				if err := walk(key+".", child); err != nil {
+					return err
+				}
+				continue
+			}
+			value, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("failed to encode %s: %w", key, err)
+			}
+			entries = append(entries, Entry{Key: key, Value: string(value)})
+		}
+		return nil
+	}
+	if err := walk("", tree); err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries, nil
+}
+
+// SetValue sets the setting at a dotted JSON key (as listed by Entries) from
+// its command-line form. value is read as JSON when it is valid JSON of the
+// setting's type (numbers, booleans, lists, objects) and as a plain string
+// otherwise. Chain settings are addressed as chain_configs.<chain-id>.<field>;
+// a chain not yet configured is added. Unknown keys are rejected.
+func SetValue(cfg *Config, key, value string) error {
+	path := strings.Split(key, ".")
+	for _, p := range path {
+		if p == "" {
+			return fmt.Errorf("invalid config key %q", key)
+		}
+	}
+
+	var parsed any
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+	isJSON := dec.Decode(&parsed) == nil && !dec.More()
+	if !isJSON {
+		parsed = value
+	}
+
+	updated, err := setPath(cfg, path, parsed)
+	if err != nil && isJSON {
+		// Valid JSON of the wrong type ("12345" for a password): take it verbatim.
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			updated, err = setPath(cfg, path, value)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set %s: %w", key, err)
+	}
+	*cfg = updated
+	return nil
+}
+
+// setPath returns cfg with the value at path replaced.
+func setPath(cfg *Config, path []string, value any) (Config, error) {
+	tree, err := configTree(cfg)
+	if err != nil {
+		return Config{}, err
+	}
+
+	node := tree
+	for i, p := range path[:len(path)-1] {
+		next, ok := node[p]
+		if !ok || next == nil {
+			child := map[string]any{}
+			node[p] = child
+			node = child
+			continue
+		}
+		child, ok := next.(map[string]any)
+		if !ok {
+			return Config{}, fmt.Errorf("%s is not a group of settings", strings.Join(path[:i+1], "."))
+		}
+		node = child
+	}
+	node[path[len(path)-1]] = value
+
+	data, err := json.Marshal(tree)
+	if err != nil {
+		return Config{}, err
+	}
+	var out Config
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&out); err != nil {
+		return Config{}, err
+	}
+	return out, nil
+}
+
+// configTree decodes cfg's JSON form into nested maps, keeping numbers exact.
+func configTree(cfg *Config) (map[string]any, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	var tree map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&tree); err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
+	}
+	return tree, nil
+}
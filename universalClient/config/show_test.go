@@ -0,0 +1,104 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func entryMap(t *testing.T, cfg Config) map[string]string {
+	t.Helper()
+	entries, err := Entries(cfg)
+	require.NoError(t, err)
+	m := make(map[string]string, len(entries))
+	for _, e := range entries {
+		m[e.Key] = e.Value
+	}
+	return m
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := Config{
+		KeyringPassword:     "kr-secret",
+		TSSPassword:         "tss-secret",
+		TSSP2PPrivateKeyHex: testTSSPrivateKeyHex,
+		DatabaseDSN:         "postgres://u:p@db/uv",
+		PushChainID:         "localchain_9000-1",
+		ChainConfigs: map[string]ChainSpecificConfig{
+			"eip155:1": {RPCURLs: []string{"https://eth.example.com/v2/API_KEY", "not a url"}},
+		},
+	}
+
+	red := Redacted(cfg)
+	assert.Equal(t, SecretMask, red.KeyringPassword)
+	assert.Equal(t, SecretMask, red.TSSPassword)
+	assert.Equal(t, SecretMask, red.TSSP2PPrivateKeyHex)
+	assert.Equal(t, SecretMask, red.DatabaseDSN)
+	assert.Equal(t, "localchain_9000-1", red.PushChainID)
+	assert.Equal(t, []string{"https://eth.example.com", SecretMask}, red.ChainConfigs["eip155:1"].RPCURLs)
+
+	// The original is untouched.
+	assert.Equal(t, "https://eth.example.com/v2/API_KEY", cfg.ChainConfigs["eip155:1"].RPCURLs[0])
+	assert.Empty(t, Redacted(Config{}).KeyringPassword, "unset secrets stay empty")
+}
+
+func TestEntries_ReflectOverrides(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := LoadLayered(home,
+		envMap(map[string]string{"PUNIVERSAL_LOG_FORMAT": "json"}),
+		&Overrides{LogLevel: intOpt(4), ChainRPCURLs: map[string][]string{"eip155:1": {"https://flag.example.com/key"}}})
+	require.NoError(t, err)
+
+	entries := entryMap(t, Redacted(cfg))
+	assert.Equal(t, "4", entries["log_level"])
+	assert.Equal(t, `"json"`, entries["log_format"])
+	assert.Equal(t, `["https://flag.example.com"]`, entries["chain_configs.eip155:1.rpc_urls"])
+	assert.Equal(t, `"`+home+`"`, entries["node_home"])
+}
+
+func TestSetValue_RoundTrip(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := LoadDefaultConfig()
+	require.NoError(t, err)
+	require.NoError(t, Save(&cfg, home))
+
+	cfg, err = Load(home)
+	require.NoError(t, err)
+	require.NoError(t, SetValue(&cfg, "log_level", "3"))
+	require.NoError(t, SetValue(&cfg, "dry_run", "true"))
+	require.NoError(t, SetValue(&cfg, "push_chain_id", "localchain_9000-1"))
+	require.NoError(t, SetValue(&cfg, "tss_password", "12345"), "numeric-looking strings are kept as strings")
+	require.NoError(t, SetValue(&cfg, "push_chain_grpc_urls", `["a:9090","b:9090"]`))
+	require.NoError(t, SetValue(&cfg, "chain_configs.eip155:1.rpc_urls", `["https://x"]`))
+	require.NoError(t, SetValue(&cfg, "chain_configs.eip155:97.gas_price_markup_percent", "15"))
+	require.NoError(t, Save(&cfg, home))
+
+	loaded, err := Load(home)
+	require.NoError(t, err)
+	assert.Equal(t, 3, loaded.LogLevel)
+	assert.True(t, loaded.DryRun)
+	assert.Equal(t, "localchain_9000-1", loaded.PushChainID)
+	assert.Equal(t, "12345", loaded.TSSPassword)
+	assert.Equal(t, []string{"a:9090", "b:9090"}, loaded.PushChainGRPCURLs)
+	assert.Equal(t, []string{"https://x"}, loaded.ChainConfigs["eip155:1"].RPCURLs)
+	require.NotNil(t, loaded.ChainConfigs["eip155:97"].GasPriceMarkupPercent)
+	assert.Equal(t, 15, *loaded.ChainConfigs["eip155:97"].GasPriceMarkupPercent)
+	assert.NotEmpty(t, loaded.ChainConfigs["eip155:97"].RPCURLs, "sibling chain settings are kept")
+}
+
+func TestSetValue_Errors(t *testing.T) {
+	cfg, err := LoadDefaultConfig()
+	require.NoError(t, err)
+
+	for key, value := range map[string]string{
+		"no_such_key":                   "1",
+		"chain_configs.eip155:97.bogus": "1",
+		"log_level":                     "loud",
+		"log_level.nested":              "1",
+		"chain_configs..rpc_urls":       `["x"]`,
+	} {
+		assert.Error(t, SetValue(&cfg, key, value), key)
+	}
+	assert.Equal(t, 1, cfg.LogLevel, "failed sets leave the config unchanged")
+}
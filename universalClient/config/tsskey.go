@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// EnvTSSPrivateKey is the environment variable that may carry the hex TSS
+// network key instead of the config file.
+const EnvTSSPrivateKey = EnvPrefix + "TSS_PRIVATE_KEY"
+
+// Sources of the TSS network key, as reported by TSSPrivateKey.
+const (
+	TSSKeySourceNone   = ""
+	TSSKeySourceConfig = "config"
+	TSSKeySourceEnv    = "env"
+	TSSKeySourceFile   = "file"
+)
+
+// TSSPrivateKey returns the hex TSS network key and where it came from.
+// Sources in increasing precedence: tss_p2p_private_key_hex, the
+// PUNIVERSAL_TSS_PRIVATE_KEY env var, tss_p2p_private_key_file. An empty key
+// with TSSKeySourceNone means TSS is not configured.
+func (c *Config) TSSPrivateKey() (key, source string, err error) {
+	if path := c.TSSPrivateKeyFilePath(); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", TSSKeySourceNone, fmt.Errorf("failed to read tss_p2p_private_key_file: %w", err)
+		}
+		key = strings.TrimSpace(string(data))
+		if key == "" {
+			return "", TSSKeySourceNone, fmt.Errorf("tss_p2p_private_key_file %s is empty", path)
+		}
+		return key, TSSKeySourceFile, nil
+	}
+	if key = strings.TrimSpace(os.Getenv(EnvTSSPrivateKey)); key != "" {
+		return key, TSSKeySourceEnv, nil
+	}
+	if c.TSSP2PPrivateKeyHex != "" {
+		return c.TSSP2PPrivateKeyHex, TSSKeySourceConfig, nil
+	}
+	return "", TSSKeySourceNone, nil
+}
+
+// TSSPrivateKeyFilePath returns the path of tss_p2p_private_key_file, resolved
+// against NodeHome when relative, or "" when no key file is configured.
+func (c *Config) TSSPrivateKeyFilePath() string {
+	if c.TSSP2PPrivateKeyFile == "" {
+		return ""
+	}
+	path := c.TSSP2PPrivateKeyFile
+	if !filepath.IsAbs(path) && c.NodeHome != "" {
+		path = filepath.Join(c.NodeHome, path)
+	}
+	return filepath.Clean(path)
+}
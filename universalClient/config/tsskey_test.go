@@ -0,0 +1,70 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTSSPrivateKey(t *testing.T) {
+	const (
+		configKey = "0101010101010101010101010101010101010101010101010101010101010101"
+		envKey    = "0202020202020202020202020202020202020202020202020202020202020202"
+		fileKey   = "0303030303030303030303030303030303030303030303030303030303030303"
+	)
+
+	t.Run("config value", func(t *testing.T) {
+		t.Setenv(EnvTSSPrivateKey, "")
+		cfg := &Config{TSSP2PPrivateKeyHex: configKey}
+		key, source, err := cfg.TSSPrivateKey()
+		require.NoError(t, err)
+		assert.Equal(t, configKey, key)
+		assert.Equal(t, TSSKeySourceConfig, source)
+	})
+
+	t.Run("env overrides config", func(t *testing.T) {
+		t.Setenv(EnvTSSPrivateKey, envKey+"\n")
+		cfg := &Config{TSSP2PPrivateKeyHex: configKey}
+		key, source, err := cfg.TSSPrivateKey()
+		require.NoError(t, err)
+		assert.Equal(t, envKey, key)
+		assert.Equal(t, TSSKeySourceEnv, source)
+	})
+
+	t.Run("file overrides env, relative to node home", func(t *testing.T) {
+		t.Setenv(EnvTSSPrivateKey, envKey)
+		home := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(home, "tss.key"), []byte(fileKey+"\n"), 0o600))
+
+		cfg := &Config{NodeHome: home, TSSP2PPrivateKeyHex: configKey, TSSP2PPrivateKeyFile: "tss.key"}
+		key, source, err := cfg.TSSPrivateKey()
+		require.NoError(t, err)
+		assert.Equal(t, fileKey, key)
+		assert.Equal(t, TSSKeySourceFile, source)
+	})
+
+	t.Run("missing or empty file is an error", func(t *testing.T) {
+		t.Setenv(EnvTSSPrivateKey, "")
+		dir := t.TempDir()
+		cfg := &Config{TSSP2PPrivateKeyFile: filepath.Join(dir, "missing.key")}
+		_, _, err := cfg.TSSPrivateKey()
+		assert.Error(t, err)
+
+		empty := filepath.Join(dir, "empty.key")
+		require.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+		cfg.TSSP2PPrivateKeyFile = empty
+		_, _, err = cfg.TSSPrivateKey()
+		assert.Error(t, err)
+	})
+
+	t.Run("no key configured", func(t *testing.T) {
+		t.Setenv(EnvTSSPrivateKey, "")
+		key, source, err := (&Config{}).TSSPrivateKey()
+		require.NoError(t, err)
+		assert.Empty(t, key)
+		assert.Equal(t, TSSKeySourceNone, source)
+	})
+}
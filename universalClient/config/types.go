@@ -10,6 +10,12 @@ const (
 	KeyringBackendFile KeyringBackend = "file"
 )
 
+// Supported values for Config.DatabaseDriver.
+const (
+	DatabaseDriverSQLite   = "sqlite"
+	DatabaseDriverPostgres = "postgres"
+)
+
 // Config holds all configuration for the Universal Validator.
 type Config struct {
 	// Logging
@@ -20,6 +26,10 @@ type Config struct {
 	// Node
 	NodeHome string `json:"node_home"`
 
+	// DryRun (staging/canary): observe, sign and build outbound txs as usual,
+	// but log each built tx instead of broadcasting it to its destination chain.
+	DryRun bool `json:"dry_run,omitempty"`
+
 	// Push Chain
 	PushChainID                  string   `json:"push_chain_id"`
 	PushChainGRPCURLs            []string `json:"push_chain_grpc_urls"`
@@ -34,14 +44,57 @@ type Config struct {
 	KeyringBackend  KeyringBackend `json:"keyring_backend"`
 	KeyringPassword string         `json:"keyring_password"`
 
+	// Database: "sqlite" (default, one file per chain under <NodeHome>/databases)
+	// or "postgres" (shared database at DatabaseDSN, tables prefixed per chain)
+	DatabaseDriver string `json:"database_driver"`
+	DatabaseDSN    string `json:"database_dsn"`
+	// DatabaseSQLite tunes the SQLite files; unset fields keep the defaults
+	// (WAL journal, 5s busy timeout, synchronous NORMAL, 64MB cache).
+	DatabaseSQLite SQLiteConfig `json:"database_sqlite,omitempty"`
+
 	// Per-chain settings (keyed by CAIP-2 chain ID)
 	ChainConfigs map[string]ChainSpecificConfig `json:"chain_configs"`
 
-	// TSS
-	TSSP2PPrivateKeyHex string `json:"tss_p2p_private_key_hex"`
-	TSSP2PListen        string `json:"tss_p2p_listen"`
-	TSSPassword         string `json:"tss_password"`
-	TSSHomeDir          string `json:"tss_home_dir"`
+	// TSS. The network key is resolved by TSSPrivateKey: a key file or the
+	// PUNIVERSAL_TSS_PRIVATE_KEY env var keep it out of this file.
+	TSSP2PPrivateKeyHex  string `json:"tss_p2p_private_key_hex"`
+	TSSP2PPrivateKeyFile string `json:"tss_p2p_private_key_file,omitempty"`
+	TSSP2PListen         string `json:"tss_p2p_listen"`
+	TSSPassword          string `json:"tss_password"`
+	TSSHomeDir           string `json:"tss_home_dir"`
+	// TSSProtocolID overrides the TSS libp2p protocol, which is otherwise
+	// derived from PushChainID (/push/tss/<chain id>/1.0.0).
+	TSSProtocolID string `json:"tss_protocol_id,omitempty"`
+	// TSSLegacyProtocolFallback also speaks the pre-namespace protocol
+	// (/push/tss/1.0.0) during a rolling upgrade. Off by default: nodes that
+	// enable it can reach each other across networks.
+	TSSLegacyProtocolFallback bool `json:"tss_legacy_protocol_fallback,omitempty"`
+
+	// TSS network timeouts; unset keeps the network defaults (dial 10s, IO
+	// 15s). The per-protocol overrides replace them field by field.
+	TSSDialTimeoutSeconds int         `json:"tss_dial_timeout_seconds,omitempty"`
+	TSSIOTimeoutSeconds   int         `json:"tss_io_timeout_seconds,omitempty"`
+	TSSKeygenTimeouts     TSSTimeouts `json:"tss_keygen_timeouts,omitempty"`
+	TSSReshareTimeouts    TSSTimeouts `json:"tss_reshare_timeouts,omitempty"` // key refresh and quorum change
+	TSSSignTimeouts       TSSTimeouts `json:"tss_sign_timeouts,omitempty"`
+
+	// TSSMaxConcurrentSigns caps concurrently running sign sessions; unset
+	// means no cap.
+	TSSMaxConcurrentSigns int `json:"tss_max_concurrent_signs,omitempty"`
+}
+
+// TSSTimeouts overrides the TSS network timeouts for one protocol.
+type TSSTimeouts struct {
+	DialSeconds int `json:"dial_seconds,omitempty"`
+	IOSeconds   int `json:"io_seconds,omitempty"`
+}
+
+// SQLiteConfig overrides the SQLite journal, locking and cache settings.
+type SQLiteConfig struct {
+	JournalMode   string `json:"journal_mode,omitempty"` // DELETE, TRUNCATE, PERSIST, MEMORY, WAL or OFF
+	BusyTimeoutMs int    `json:"busy_timeout_ms,omitempty"`
+	Synchronous   string `json:"synchronous,omitempty"` // OFF, NORMAL, FULL or EXTRA
+	CacheSizeKB   int    `json:"cache_size_kb,omitempty"`
 }
 
 // ChainSpecificConfig holds per-chain configuration.
@@ -50,15 +103,83 @@ type ChainSpecificConfig struct {
 	CleanupIntervalSeconds      *int              `json:"cleanup_interval_seconds,omitempty"`
 	RetentionPeriodSeconds      *int              `json:"retention_period_seconds,omitempty"`
 	EventPollingIntervalSeconds *int              `json:"event_polling_interval_seconds,omitempty"`
-	EventStartFrom              *int64            `json:"event_start_from,omitempty"`
+	EventBatchSize              *int              `json:"event_batch_size,omitempty"`          // Push Chain: pending events fetched per query page
+	MaxInFlightSignEvents       *int              `json:"max_in_flight_sign_events,omitempty"` // Push Chain: pause fetching outbounds while this many sign events await or are in TSS
+	EventStartFrom              *int64            `json:"event_start_from,omitempty"`          // block/slot a newly enabled chain is observed from; -1 or unset = tip; recorded on first start
+	EventMaxBlockRange          *int              `json:"event_max_block_range,omitempty"`     // EVM: widest block span per eth_getLogs query
 	GasPriceIntervalSeconds     *int              `json:"gas_price_interval_seconds,omitempty"`
 	GasPriceMarkupPercent       *int              `json:"gas_price_markup_percent,omitempty"`    // % markup on fetched gas price to handle spikes
+	MinGasPrice                 *int64            `json:"min_gas_price,omitempty"`               // EVM: outbounds priced below this (wei) are not signed
+	MaxGasPrice                 *int64            `json:"max_gas_price,omitempty"`               // EVM: outbounds priced above this (wei) are not signed
+	ComputeUnitLimit            *int              `json:"compute_unit_limit,omitempty"`          // SVM: compute units requested per gateway tx
+	ComputeUnitMarginPercent    *int              `json:"compute_unit_margin_percent,omitempty"` // SVM: if set, simulate each gateway tx and request consumed units plus this %
 	ProtocolALT                 string            `json:"protocol_alt,omitempty"`            // Protocol ALT address (base58) for V0 transactions
 	TokenALTs                   map[string]string `json:"token_alts,omitempty"`              // mint address → token ALT address (base58)
 
+	// SVM execute-mode account rules: target program (base58) → accounts it
+	// requires at fixed positions of the remaining accounts. Outbounds that
+	// break a rule are refused before signing; must match across validators.
+	ExecuteAccountRules map[string][]ExecuteAccountRule `json:"execute_account_rules,omitempty"`
+
+	// Outbounds moving at least an asset's entry in HighValueThresholds need
+	// HighValueConfirmations before they are final: EVM blocks, or SVM slots
+	// past finality. Keys are the asset's address on this chain ("native" for
+	// the gas token); values are base units of that asset. Both must be set;
+	// must match across validators.
+	HighValueThresholds    map[string]string `json:"high_value_thresholds,omitempty"`
+	HighValueConfirmations *int              `json:"high_value_confirmations,omitempty"`
+
+	// Outbounds to this chain moving more of an asset than its entry in
+	// MaxOutboundAmounts are held for manual review instead of being signed.
+	// Keys are the asset's address on this chain ("native" for the gas
+	// token); values are base units of that asset. Assets without an entry
+	// are uncapped.
+	MaxOutboundAmounts map[string]string `json:"max_outbound_amounts,omitempty"`
+
+	// Outbounds to this chain still unsigned OutboundMaxLifetimeBlocks Push
+	// Chain blocks after creation are voted failed so Push Chain reverts them.
+	// Unset means no limit; must match across validators.
+	OutboundMaxLifetimeBlocks *int `json:"outbound_max_lifetime_blocks,omitempty"`
+
+	// Per-endpoint RPC pacing. Each RPC URL gets its own token bucket; unset means unpaced.
+	RPCRequestsPerSecond *float64 `json:"rpc_requests_per_second,omitempty"` // sustained requests/sec per endpoint
+	RPCBurst             *int     `json:"rpc_burst,omitempty"`               // bucket size; defaults to ceil(rps)
+	RPCRecoveryStreak    *int     `json:"rpc_recovery_streak,omitempty"`     // consecutive successes before a throttled endpoint is healthy again; default 3
+	RPCHealthCheck       string   `json:"rpc_health_check,omitempty"`        // EVM: "block_number" (default) or "syncing"; SVM: "slot" (default) or "health"
+
+	// RPC endpoint eligibility. Entries match an rpc_urls entry exactly or by
+	// its scheme://host, so a provider can be named without its API key. When
+	// the allowlist is set only its members are used; blacklisted endpoints
+	// are never used. Applied whenever the chain's RPC client is (re)created.
+	RPCAllowlist []string `json:"rpc_allowlist,omitempty"`
+	RPCBlacklist []string `json:"rpc_blacklist,omitempty"`
+
 	// SVM rent reclaimer (orphaned StoredIxData PDA cleanup). Both default if unset.
 	RentReclaimSweepIntervalSeconds *int `json:"rent_reclaim_sweep_interval_seconds,omitempty"` // how often to sweep
 	RentReclaimMinPDAAgeSeconds     *int `json:"rent_reclaim_min_pda_age_seconds,omitempty"`    // skip PDAs younger than this
+
+	// Relayer balance monitor: the account paying for outbound txs (the TSS
+	// address on EVM, the relayer keypair on SVM) is checked every
+	// RelayerBalanceCheckIntervalSeconds (default 60) and exported as a metric;
+	// below RelayerBalanceThreshold (base units: wei, lamports) a warning is logged.
+	RelayerBalanceThreshold            *string `json:"relayer_balance_threshold,omitempty"`
+	RelayerBalanceCheckIntervalSeconds *int    `json:"relayer_balance_check_interval_seconds,omitempty"`
+
+	// SVM relayer key source: "file" (default, <NodeHome>/relayer/<namespace>.json)
+	// or "keyring" (OS keyring item relayer/<namespace> holding the same JSON array).
+	RelayerKeyBackend string `json:"relayer_key_backend,omitempty"`
+
+	// EVM/SVM: keep the raw source-chain event behind each inbound for this
+	// long, for audit and replay. Unset disables raw event capture.
+	RawEventRetentionSeconds *int `json:"raw_event_retention_seconds,omitempty"`
+}
+
+// ExecuteAccountRule pins an account a target program expects at a fixed
+// position of its execute-mode remaining accounts.
+type ExecuteAccountRule struct {
+	Index    int    `json:"index"`              // position in the payload's account list
+	Pubkey   string `json:"pubkey"`             // base58 account address
+	Writable *bool  `json:"writable,omitempty"` // required writability; unset accepts either
 }
 
 // GetChainCleanupSettings returns cleanup settings for a specific chain.
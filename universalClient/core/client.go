@@ -8,16 +8,21 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pushchain/push-chain-node/universalClient/api"
 	"github.com/pushchain/push-chain-node/universalClient/chains"
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/chains/push"
+	"github.com/pushchain/push-chain-node/universalClient/chains/svm"
 	"github.com/pushchain/push-chain-node/universalClient/config"
 	"github.com/pushchain/push-chain-node/universalClient/db"
 	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/pushcore"
 	"github.com/pushchain/push-chain-node/universalClient/pushsigner"
 	"github.com/pushchain/push-chain-node/universalClient/tss"
+	"github.com/pushchain/push-chain-node/universalClient/tss/coordinator"
 	"github.com/rs/zerolog"
 )
 
@@ -72,8 +77,36 @@ func NewUniversalClient(ctx context.Context, cfg *config.Config) (*UniversalClie
 	if err != nil {
 		return nil, err
 	}
+	if tssNode != nil {
+		chainsManager.SetOutboundReportTurn(tssNode.IsOutboundReportTurn)
+	}
 
 	queryServer := api.NewServer(log, cfg.QueryServerPort)
+	if err := queryServer.Register(push.EventStatsCollectors(chainsManager.PushEventStats)...); err != nil {
+		return nil, err
+	}
+	if err := queryServer.Register(common.RelayerBalanceCollector(chainsManager.RelayerBalances)); err != nil {
+		return nil, err
+	}
+	if err := queryServer.Register(common.EndpointStatsCollector(chainsManager.EndpointStats)); err != nil {
+		return nil, err
+	}
+	if err := queryServer.Register(svm.RecoveryIDMismatchesCollector()); err != nil {
+		return nil, err
+	}
+	if tssNode != nil {
+		if err := queryServer.Register(coordinator.QuorumDeferralsCollector(tssNode.QuorumDeferrals)); err != nil {
+			return nil, err
+		}
+		if err := queryServer.Register(tss.LeaveStateCollector(tssNode.LeaveState)); err != nil {
+			return nil, err
+		}
+	}
+	queryServer.SetChainRefresher(chainsManager.Refresh)
+	if tssNode != nil {
+		queryServer.SetHeldOutbounds(tssNode)
+		queryServer.SetLeaveState(func() string { return tssNode.LeaveState().String() })
+	}
 
 	return &UniversalClient{
 		ctx:         ctx,
@@ -91,6 +124,9 @@ func NewUniversalClient(ctx context.Context, cfg *config.Config) (*UniversalClie
 func (uc *UniversalClient) Start() error {
 	uc.log.Info().Msg("starting universal client")
 
+	// SIGUSR1/SIGUSR2 raise/lower log verbosity without interrupting signing
+	logger.WatchLevelSignals(uc.ctx, uc.log)
+
 	if err := uc.chains.Start(uc.ctx); err != nil {
 		return fmt.Errorf("failed to start chains manager: %w", err)
 	}
@@ -162,29 +198,46 @@ func initTSS(
 	pushSigner *pushsigner.Signer,
 	log zerolog.Logger,
 ) (*tss.Node, error) {
-	if cfg.PushValoperAddress == "" || cfg.TSSP2PPrivateKeyHex == "" {
+	if cfg.PushValoperAddress == "" {
+		return nil, nil
+	}
+	p2pKey, err := tssPrivateKey(cfg, log)
+	if err != nil {
+		return nil, err
+	}
+	if p2pKey == "" {
 		return nil, nil
 	}
 
-	// Sanitize chain ID for use as a database filename (e.g. "push_42101-1" → "push_42101-1.db")
-	dbFilename := sanitizeForFilename(cfg.PushChainID) + ".db"
+	// Sanitize chain ID for use as a database namespace, e.g. "push_42101-1" ->
+	// "push_42101-1.db" (sqlite) or "push_42101_1_" tables (postgres)
 	baseDir := filepath.Join(cfg.NodeHome, config.DatabasesSubdir)
-	pushDB, err := db.OpenFileDB(baseDir, dbFilename, true)
+	pushDB, err := db.OpenWithSQLiteOptions(db.Driver(cfg.DatabaseDriver), cfg.DatabaseDSN, baseDir, sanitizeForFilename(cfg.PushChainID), true, chains.SQLiteOptions(cfg.DatabaseSQLite))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create push database: %w", err)
 	}
 
 	node, err := tss.NewNode(ctx, tss.Config{
-		ValidatorAddress: cfg.PushValoperAddress,
-		P2PPrivateKeyHex: cfg.TSSP2PPrivateKeyHex,
-		LibP2PListen:     cfg.TSSP2PListen,
-		HomeDir:          cfg.NodeHome,
-		Password:         cfg.TSSPassword,
-		Database:         pushDB,
-		PushCore:         pushCore,
-		Logger:           log,
-		Chains:           chainsManager,
-		PushSigner:       pushSigner,
+		ValidatorAddress:       cfg.PushValoperAddress,
+		P2PPrivateKeyHex:       p2pKey,
+		LibP2PListen:           cfg.TSSP2PListen,
+		ProtocolID:             cfg.TSSProtocolID,
+		NetworkNamespace:       cfg.PushChainID,
+		LegacyProtocolFallback: cfg.TSSLegacyProtocolFallback,
+		HomeDir:                cfg.NodeHome,
+		Password:               cfg.TSSPassword,
+		Database:               pushDB,
+		PushCore:               pushCore,
+		Logger:                 log,
+		Chains:                 chainsManager,
+		PushSigner:             pushSigner,
+
+		DialTimeout:        seconds(cfg.TSSDialTimeoutSeconds),
+		IOTimeout:          seconds(cfg.TSSIOTimeoutSeconds),
+		KeygenTimeouts:     tssTimeouts(cfg.TSSKeygenTimeouts),
+		ReshareTimeouts:    tssTimeouts(cfg.TSSReshareTimeouts),
+		SignTimeouts:       tssTimeouts(cfg.TSSSignTimeouts),
+		MaxConcurrentSigns: cfg.TSSMaxConcurrentSigns,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create TSS node: %w", err)
@@ -193,6 +246,31 @@ func initTSS(
 	return node, nil
 }
 
+// tssPrivateKey resolves the TSS network key, warning when it is read from
+// the plaintext config field rather than a key file or the environment.
+func tssPrivateKey(cfg *config.Config, log zerolog.Logger) (string, error) {
+	key, source, err := cfg.TSSPrivateKey()
+	if err != nil {
+		return "", err
+	}
+	if source == config.TSSKeySourceConfig {
+		log.Warn().
+			Str("alternatives", "tss_p2p_private_key_file, "+config.EnvTSSPrivateKey).
+			Msg("TSS network key read from plaintext tss_p2p_private_key_hex")
+	}
+	return key, nil
+}
+
+// seconds converts a config value in seconds to a duration.
+func seconds(s int) time.Duration {
+	return time.Duration(s) * time.Second
+}
+
+// tssTimeouts converts a configured per-protocol override.
+func tssTimeouts(t config.TSSTimeouts) tss.Timeouts {
+	return tss.Timeouts{Dial: seconds(t.DialSeconds), IO: seconds(t.IOSeconds)}
+}
+
 // sanitizeForFilename replaces characters that are problematic in filenames.
 func sanitizeForFilename(s string) string {
 	return strings.ReplaceAll(s, ":", "_")
@@ -1,10 +1,14 @@
 package core
 
 import (
+	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pushchain/push-chain-node/universalClient/config"
+	"github.com/pushchain/push-chain-node/universalClient/tss"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -76,3 +80,30 @@ func TestSanitizeForFilename(t *testing.T) {
 	assert.Equal(t, "push_42101-1", sanitizeForFilename("push_42101-1"))
 	assert.Equal(t, "solana_EtWTRABZaYq6", sanitizeForFilename("solana:EtWTRABZaYq6"))
 }
+
+func TestTSSTimeouts(t *testing.T) {
+	assert.Equal(t, tss.Timeouts{Dial: 30 * time.Second}, tssTimeouts(config.TSSTimeouts{DialSeconds: 30}))
+	assert.Equal(t, tss.Timeouts{}, tssTimeouts(config.TSSTimeouts{}))
+}
+
+func TestTSSPrivateKey(t *testing.T) {
+	const key = "0101010101010101010101010101010101010101010101010101010101010101"
+
+	t.Run("plaintext config key warns", func(t *testing.T) {
+		t.Setenv(config.EnvTSSPrivateKey, "")
+		var buf bytes.Buffer
+		got, err := tssPrivateKey(&config.Config{TSSP2PPrivateKeyHex: key}, zerolog.New(&buf))
+		require.NoError(t, err)
+		assert.Equal(t, key, got)
+		assert.Contains(t, buf.String(), "plaintext tss_p2p_private_key_hex")
+	})
+
+	t.Run("env key does not warn", func(t *testing.T) {
+		t.Setenv(config.EnvTSSPrivateKey, key)
+		var buf bytes.Buffer
+		got, err := tssPrivateKey(&config.Config{}, zerolog.New(&buf))
+		require.NoError(t, err)
+		assert.Equal(t, key, got)
+		assert.Empty(t, buf.String())
+	})
+}
@@ -1,18 +1,35 @@
-// Package db provides a lightweight GORM-based SQLite wrapper for persisting
-// state required by the Push Universal Validator (UV), such as block tracking,
+// Package db provides a lightweight GORM-based wrapper for persisting state
+// required by the Push Universal Validator (UV), such as block tracking,
 // processed cross-chain messages, and transaction receipts.
+//
+// SQLite is the default backend: one database file per chain. Operators who
+// want a shared or HA database can select Postgres instead, in which case each
+// chain's tables live in one database under a per-chain table prefix.
 package db
 
 import (
+	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/mattn/go-sqlite3"
 	"github.com/pushchain/push-chain-node/universalClient/store"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// Driver selects the database engine backing a DB.
+type Driver string
+
+const (
+	DriverSQLite   Driver = "sqlite"
+	DriverPostgres Driver = "postgres"
 )
 
 const (
@@ -21,8 +38,33 @@ const (
 
 	// dbDirPermissions sets directory permissions to 750 (rwxr-x---).
 	dbDirPermissions = 0o750
+
+	// sqliteDriverName is go-sqlite3 with a connect hook that applies
+	// connPragmas, used for file-backed databases.
+	sqliteDriverName = "sqlite3_puniversal"
 )
 
+// connPragmas are per-connection settings go-sqlite3 has no DSN parameter
+// for. They run on every new pooled connection, not just the first one.
+var connPragmas = []string{
+	"PRAGMA temp_store = MEMORY",   // Temporary tables and indices stored in RAM
+	"PRAGMA mmap_size = 268435456", // 256MB memory-mapped I/O for faster reads
+}
+
+func init() {
+	sql.Register(sqliteDriverName, &sqlite3.SQLiteDriver{ConnectHook: applyConnPragmas})
+}
+
+// applyConnPragmas runs connPragmas on a newly opened connection.
+func applyConnPragmas(conn *sqlite3.SQLiteConn) error {
+	for _, pragma := range connPragmas {
+		if _, err := conn.Exec(pragma, nil); err != nil {
+			return fmt.Errorf("failed to execute %s: %w", pragma, err)
+		}
+	}
+	return nil
+}
+
 func newGormConfig() *gorm.Config {
 	return &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Silent),
@@ -36,19 +78,111 @@ func schemaModels() []any {
 	}
 }
 
+// Store is the persistence backend the chain clients and the TSS node work
+// against. *DB implements it for every Driver, so callers don't depend on
+// which engine is configured.
+type Store interface {
+	// Client returns the GORM handle queries run on. Table names already
+	// carry the backend's namespace.
+	Client() *gorm.DB
+	// Driver reports which database engine backs the store.
+	Driver() Driver
+	// Close releases the underlying connections.
+	Close() error
+}
+
+var _ Store = (*DB)(nil)
+
 // DB wraps a GORM client and provides simplified DB lifecycle management.
 type DB struct {
 	client *gorm.DB
+	driver Driver
+}
+
+// Open opens the database for namespace (typically a sanitized chain ID) on
+// the given driver. SQLite opens <dir>/<namespace>.db and ignores dsn;
+// Postgres connects to dsn and prefixes every table with the namespace.
+func Open(driver Driver, dsn, dir, namespace string, migrateSchema bool) (*DB, error) {
+	return OpenWithSQLiteOptions(driver, dsn, dir, namespace, migrateSchema, DefaultSQLiteOptions())
+}
+
+// OpenWithSQLiteOptions is Open with explicit SQLite settings; zero fields of
+// sqliteOpts keep their defaults. Postgres ignores them.
+func OpenWithSQLiteOptions(driver Driver, dsn, dir, namespace string, migrateSchema bool, sqliteOpts SQLiteOptions) (*DB, error) {
+	switch driver {
+	case DriverSQLite, "":
+		return OpenFileDBWithOptions(dir, namespace+".db", migrateSchema, sqliteOpts.withDefaults())
+	case DriverPostgres:
+		return OpenPostgresDB(dsn, namespace, migrateSchema)
+	default:
+		return nil, fmt.Errorf("unsupported database driver: %s", driver)
+	}
+}
+
+// SQLiteOptions tunes a file-backed SQLite database for concurrent writers
+// (chain listeners, coordinator, TSS sessions all share one file per chain).
+type SQLiteOptions struct {
+	// JournalMode is the SQLite journal mode. "WAL" lets readers proceed
+	// while a writer commits, instead of serializing everything.
+	JournalMode string
+	// BusyTimeout is how long a connection waits on a competing write lock
+	// before failing with "database is locked". Zero fails immediately.
+	BusyTimeout time.Duration
+	// Synchronous is the fsync policy: "OFF", "NORMAL", "FULL" or "EXTRA".
+	// NORMAL is durable across application crashes in WAL mode.
+	Synchronous string
+	// CacheSizeKB is the in-memory page cache size.
+	CacheSizeKB int
+}
+
+// DefaultSQLiteOptions returns the settings used by OpenFileDB.
+func DefaultSQLiteOptions() SQLiteOptions {
+	return SQLiteOptions{
+		JournalMode: "WAL",
+		BusyTimeout: 5 * time.Second,
+		Synchronous: "NORMAL",
+		CacheSizeKB: 64000,
+	}
+}
+
+// withDefaults fills the zero fields of o from DefaultSQLiteOptions.
+func (o SQLiteOptions) withDefaults() SQLiteOptions {
+	d := DefaultSQLiteOptions()
+	if o.JournalMode == "" {
+		o.JournalMode = d.JournalMode
+	}
+	if o.BusyTimeout == 0 {
+		o.BusyTimeout = d.BusyTimeout
+	}
+	if o.Synchronous == "" {
+		o.Synchronous = d.Synchronous
+	}
+	if o.CacheSizeKB == 0 {
+		o.CacheSizeKB = d.CacheSizeKB
+	}
+	return o
+}
+
+// dsnParams renders the per-connection settings as go-sqlite3 DSN parameters,
+// so every pooled connection gets them rather than just the first one.
+func (o SQLiteOptions) dsnParams() string {
+	return fmt.Sprintf("_journal_mode=%s&_busy_timeout=%d&_synchronous=%s&_cache_size=-%d&_foreign_keys=1&cache=shared&mode=rwc",
+		o.JournalMode, o.BusyTimeout.Milliseconds(), o.Synchronous, o.CacheSizeKB)
 }
 
 // OpenFileDB opens (or creates) a file-backed SQLite database located in the given directory.
-// If `migrateSchema` is true, all defined schema models are automatically migrated.
+// If `migrateSchema` is true, pending schema migrations are applied.
 func OpenFileDB(dir, filename string, migrateSchema bool) (*DB, error) {
+	return OpenFileDBWithOptions(dir, filename, migrateSchema, DefaultSQLiteOptions())
+}
+
+// OpenFileDBWithOptions is OpenFileDB with explicit journal, locking and cache settings.
+func OpenFileDBWithOptions(dir, filename string, migrateSchema bool, opts SQLiteOptions) (*DB, error) {
 	dsn, err := prepareFilePath(dir, filename)
 	if err != nil {
 		return nil, fmt.Errorf("failed to prepare database path: %w", err)
 	}
-	return openSQLite(dsn, migrateSchema)
+	return openSQLiteWithOptions(dsn, migrateSchema, opts)
 }
 
 // OpenInMemoryDB opens a non-persistent SQLite database in memory.
@@ -57,26 +191,30 @@ func OpenInMemoryDB(migrateSchema bool) (*DB, error) {
 	return openSQLite(InMemorySQLiteDSN, migrateSchema)
 }
 
-// openSQLite creates a GORM-backed database instance using the given SQLite DSN.
-// If migrateSchema is true, GORM auto-migrates all schema models.
+// openSQLite creates a GORM-backed database instance using the given SQLite DSN
+// and the default options.
 func openSQLite(dsn string, migrateSchema bool) (*DB, error) {
+	return openSQLiteWithOptions(dsn, migrateSchema, DefaultSQLiteOptions())
+}
+
+// openSQLiteWithOptions creates a GORM-backed database instance using the given SQLite DSN.
+// If migrateSchema is true, pending schema migrations are applied.
+func openSQLiteWithOptions(dsn string, migrateSchema bool, opts SQLiteOptions) (*DB, error) {
 	// Add SQLite connection parameters for concurrent access
 	// Only add parameters if it's a file database (not in-memory)
-	if dsn != InMemorySQLiteDSN && !strings.Contains(dsn, "?") {
-		dsn += "?_journal_mode=WAL&_busy_timeout=5000&cache=shared&mode=rwc"
+	dialector := sqlite.Open(dsn)
+	if dsn != InMemorySQLiteDSN {
+		if !strings.Contains(dsn, "?") {
+			dsn += "?" + opts.dsnParams()
+		}
+		dialector = sqlite.New(sqlite.Config{DriverName: sqliteDriverName, DSN: dsn})
 	}
 
-	db, err := gorm.Open(sqlite.Open(dsn), newGormConfig())
+	db, err := gorm.Open(dialector, newGormConfig())
 	if err != nil {
 		return nil, fmt.Errorf("failed to open SQLite database: %w", err)
 	}
 
-	if migrateSchema {
-		if err := db.AutoMigrate(schemaModels()...); err != nil {
-			return nil, fmt.Errorf("failed to auto-migrate database schema: %w", err)
-		}
-	}
-
 	// Configure connection pool for better concurrent access
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -97,36 +235,60 @@ func openSQLite(dsn string, migrateSchema bool) (*DB, error) {
 	// Set maximum lifetime of a connection
 	sqlDB.SetConnMaxLifetime(0) // Connections don't expire
 
-	// Apply SQLite performance optimizations for file-based databases
-	if err := optimizeSQLiteSettings(db, dsn); err != nil {
-		return nil, fmt.Errorf("failed to apply SQLite optimizations: %w", err)
+	// Migrate only once the pool is pinned: each new :memory: connection is a
+	// separate empty database, so migrations must share the single connection.
+	if migrateSchema {
+		if err := migrate(db); err != nil {
+			return nil, fmt.Errorf("failed to migrate database schema: %w", err)
+		}
 	}
 
-	return &DB{client: db}, nil
+	return &DB{client: db, driver: DriverSQLite}, nil
 }
 
-// optimizeSQLiteSettings applies performance-oriented PRAGMA settings to SQLite
-func optimizeSQLiteSettings(db *gorm.DB, dsn string) error {
-	// Skip optimizations for in-memory databases as they don't support all PRAGMAs
-	if dsn == InMemorySQLiteDSN {
-		return nil
+// OpenPostgresDB opens a Postgres database at dsn. Tables are prefixed with
+// "<namespace>_" so several chains can share one database without their
+// State/Event rows colliding. An empty namespace uses unprefixed tables.
+func OpenPostgresDB(dsn, namespace string, migrateSchema bool) (*DB, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("postgres DSN is required")
 	}
 
-	pragmas := []string{
-		"PRAGMA synchronous = NORMAL",  // Faster writes, still safe in WAL mode (2-10x faster)
-		"PRAGMA cache_size = -64000",   // 64MB in-memory page cache (vs default ~2MB)
-		"PRAGMA temp_store = MEMORY",   // Temporary tables and indices stored in RAM
-		"PRAGMA mmap_size = 268435456", // 256MB memory-mapped I/O for faster reads
-		"PRAGMA foreign_keys = ON",     // Enforce foreign key constraints (data integrity)
+	cfg := newGormConfig()
+	if prefix := tablePrefix(namespace); prefix != "" {
+		cfg.NamingStrategy = schema.NamingStrategy{TablePrefix: prefix}
 	}
 
-	for _, pragma := range pragmas {
-		if err := db.Exec(pragma).Error; err != nil {
-			return fmt.Errorf("failed to execute %s: %w", pragma, err)
+	db, err := gorm.Open(postgres.Open(dsn), cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open Postgres database: %w", err)
+	}
+
+	if migrateSchema {
+		if err := migrate(db); err != nil {
+			return nil, fmt.Errorf("failed to migrate database schema: %w", err)
 		}
 	}
 
-	return nil
+	return &DB{client: db, driver: DriverPostgres}, nil
+}
+
+// tablePrefix turns a namespace into a lowercase identifier-safe table prefix,
+// e.g. "push_42101-1" -> "push_42101_1_".
+func tablePrefix(namespace string) string {
+	if namespace == "" {
+		return ""
+	}
+	var b strings.Builder
+	for _, r := range strings.ToLower(namespace) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune('_')
+		}
+	}
+	b.WriteRune('_')
+	return b.String()
 }
 
 // Client returns the internal *gorm.DB instance for direct usage in queries.
@@ -134,6 +296,11 @@ func (d *DB) Client() *gorm.DB {
 	return d.client
 }
 
+// Driver reports which database engine backs this DB.
+func (d *DB) Driver() Driver {
+	return d.driver
+}
+
 // Close safely closes the underlying database connection.
 func (d *DB) Close() error {
 	sqlDB, err := d.client.DB()
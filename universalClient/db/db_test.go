@@ -1,8 +1,11 @@
 package db
 
 import (
+	"context"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/stretchr/testify/assert"
@@ -87,6 +90,155 @@ func TestDB_PragmaOptimizations(t *testing.T) {
 	assert.Equal(t, 1, fkEnabled)
 }
 
+func TestDB_SQLiteOptions(t *testing.T) {
+	t.Run("options are applied", func(t *testing.T) {
+		opts := DefaultSQLiteOptions()
+		opts.JournalMode = "DELETE"
+		opts.Synchronous = "FULL"
+		opts.CacheSizeKB = 2000
+
+		db, err := OpenFileDBWithOptions(t.TempDir(), "opts.db", true, opts)
+		require.NoError(t, err)
+		defer db.Close()
+
+		var journalMode string
+		require.NoError(t, db.Client().Raw("PRAGMA journal_mode").Scan(&journalMode).Error)
+		assert.Equal(t, "delete", journalMode)
+
+		var syncMode int
+		require.NoError(t, db.Client().Raw("PRAGMA synchronous").Scan(&syncMode).Error)
+		assert.Equal(t, 2, syncMode)
+
+		var busyTimeout int
+		require.NoError(t, db.Client().Raw("PRAGMA busy_timeout").Scan(&busyTimeout).Error)
+		assert.Equal(t, 5000, busyTimeout)
+	})
+
+	t.Run("Open fills unset options from the defaults", func(t *testing.T) {
+		db, err := OpenWithSQLiteOptions(DriverSQLite, "", t.TempDir(), "eip155_1", true, SQLiteOptions{BusyTimeout: 2 * time.Second})
+		require.NoError(t, err)
+		defer db.Close()
+
+		var busyTimeout int
+		require.NoError(t, db.Client().Raw("PRAGMA busy_timeout").Scan(&busyTimeout).Error)
+		assert.Equal(t, 2000, busyTimeout)
+
+		var journalMode string
+		require.NoError(t, db.Client().Raw("PRAGMA journal_mode").Scan(&journalMode).Error)
+		assert.Equal(t, "wal", journalMode)
+
+		var cacheSize int
+		require.NoError(t, db.Client().Raw("PRAGMA cache_size").Scan(&cacheSize).Error)
+		assert.Equal(t, -64000, cacheSize)
+	})
+
+	t.Run("settings apply to every pooled connection", func(t *testing.T) {
+		opts := DefaultSQLiteOptions()
+		opts.CacheSizeKB = 3000
+
+		db, err := OpenFileDBWithOptions(t.TempDir(), "pool.db", true, opts)
+		require.NoError(t, err)
+		defer db.Close()
+
+		sqlDB, err := db.Client().DB()
+		require.NoError(t, err)
+		ctx := context.Background()
+		first, err := sqlDB.Conn(ctx)
+		require.NoError(t, err)
+		defer first.Close()
+		// Holding the first connection forces the pool to open a second one.
+		second, err := sqlDB.Conn(ctx)
+		require.NoError(t, err)
+		defer second.Close()
+
+		var cacheSize, tempStore, foreignKeys int
+		require.NoError(t, second.QueryRowContext(ctx, "PRAGMA cache_size").Scan(&cacheSize))
+		require.NoError(t, second.QueryRowContext(ctx, "PRAGMA temp_store").Scan(&tempStore))
+		require.NoError(t, second.QueryRowContext(ctx, "PRAGMA foreign_keys").Scan(&foreignKeys))
+		assert.Equal(t, -3000, cacheSize)
+		assert.Equal(t, 2, tempStore) // MEMORY
+		assert.Equal(t, 1, foreignKeys)
+	})
+
+	t.Run("concurrent writers succeed with defaults", func(t *testing.T) {
+		dir := t.TempDir()
+		const writers = 8
+		const perWriter = 25
+
+		handles := make([]*DB, writers)
+		for i := range handles {
+			db, err := OpenFileDB(dir, "concurrent.db", true)
+			require.NoError(t, err)
+			defer db.Close()
+			handles[i] = db
+		}
+
+		var wg sync.WaitGroup
+		errs := make(chan error, writers*perWriter)
+		for i, db := range handles {
+			wg.Add(1)
+			go func(i int, db *DB) {
+				defer wg.Done()
+				for j := 0; j < perWriter; j++ {
+					errs <- db.Client().Create(&store.State{BlockHeight: uint64(i*perWriter + j)}).Error
+				}
+			}(i, db)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		var count int64
+		require.NoError(t, handles[0].Client().Model(&store.State{}).Count(&count).Error)
+		assert.Equal(t, int64(writers*perWriter), count)
+	})
+
+	t.Run("busy timeout waits out a competing writer", func(t *testing.T) {
+		for _, tc := range []struct {
+			name        string
+			busyTimeout time.Duration
+			wantLocked  bool
+		}{
+			{name: "no timeout", busyTimeout: 0, wantLocked: true},
+			{name: "with timeout", busyTimeout: 5 * time.Second, wantLocked: false},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				dir := t.TempDir()
+				opts := DefaultSQLiteOptions()
+				opts.BusyTimeout = tc.busyTimeout
+
+				holder, err := OpenFileDBWithOptions(dir, "lock.db", true, opts)
+				require.NoError(t, err)
+				defer holder.Close()
+				writer, err := OpenFileDBWithOptions(dir, "lock.db", false, opts)
+				require.NoError(t, err)
+				defer writer.Close()
+
+				// Hold the write lock for a short while in another handle.
+				tx := holder.Client().Begin()
+				require.NoError(t, tx.Create(&store.State{BlockHeight: 1}).Error)
+				released := make(chan struct{})
+				go func() {
+					time.Sleep(200 * time.Millisecond)
+					tx.Commit()
+					close(released)
+				}()
+
+				err = writer.Client().Create(&store.State{BlockHeight: 2}).Error
+				<-released
+				if tc.wantLocked {
+					require.Error(t, err)
+					assert.Contains(t, err.Error(), "locked")
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		}
+	})
+}
+
 func TestDB_OpenWithoutMigration(t *testing.T) {
 	db, err := OpenInMemoryDB(false)
 	require.NoError(t, err)
@@ -119,6 +271,117 @@ func TestDB_SchemaModels(t *testing.T) {
 	assert.Len(t, models, 2)
 }
 
+func TestDB_Open(t *testing.T) {
+	t.Run("sqlite driver opens namespaced file", func(t *testing.T) {
+		dir := t.TempDir()
+		db, err := Open(DriverSQLite, "", dir, "eip155_1", true)
+		require.NoError(t, err)
+		defer db.Close()
+
+		assert.FileExists(t, filepath.Join(dir, "eip155_1.db"))
+		assert.Equal(t, DriverSQLite, db.Driver())
+		runEventRoundTripTest(t, db)
+	})
+
+	t.Run("empty driver defaults to sqlite", func(t *testing.T) {
+		db, err := Open("", "", t.TempDir(), "push_42101-1", true)
+		require.NoError(t, err)
+		defer db.Close()
+		assert.Equal(t, DriverSQLite, db.Driver())
+	})
+
+	t.Run("postgres requires DSN", func(t *testing.T) {
+		db, err := Open(DriverPostgres, "", t.TempDir(), "eip155_1", true)
+		require.ErrorContains(t, err, "postgres DSN is required")
+		require.Nil(t, db)
+	})
+
+	t.Run("unsupported driver", func(t *testing.T) {
+		db, err := Open("mysql", "", t.TempDir(), "eip155_1", true)
+		require.ErrorContains(t, err, "unsupported database driver")
+		require.Nil(t, db)
+	})
+}
+
+func TestDB_InMemoryEventRoundTrip(t *testing.T) {
+	db, err := OpenInMemoryDB(true)
+	require.NoError(t, err)
+	defer db.Close()
+
+	runEventRoundTripTest(t, db)
+}
+
+func TestStore_InMemoryRoundTrip(t *testing.T) {
+	db, err := OpenInMemoryDB(true)
+	require.NoError(t, err)
+	var s Store = db
+	defer s.Close()
+
+	assert.Equal(t, DriverSQLite, s.Driver())
+	runEventRoundTripTest(t, s)
+}
+
+func TestTablePrefix(t *testing.T) {
+	assert.Equal(t, "", tablePrefix(""))
+	assert.Equal(t, "eip155_1_", tablePrefix("eip155_1"))
+	assert.Equal(t, "push_42101_1_", tablePrefix("push_42101-1"))
+	assert.Equal(t, "solana_abc_", tablePrefix("solana_ABC"))
+}
+
+// runEventRoundTripTest stores a TSS event and outbound events through the
+// Store interface and reads them back, covering the record shapes every
+// backend must persist.
+func runEventRoundTripTest(t *testing.T, db Store) {
+	events := []store.Event{
+		{
+			EventID:           "tss-keygen-1",
+			BlockHeight:       100,
+			ExpiryBlockHeight: 200,
+			Type:              store.EventTypeKeygen,
+			ConfirmationType:  store.ConfirmationInstant,
+			Status:            store.StatusConfirmed,
+			EventData:         []byte(`{"key_id":"k1"}`),
+		},
+		{
+			EventID:           "0xabc",
+			BlockHeight:       101,
+			ExpiryBlockHeight: 300,
+			Type:              store.EventTypeSignOutbound,
+			ConfirmationType:  store.ConfirmationInstant,
+			Status:            store.StatusBroadcasted,
+			EventData:         []byte(`{"tx_id":"0xabc"}`),
+			BroadcastedTxHash: "eip155:1:0xdef",
+			ServedEndpoints:   []byte(`{"broadcast":"rpc-1"}`),
+		},
+		{
+			EventID:           "0xdef:3",
+			BlockHeight:       5000,
+			Type:              store.EventTypeOutbound,
+			ConfirmationType:  store.ConfirmationStandard,
+			Status:            store.StatusPending,
+			EventData:         []byte(`{"tx_id":"0xabc","success":true}`),
+			VoteTxHash:        "0x123",
+		},
+	}
+	for i := range events {
+		require.NoError(t, db.Client().Create(&events[i]).Error)
+	}
+
+	for _, want := range events {
+		var got store.Event
+		require.NoError(t, db.Client().Where("event_id = ?", want.EventID).First(&got).Error)
+		assert.Equal(t, want.Type, got.Type)
+		assert.Equal(t, want.Status, got.Status)
+		assert.Equal(t, want.BlockHeight, got.BlockHeight)
+		assert.Equal(t, want.ExpiryBlockHeight, got.ExpiryBlockHeight)
+		assert.Equal(t, want.EventData, got.EventData)
+		assert.Equal(t, want.BroadcastedTxHash, got.BroadcastedTxHash)
+		assert.Equal(t, want.ConfirmationType, got.ConfirmationType)
+		assert.Equal(t, want.VoteTxHash, got.VoteTxHash)
+		assert.Equal(t, want.ServedEndpoints, got.ServedEndpoints)
+	}
+}
+
 func runSampleInsertSelectTest(t *testing.T, db *DB) {
 	// Given a sample row
 	entry := store.State{
@@ -0,0 +1,171 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// migration is one versioned schema change. IDs are strictly increasing and
+// never reused; once released, a migration's Up must not change. Every
+// schema change to the store models, even an added column, goes in a new
+// migration; nothing migrates the models themselves.
+type migration struct {
+	ID   uint
+	Name string
+	Up   func(tx *gorm.DB) error
+}
+
+// schemaMigration records an applied migration in the schema_migrations table.
+type schemaMigration struct {
+	ID        uint `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// migrations is the ordered schema history. Append only. Each Up works on
+// snapshot structs declared next to it rather than the live store models, so
+// what it creates never changes when the models do. Snapshots name their
+// table after the model through the namer, keeping any namespace prefix.
+var migrations = []migration{
+	{
+		// Baseline: the schema previously created by a bare AutoMigrate. Running
+		// it against a pre-versioning database is a no-op apart from recording it.
+		ID:   1,
+		Name: "baseline_state_and_events",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&baselineState{}, &baselineEvent{})
+		},
+	},
+	{
+		// Per-event record of which RPC endpoint served each outbound call.
+		// Databases whose baseline was applied by an earlier build, which
+		// migrated the live models, may already have it.
+		ID:   2,
+		Name: "event_served_endpoints",
+		Up: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&servedEndpointsEvent{}, "ServedEndpoints") {
+				return nil
+			}
+			return tx.Migrator().AddColumn(&servedEndpointsEvent{}, "ServedEndpoints")
+		},
+	},
+	{
+		// Raw source-chain events kept for audit/replay of inbound votes.
+		ID:   3,
+		Name: "raw_events",
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&rawEvent{})
+		},
+	},
+	{
+		// Observation start point recorded when a chain is first enabled.
+		// Databases whose baseline was applied by an earlier build, which
+		// migrated the live models, may already have it.
+		ID:   4,
+		Name: "state_enable_height",
+		Up: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&enableHeightState{}, "EnableHeight") {
+				return nil
+			}
+			return tx.Migrator().AddColumn(&enableHeightState{}, "EnableHeight")
+		},
+	},
+}
+
+// baselineState is store.State as of migration 1.
+type baselineState struct {
+	gorm.Model
+	BlockHeight uint64
+}
+
+func (baselineState) TableName(namer schema.Namer) string { return namer.TableName("State") }
+
+// baselineEvent is store.Event as of migration 1.
+type baselineEvent struct {
+	gorm.Model
+	EventID           string `gorm:"uniqueIndex;not null"`
+	BlockHeight       uint64 `gorm:"index;not null"`
+	ExpiryBlockHeight uint64 `gorm:"index"`
+	Type              string `gorm:"index;not null"`
+	ConfirmationType  string `gorm:"index;not null"`
+	Status            string `gorm:"index;not null"`
+	EventData         []byte
+	VoteTxHash        string `gorm:"default:NULL"`
+	BroadcastedTxHash string `gorm:"default:NULL"`
+}
+
+func (baselineEvent) TableName(namer schema.Namer) string { return namer.TableName("Event") }
+
+// servedEndpointsEvent is the column migration 2 adds to events.
+type servedEndpointsEvent struct {
+	ServedEndpoints []byte
+}
+
+func (servedEndpointsEvent) TableName(namer schema.Namer) string { return namer.TableName("Event") }
+
+// rawEvent is store.RawEvent as of migration 3.
+type rawEvent struct {
+	gorm.Model
+	EventID       string `gorm:"uniqueIndex;not null"`
+	UniversalTxID string `gorm:"index"`
+	BlockHeight   uint64 `gorm:"index;not null"`
+	Payload       []byte
+}
+
+func (rawEvent) TableName(namer schema.Namer) string { return namer.TableName("RawEvent") }
+
+// enableHeightState is the column migration 4 adds to states.
+type enableHeightState struct {
+	EnableHeight *uint64
+}
+
+func (enableHeightState) TableName(namer schema.Namer) string { return namer.TableName("State") }
+
+// migrate brings the database schema up to date with the registered migrations.
+func migrate(db *gorm.DB) error {
+	return applyMigrations(db, migrations)
+}
+
+// applyMigrations runs every migration in list whose ID is not yet recorded,
+// in ID order, each in its own transaction together with its record.
+func applyMigrations(db *gorm.DB, list []migration) error {
+	if err := db.AutoMigrate(&schemaMigration{}); err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	var applied []schemaMigration
+	if err := db.Find(&applied).Error; err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	done := make(map[uint]bool, len(applied))
+	for _, m := range applied {
+		done[m.ID] = true
+	}
+
+	var lastID uint
+	for _, m := range list {
+		if m.ID <= lastID {
+			return fmt.Errorf("migration %d (%s) is out of order", m.ID, m.Name)
+		}
+		lastID = m.ID
+
+		if done[m.ID] {
+			continue
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := m.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{ID: m.ID, Name: m.Name, AppliedAt: time.Now().UTC()}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %w", m.ID, m.Name, err)
+		}
+	}
+
+	return nil
+}
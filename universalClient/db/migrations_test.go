@@ -0,0 +1,206 @@
+package db
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+)
+
+func appliedMigrationIDs(t *testing.T, db *DB) []uint {
+	var rows []schemaMigration
+	require.NoError(t, db.Client().Order("id").Find(&rows).Error)
+	ids := make([]uint, 0, len(rows))
+	for _, r := range rows {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestMigrate_FreshDB(t *testing.T) {
+	db, err := OpenInMemoryDB(true)
+	require.NoError(t, err)
+	defer db.Close()
+
+	assert.Equal(t, []uint{1, 2, 3, 4}, appliedMigrationIDs(t, db))
+	assert.True(t, db.Client().Migrator().HasTable(&store.State{}))
+	assert.True(t, db.Client().Migrator().HasTable(&store.Event{}))
+	assert.True(t, db.Client().Migrator().HasTable(&store.RawEvent{}))
+}
+
+func TestMigrate_AppliesInOrder(t *testing.T) {
+	db, err := OpenInMemoryDB(false)
+	require.NoError(t, err)
+	defer db.Close()
+
+	var order []uint
+	step := func(id uint) migration {
+		return migration{ID: id, Name: "step", Up: func(tx *gorm.DB) error {
+			order = append(order, id)
+			return nil
+		}}
+	}
+
+	require.NoError(t, applyMigrations(db.Client(), []migration{step(1), step(2), step(3)}))
+	assert.Equal(t, []uint{1, 2, 3}, order)
+	assert.Equal(t, []uint{1, 2, 3}, appliedMigrationIDs(t, db))
+}
+
+func TestMigrate_Idempotent(t *testing.T) {
+	dir := t.TempDir()
+
+	db, err := OpenFileDB(dir, "migrate.db", true)
+	require.NoError(t, err)
+	require.NoError(t, db.Client().Create(&store.State{BlockHeight: 42}).Error)
+	require.NoError(t, db.Close())
+
+	// Reopening an already-migrated DB must not re-run or re-record anything.
+	db, err = OpenFileDB(dir, "migrate.db", true)
+	require.NoError(t, err)
+	defer db.Close()
+
+	assert.Equal(t, []uint{1, 2, 3, 4}, appliedMigrationIDs(t, db))
+	var state store.State
+	require.NoError(t, db.Client().First(&state).Error)
+	assert.Equal(t, uint64(42), state.BlockHeight)
+
+	runs := 0
+	list := append(append([]migration{}, migrations...), migration{ID: 5, Name: "counted", Up: func(tx *gorm.DB) error {
+		runs++
+		return nil
+	}})
+	require.NoError(t, applyMigrations(db.Client(), list))
+	require.NoError(t, applyMigrations(db.Client(), list))
+	assert.Equal(t, 1, runs)
+	assert.Equal(t, []uint{1, 2, 3, 4, 5}, appliedMigrationIDs(t, db))
+}
+
+func TestMigrate_PreVersioningDB(t *testing.T) {
+	// A database created by the old bare AutoMigrate has tables but no
+	// migrations table; the baseline must adopt it without losing data.
+	db, err := OpenInMemoryDB(false)
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Client().AutoMigrate(&baselineState{}, &baselineEvent{}))
+	require.NoError(t, db.Client().Create(&baselineState{BlockHeight: 7}).Error)
+
+	require.NoError(t, migrate(db.Client()))
+	assert.Equal(t, []uint{1, 2, 3, 4}, appliedMigrationIDs(t, db))
+
+	var state store.State
+	require.NoError(t, db.Client().First(&state).Error)
+	assert.Equal(t, uint64(7), state.BlockHeight)
+}
+
+func TestMigrate_CoversStoreModels(t *testing.T) {
+	// Migrations never touch the live models, so a field added to one without
+	// a migration would be missing from every database.
+	db, err := OpenInMemoryDB(true)
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := db.Client().Migrator()
+	for _, model := range []any{&store.State{}, &store.Event{}, &store.RawEvent{}} {
+		stmt := &gorm.Statement{DB: db.Client()}
+		require.NoError(t, stmt.Parse(model))
+		require.True(t, migrator.HasTable(model), stmt.Schema.Table)
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			assert.True(t, migrator.HasColumn(model, field.DBName),
+				"%s.%s has no migration", stmt.Schema.Table, field.DBName)
+		}
+	}
+}
+
+func TestMigrate_SnapshotsKeepNamespacePrefix(t *testing.T) {
+	namer := schema.NamingStrategy{TablePrefix: "eip155_1_"}
+	for model, want := range map[any]string{
+		&baselineState{}:        "eip155_1_states",
+		&baselineEvent{}:        "eip155_1_events",
+		&servedEndpointsEvent{}: "eip155_1_events",
+		&rawEvent{}:             "eip155_1_raw_events",
+		&enableHeightState{}:    "eip155_1_states",
+	} {
+		s, err := schema.Parse(model, &sync.Map{}, namer)
+		require.NoError(t, err)
+		assert.Equal(t, want, s.Table)
+	}
+}
+
+func TestMigrate_AddsServedEndpoints(t *testing.T) {
+	// A database migrated before served_endpoints existed gets the column
+	// without losing its events.
+	db, err := OpenInMemoryDB(true)
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := db.Client().Migrator()
+	require.NoError(t, migrator.DropColumn(&store.Event{}, "ServedEndpoints"))
+	require.NoError(t, db.Client().Delete(&schemaMigration{ID: 2}).Error)
+	require.NoError(t, db.Client().Exec(
+		"INSERT INTO events (event_id, block_height, type, confirmation_type, status) VALUES (?, ?, ?, ?, ?)",
+		"ev-1", 1, store.EventTypeSignOutbound, store.ConfirmationStandard, store.StatusSigned).Error)
+
+	require.NoError(t, migrate(db.Client()))
+	assert.Equal(t, []uint{1, 2, 3, 4}, appliedMigrationIDs(t, db))
+	assert.True(t, migrator.HasColumn(&store.Event{}, "ServedEndpoints"))
+
+	var event store.Event
+	require.NoError(t, db.Client().Where("event_id = ?", "ev-1").First(&event).Error)
+	assert.Empty(t, event.ServedEndpoints)
+}
+
+func TestMigrate_AddsStateEnableHeight(t *testing.T) {
+	// A database migrated before enable_height existed gets the column and
+	// keeps its checkpoint, with no enable height recorded.
+	db, err := OpenInMemoryDB(true)
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator := db.Client().Migrator()
+	require.NoError(t, migrator.DropColumn(&store.State{}, "EnableHeight"))
+	require.NoError(t, db.Client().Delete(&schemaMigration{ID: 4}).Error)
+	require.NoError(t, db.Client().Exec("INSERT INTO states (block_height) VALUES (?)", 88).Error)
+
+	require.NoError(t, migrate(db.Client()))
+	assert.Equal(t, []uint{1, 2, 3, 4}, appliedMigrationIDs(t, db))
+	assert.True(t, migrator.HasColumn(&store.State{}, "EnableHeight"))
+
+	var state store.State
+	require.NoError(t, db.Client().First(&state).Error)
+	assert.Equal(t, uint64(88), state.BlockHeight)
+	assert.Nil(t, state.EnableHeight)
+}
+
+func TestMigrate_FailureIsNotRecorded(t *testing.T) {
+	db, err := OpenInMemoryDB(false)
+	require.NoError(t, err)
+	defer db.Close()
+
+	list := []migration{
+		{ID: 1, Name: "ok", Up: func(tx *gorm.DB) error { return nil }},
+		{ID: 2, Name: "boom", Up: func(tx *gorm.DB) error { return errors.New("boom") }},
+	}
+	err = applyMigrations(db.Client(), list)
+	require.ErrorContains(t, err, "migration 2 (boom) failed")
+	assert.Equal(t, []uint{1}, appliedMigrationIDs(t, db))
+}
+
+func TestMigrate_RejectsOutOfOrder(t *testing.T) {
+	db, err := OpenInMemoryDB(false)
+	require.NoError(t, err)
+	defer db.Close()
+
+	noop := func(tx *gorm.DB) error { return nil }
+	err = applyMigrations(db.Client(), []migration{{ID: 2, Name: "b", Up: noop}, {ID: 1, Name: "a", Up: noop}})
+	require.ErrorContains(t, err, "out of order")
+}
@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/rs/zerolog"
+)
+
+// Bounds for runtime level changes: verbosity can go down to trace, but
+// errors are never silenced.
+const (
+	mostVerboseLevel = zerolog.TraceLevel
+	quietestLevel    = zerolog.ErrorLevel
+)
+
+// SetLevel sets the level of every logger in the process, including those
+// handed to subsystems (chains, TSS) before the change.
+func SetLevel(level zerolog.Level) {
+	zerolog.SetGlobalLevel(level)
+}
+
+// Level returns the current process-wide log level.
+func Level() zerolog.Level {
+	return zerolog.GlobalLevel()
+}
+
+// WatchLevelSignals changes the log level at runtime until ctx is done:
+// SIGUSR1 makes logging one level more verbose (down to trace), SIGUSR2 one
+// level quieter (up to error). Each change is logged whatever the new level,
+// so `kill -USR1 <pid>` on a live node turns on debug logs without a restart.
+func WatchLevelSignals(ctx context.Context, log zerolog.Logger) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGUSR1, syscall.SIGUSR2)
+
+	go func() {
+		defer signal.Stop(sigCh)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case sig := <-sigCh:
+				from := Level()
+				to := from
+				switch {
+				case sig == syscall.SIGUSR1 && from > mostVerboseLevel:
+					to = from - 1
+				case sig == syscall.SIGUSR2 && from < quietestLevel:
+					to = from + 1
+				}
+				SetLevel(to)
+				log.Log().
+					Str("signal", sig.String()).
+					Str("from", from.String()).
+					Str("to", to.String()).
+					Msg("log level changed")
+			}
+		}
+	}()
+}
@@ -3,6 +3,7 @@ package logger
 import (
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -10,6 +11,7 @@ import (
 
 // New creates a new zerolog logger with the specified configuration.
 // Supports console/json format, level filtering, and optional sampling.
+// The level is process-wide (see SetLevel) so it can be changed at runtime.
 func New(logLevel int, logFormat string, logSampler bool) zerolog.Logger {
 	level := zerolog.Level(logLevel)
 	if level < zerolog.TraceLevel || level > zerolog.Disabled {
@@ -24,8 +26,9 @@ func New(logLevel int, logFormat string, logSampler bool) zerolog.Logger {
 		}
 	}
 
+	SetLevel(level)
+
 	logger := zerolog.New(writer).
-		Level(level).
 		With().
 		Timestamp().
 		Logger()
@@ -35,3 +38,30 @@ func New(logLevel int, logFormat string, logSampler bool) zerolog.Logger {
 	}
 	return logger
 }
+
+// TraceIDField is the log field that correlates every stage of one outbound
+// (observe, sign, broadcast, resolve, vote) across all validators.
+const TraceIDField = "trace_id"
+
+// traceIDLen keeps trace IDs short enough to scan while staying unique in practice.
+const traceIDLen = 16
+
+// TraceID derives an outbound's trace ID from its universal tx ID. It is
+// deterministic, so every validator logs the same ID for the same outbound.
+func TraceID(universalTxID string) string {
+	id := strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(universalTxID, "0x"), "0X"))
+	if len(id) > traceIDLen {
+		id = id[:traceIDLen]
+	}
+	return id
+}
+
+// WithTraceID returns l with the trace ID for universalTxID attached, or l
+// unchanged when the ID is empty.
+func WithTraceID(l zerolog.Logger, universalTxID string) zerolog.Logger {
+	id := TraceID(universalTxID)
+	if id == "" {
+		return l
+	}
+	return l.With().Str(TraceIDField, id).Logger()
+}
@@ -1,10 +1,15 @@
 package logger
 
 import (
+	"bytes"
+	"context"
 	"os"
 	"regexp"
 	"strings"
+	"sync"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
@@ -106,3 +111,79 @@ func stripANSI(input string) string {
 	re := regexp.MustCompile(`\x1b\[[0-9;]*m`)
 	return re.ReplaceAllString(input, "")
 }
+
+func TestTraceID(t *testing.T) {
+	full := "0xABCDEF0123456789abcdef0123456789abcdef0123456789abcdef0123456789"
+
+	require.Equal(t, "abcdef0123456789", TraceID(full))
+	require.Equal(t, TraceID(full), TraceID(full[2:]), "0x prefix must not change the trace ID")
+	require.Equal(t, "abc", TraceID("0xABC"))
+	require.Empty(t, TraceID(""))
+}
+
+func TestWithTraceID(t *testing.T) {
+	var buf bytes.Buffer
+	base := zerolog.New(&buf)
+
+	traced := WithTraceID(base, "0xABCDEF0123456789ffff")
+	traced.Info().Msg("traced")
+	require.Contains(t, buf.String(), `"trace_id":"abcdef0123456789"`)
+
+	buf.Reset()
+	untraced := WithTraceID(base, "")
+	untraced.Info().Msg("untraced")
+	require.NotContains(t, buf.String(), "trace_id")
+}
+
+// syncBuffer is a bytes.Buffer safe to write from the signal goroutine.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatchLevelSignals(t *testing.T) {
+	defer SetLevel(zerolog.InfoLevel)
+
+	var buf syncBuffer
+	log := New(int(zerolog.InfoLevel), "json", false).Output(&buf)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	WatchLevelSignals(ctx, log)
+
+	// Each handled signal logs one change line, even when the level is at a bound.
+	signalAndWait := func(sig syscall.Signal, want zerolog.Level) {
+		t.Helper()
+		changes := strings.Count(buf.String(), "log level changed")
+		require.NoError(t, syscall.Kill(os.Getpid(), sig))
+		require.Eventually(t, func() bool {
+			return strings.Count(buf.String(), "log level changed") > changes
+		}, time.Second, 5*time.Millisecond)
+		require.Equal(t, want, Level())
+	}
+
+	log.Debug().Msg("hidden_before")
+	signalAndWait(syscall.SIGUSR1, zerolog.DebugLevel)
+	log.Debug().Msg("shown_after")
+	require.NotContains(t, buf.String(), "hidden_before")
+	require.Contains(t, buf.String(), "shown_after")
+
+	signalAndWait(syscall.SIGUSR1, zerolog.TraceLevel)
+	signalAndWait(syscall.SIGUSR1, zerolog.TraceLevel) // floor
+
+	SetLevel(zerolog.WarnLevel)
+	signalAndWait(syscall.SIGUSR2, zerolog.ErrorLevel)
+	signalAndWait(syscall.SIGUSR2, zerolog.ErrorLevel) // errors are never silenced
+}
@@ -0,0 +1,16 @@
+//go:build !unix
+
+package pidfile
+
+import (
+	"errors"
+	"os"
+)
+
+var errWouldBlock = errors.New("lock held")
+
+// lockFile is not implemented without flock(2); Acquire fails rather than
+// let two processes share a home unnoticed.
+func lockFile(*os.File) error {
+	return errors.ErrUnsupported
+}
@@ -0,0 +1,117 @@
+// Package pidfile keeps a single process running per node home: the holder
+// takes an exclusive flock(2) on a lock file and writes its PID there. The
+// kernel drops the lock when the holder exits, so a file left behind by a
+// crashed process is simply locked again.
+package pidfile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrLocked is returned by Acquire when a live process holds the lock.
+var ErrLocked = errors.New("pid file is held by a running process")
+
+// Lock is a held PID file. The lock lasts as long as its file stays open.
+type Lock struct {
+	path string
+	pid  int
+	file *os.File
+}
+
+// Acquire locks path and writes the current PID to it. If another open file
+// already holds the lock it fails with ErrLocked.
+func Acquire(path string) (*Lock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create pid file directory: %w", err)
+	}
+
+	pid := os.Getpid()
+	// One retry: a releasing holder may unlink the file between our open and
+	// lock, leaving us holding a lock on a file no one else can see.
+	for attempt := 0; attempt < 2; attempt++ {
+		f, err := os.OpenFile(filepath.Clean(path), os.O_RDWR|os.O_CREATE, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open pid file %s: %w", path, err)
+		}
+		if err := lockFile(f); err != nil {
+			_ = f.Close()
+			if errors.Is(err, errWouldBlock) {
+				if holder, rerr := readPID(path); rerr == nil {
+					return nil, fmt.Errorf("%w: %s (pid %d)", ErrLocked, path, holder)
+				}
+				return nil, fmt.Errorf("%w: %s", ErrLocked, path)
+			}
+			return nil, fmt.Errorf("failed to lock pid file %s: %w", path, err)
+		}
+		if !samePath(f, path) {
+			_ = f.Close()
+			continue
+		}
+
+		werr := f.Truncate(0)
+		if werr == nil {
+			_, werr = f.WriteAt([]byte(strconv.Itoa(pid)+"\n"), 0)
+		}
+		if werr == nil {
+			werr = f.Sync()
+		}
+		if werr != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("failed to write pid file %s: %w", path, werr)
+		}
+		return &Lock{path: path, pid: pid, file: f}, nil
+	}
+	return nil, fmt.Errorf("failed to acquire pid file %s: raced with another process", path)
+}
+
+// Release removes the PID file if it still holds this lock's PID, then drops
+// the lock.
+func (l *Lock) Release() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+	var rerr error
+	if holder, err := readPID(l.path); err == nil && holder == l.pid {
+		if err := os.Remove(l.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			rerr = fmt.Errorf("failed to remove pid file %s: %w", l.path, err)
+		}
+	}
+	if err := l.file.Close(); err != nil && rerr == nil {
+		rerr = fmt.Errorf("failed to close pid file %s: %w", l.path, err)
+	}
+	l.file = nil
+	return rerr
+}
+
+// Path returns the PID file's location.
+func (l *Lock) Path() string { return l.path }
+
+// samePath reports whether f is still the file at path.
+func samePath(f *os.File, path string) bool {
+	held, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	current, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(held, current)
+}
+
+func readPID(path string) (int, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0, fmt.Errorf("invalid pid in %s", path)
+	}
+	return pid, nil
+}
@@ -0,0 +1,70 @@
+package pidfile
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquire(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "puniversald.pid")
+
+	lock, err := Acquire(path)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(os.Getpid())+"\n", string(data))
+
+	// A second holder is rejected while the first is alive.
+	_, err = Acquire(path)
+	require.ErrorIs(t, err, ErrLocked)
+
+	require.NoError(t, lock.Release())
+	assert.NoFileExists(t, path)
+
+	lock, err = Acquire(path)
+	require.NoError(t, err, "released lock can be acquired again")
+	require.NoError(t, lock.Release())
+}
+
+func TestAcquire_StaleLock(t *testing.T) {
+	for name, content := range map[string]string{
+		"dead process": strconv.Itoa(math.MaxInt32) + "\n",
+		"garbage":      "not-a-pid",
+		// A crashed holder's PID may since have been reused, even by us.
+		"reused pid": strconv.Itoa(os.Getpid()) + "\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "puniversald.pid")
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+			lock, err := Acquire(path)
+			require.NoError(t, err)
+			defer lock.Release()
+
+			pid, err := readPID(path)
+			require.NoError(t, err)
+			assert.Equal(t, os.Getpid(), pid)
+		})
+	}
+}
+
+func TestRelease_LeavesOtherHoldersFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "puniversald.pid")
+	lock, err := Acquire(path)
+	require.NoError(t, err)
+
+	// The file was taken over (e.g. after being judged stale); releasing the
+	// old lock must not delete the new holder's file.
+	require.NoError(t, os.WriteFile(path, []byte("1\n"), 0o600))
+	require.NoError(t, lock.Release())
+	assert.FileExists(t, path)
+
+	var nilLock *Lock
+	assert.NoError(t, nilLock.Release())
+}
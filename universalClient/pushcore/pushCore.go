@@ -22,8 +22,10 @@ import (
 	uvalidatortypes "github.com/pushchain/push-chain-node/x/uvalidator/types"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 // Client is a fan-out client that connects to multiple Push Chain gRPC endpoints.
@@ -310,24 +312,42 @@ func (c *Client) GetTx(ctx context.Context, txHash string) (*tx.GetTxResponse, e
 	)
 }
 
-// GetPendingTssEvents retrieves up to the first 1000 pending TSS events from Push Chain.
-// Sorted by process_id ascending (oldest first).
-func (c *Client) GetPendingTssEvents(ctx context.Context) ([]*utsstypes.TssEvent, error) {
-	return retryWithRoundRobin(
-		len(c.utssClients),
-		&c.rr,
-		func(idx int) ([]*utsstypes.TssEvent, error) {
-			resp, err := c.utssClients[idx].AllPendingTssEvents(ctx, &utsstypes.QueryAllPendingTssEventsRequest{
-				Pagination: &query.PageRequest{Limit: 1000},
-			})
-			if err != nil {
-				return nil, err
-			}
-			return resp.Events, nil
-		},
-		"GetPendingTssEvents",
-		c.logger,
+// DefaultPendingPageSize is the page size used by the pending-event queries
+// when the caller passes 0.
+const DefaultPendingPageSize uint64 = 1000
+
+// GetPendingTssEvents retrieves all pending TSS events from Push Chain, fetching
+// pageSize events per request (DefaultPendingPageSize if 0) until the backlog
+// is exhausted.
+func (c *Client) GetPendingTssEvents(ctx context.Context, pageSize uint64) ([]*utsstypes.TssEvent, error) {
+	if pageSize == 0 {
+		pageSize = DefaultPendingPageSize
+	}
+	var (
+		events  []*utsstypes.TssEvent
+		nextKey []byte
 	)
+	for {
+		resp, err := retryWithRoundRobin(
+			len(c.utssClients),
+			&c.rr,
+			func(idx int) (*utsstypes.QueryAllPendingTssEventsResponse, error) {
+				return c.utssClients[idx].AllPendingTssEvents(ctx, &utsstypes.QueryAllPendingTssEventsRequest{
+					Pagination: &query.PageRequest{Key: nextKey, Limit: pageSize},
+				})
+			},
+			"GetPendingTssEvents",
+			c.logger,
+		)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.Events...)
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 || len(resp.Events) == 0 {
+			return events, nil
+		}
+		nextKey = resp.Pagination.NextKey
+	}
 }
 
 // GetPendingFundMigrations retrieves all pending fund migrations from Push Chain.
@@ -347,24 +367,87 @@ func (c *Client) GetPendingFundMigrations(ctx context.Context) ([]*utsstypes.Fun
 	)
 }
 
-// GetAllPendingOutbounds retrieves up to the first 1000 pending outbound transactions from Push Chain.
-// Sorted by created_at (block height) ascending — oldest first.
-func (c *Client) GetAllPendingOutbounds(ctx context.Context) ([]*uexecutortypes.PendingOutboundEntry, []*uexecutortypes.OutboundTx, error) {
+// GetAllPendingOutbounds retrieves all pending outbound transactions from Push
+// Chain, fetching pageSize entries per request (DefaultPendingPageSize if 0)
+// until the backlog is exhausted. Sorted by created_at (block height) ascending — oldest first.
+func (c *Client) GetAllPendingOutbounds(ctx context.Context, pageSize uint64) ([]*uexecutortypes.PendingOutboundEntry, []*uexecutortypes.OutboundTx, error) {
+	if pageSize == 0 {
+		pageSize = DefaultPendingPageSize
+	}
+	var (
+		entries   []*uexecutortypes.PendingOutboundEntry
+		outbounds []*uexecutortypes.OutboundTx
+		offset    uint64
+	)
+	for {
+		resp, err := retryWithRoundRobin(
+			len(c.uexecutorClients),
+			&c.rr,
+			func(idx int) (*uexecutortypes.QueryAllPendingOutboundsResponse, error) {
+				return c.uexecutorClients[idx].AllPendingOutbounds(ctx, &uexecutortypes.QueryAllPendingOutboundsRequest{
+					Pagination: &query.PageRequest{Offset: offset, Limit: pageSize},
+				})
+			},
+			"GetAllPendingOutbounds",
+			c.logger,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		entries = append(entries, resp.Entries...)
+		outbounds = append(outbounds, resp.Outbounds...)
+		// The keeper pages by offset and reports the total; entries whose
+		// outbound is missing are still counted, so advance by the page size.
+		offset += pageSize
+		if resp.Pagination == nil || len(resp.Entries) == 0 || offset >= resp.Pagination.Total {
+			return entries, outbounds, nil
+		}
+	}
+}
+
+// ErrUniversalTxNotFound is returned by GetOutboundStatus when Push Chain has
+// no universal tx with the requested ID.
+var ErrUniversalTxNotFound = errors.New("pushcore: universal tx not found")
+
+// GetOutboundStatus returns the canonical status of a universal tx's outbound
+// leg on Push Chain: OUTBOUND_PENDING while any outbound is still awaiting an
+// observation, and OUTBOUND_SUCCESS / OUTBOUND_FAILED / CANCELED once settled.
+// Returns ErrUniversalTxNotFound for an unknown ID.
+func (c *Client) GetOutboundStatus(ctx context.Context, universalTxID string) (uexecutortypes.UniversalTxStatus, error) {
 	resp, err := retryWithRoundRobin(
 		len(c.uexecutorClients),
 		&c.rr,
-		func(idx int) (*uexecutortypes.QueryAllPendingOutboundsResponse, error) {
-			return c.uexecutorClients[idx].AllPendingOutbounds(ctx, &uexecutortypes.QueryAllPendingOutboundsRequest{
-				Pagination: &query.PageRequest{Limit: 1000},
+		func(idx int) (*uexecutortypes.QueryGetUniversalTxResponse, error) {
+			return c.uexecutorClients[idx].GetUniversalTx(ctx, &uexecutortypes.QueryGetUniversalTxRequest{
+				Id: strings.TrimPrefix(universalTxID, "0x"),
 			})
 		},
-		"GetAllPendingOutbounds",
+		"GetOutboundStatus",
 		c.logger,
 	)
 	if err != nil {
-		return nil, nil, err
+		if status.Code(err) == codes.NotFound {
+			return uexecutortypes.UniversalTxStatus_UNIVERSAL_TX_STATUS_UNSPECIFIED, ErrUniversalTxNotFound
+		}
+		return uexecutortypes.UniversalTxStatus_UNIVERSAL_TX_STATUS_UNSPECIFIED, err
+	}
+	if resp == nil || resp.UniversalTx == nil {
+		return uexecutortypes.UniversalTxStatus_UNIVERSAL_TX_STATUS_UNSPECIFIED, ErrUniversalTxNotFound
+	}
+	return resp.UniversalTx.UniversalStatus, nil
+}
+
+// IsOutboundFinalized reports whether an outbound status is terminal, i.e. no
+// validator should sign or broadcast for it any more.
+func IsOutboundFinalized(s uexecutortypes.UniversalTxStatus) bool {
+	switch s {
+	case uexecutortypes.UniversalTxStatus_OUTBOUND_SUCCESS,
+		uexecutortypes.UniversalTxStatus_OUTBOUND_FAILED,
+		uexecutortypes.UniversalTxStatus_CANCELED:
+		return true
+	default:
+		return false
 	}
-	return resp.Entries, resp.Outbounds, nil
 }
 
 // createGRPCConnection creates a gRPC connection with appropriate transport security.
@@ -2,11 +2,14 @@ package pushcore
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 	"math/big"
 	"testing"
 
 	cmtservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -18,6 +21,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestNew(t *testing.T) {
@@ -672,7 +677,7 @@ func TestClient_GetPendingTssEvents(t *testing.T) {
 			utssClients: []utsstypes.QueryClient{},
 		}
 
-		events, err := client.GetPendingTssEvents(ctx)
+		events, err := client.GetPendingTssEvents(ctx, 0)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "no endpoints configured")
 		assert.Nil(t, events)
@@ -693,7 +698,7 @@ func TestClient_GetPendingTssEvents(t *testing.T) {
 			utssClients: []utsstypes.QueryClient{mockClient},
 		}
 
-		events, err := client.GetPendingTssEvents(ctx)
+		events, err := client.GetPendingTssEvents(ctx, 0)
 		require.NoError(t, err)
 		require.Len(t, events, 2)
 		assert.Equal(t, uint64(100), events[0].ProcessId)
@@ -708,10 +713,30 @@ func TestClient_GetPendingTssEvents(t *testing.T) {
 			},
 		}
 
-		events, err := client.GetPendingTssEvents(ctx)
+		events, err := client.GetPendingTssEvents(ctx, 0)
 		require.Error(t, err)
 		assert.Nil(t, events)
 	})
+
+	t.Run("drains a backlog larger than one page", func(t *testing.T) {
+		backlog := make([]*utsstypes.TssEvent, 25)
+		for i := range backlog {
+			backlog[i] = &utsstypes.TssEvent{Id: uint64(i + 1)}
+		}
+		mockClient := &mockUTSSQueryClient{pendingTssEvents: backlog}
+		client := &Client{
+			logger:      logger,
+			utssClients: []utsstypes.QueryClient{mockClient},
+		}
+
+		events, err := client.GetPendingTssEvents(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 25)
+		for i, e := range events {
+			assert.Equal(t, uint64(i+1), e.Id)
+		}
+		assert.Equal(t, 3, mockClient.pendingTssEventsCalls)
+	})
 }
 
 func TestClient_GetPendingFundMigrations(t *testing.T) {
@@ -776,7 +801,7 @@ func TestClient_GetAllPendingOutbounds(t *testing.T) {
 			uexecutorClients: []uexecutortypes.QueryClient{},
 		}
 
-		entries, outbounds, err := client.GetAllPendingOutbounds(ctx)
+		entries, outbounds, err := client.GetAllPendingOutbounds(ctx, 0)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "no endpoints configured")
 		assert.Nil(t, entries)
@@ -800,7 +825,7 @@ func TestClient_GetAllPendingOutbounds(t *testing.T) {
 			uexecutorClients: []uexecutortypes.QueryClient{mockClient},
 		}
 
-		entries, outbounds, err := client.GetAllPendingOutbounds(ctx)
+		entries, outbounds, err := client.GetAllPendingOutbounds(ctx, 0)
 		require.NoError(t, err)
 		require.Len(t, entries, 1)
 		require.Len(t, outbounds, 1)
@@ -816,11 +841,99 @@ func TestClient_GetAllPendingOutbounds(t *testing.T) {
 			},
 		}
 
-		entries, outbounds, err := client.GetAllPendingOutbounds(ctx)
+		entries, outbounds, err := client.GetAllPendingOutbounds(ctx, 0)
 		require.Error(t, err)
 		assert.Nil(t, entries)
 		assert.Nil(t, outbounds)
 	})
+
+	t.Run("drains a backlog larger than one page", func(t *testing.T) {
+		backlog := make([]*uexecutortypes.PendingOutboundEntry, 25)
+		for i := range backlog {
+			backlog[i] = &uexecutortypes.PendingOutboundEntry{OutboundId: fmt.Sprintf("ob-%d", i)}
+		}
+		mockClient := &mockUExecutorQueryClient{pendingOutbounds: backlog}
+		client := &Client{
+			logger:           logger,
+			uexecutorClients: []uexecutortypes.QueryClient{mockClient},
+		}
+
+		entries, outbounds, err := client.GetAllPendingOutbounds(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 25)
+		require.Len(t, outbounds, 25)
+		assert.Equal(t, "ob-0", entries[0].OutboundId)
+		assert.Equal(t, "ob-24", entries[24].OutboundId)
+		assert.Equal(t, 3, mockClient.allPendingOutboundsCalls)
+	})
+
+	t.Run("a page-aligned backlog stops on total", func(t *testing.T) {
+		backlog := make([]*uexecutortypes.PendingOutboundEntry, 20)
+		for i := range backlog {
+			backlog[i] = &uexecutortypes.PendingOutboundEntry{OutboundId: fmt.Sprintf("ob-%d", i)}
+		}
+		mockClient := &mockUExecutorQueryClient{pendingOutbounds: backlog}
+		client := &Client{
+			logger:           logger,
+			uexecutorClients: []uexecutortypes.QueryClient{mockClient},
+		}
+
+		entries, _, err := client.GetAllPendingOutbounds(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 20)
+		assert.Equal(t, 2, mockClient.allPendingOutboundsCalls)
+	})
+}
+
+func TestClient_GetOutboundStatus(t *testing.T) {
+	logger := zerolog.Nop()
+	ctx := context.Background()
+
+	statuses := map[string]uexecutortypes.UniversalTxStatus{
+		"utx-pending":  uexecutortypes.UniversalTxStatus_OUTBOUND_PENDING,
+		"utx-success":  uexecutortypes.UniversalTxStatus_OUTBOUND_SUCCESS,
+		"utx-failed":   uexecutortypes.UniversalTxStatus_OUTBOUND_FAILED,
+		"utx-canceled": uexecutortypes.UniversalTxStatus_CANCELED,
+	}
+	utxs := make(map[string]*uexecutortypes.UniversalTxLegacy, len(statuses))
+	for id, st := range statuses {
+		utxs[id] = &uexecutortypes.UniversalTxLegacy{UniversalStatus: st}
+	}
+	client := &Client{
+		logger:           logger,
+		uexecutorClients: []uexecutortypes.QueryClient{&mockUExecutorQueryClient{universalTxs: utxs}},
+	}
+
+	for id, want := range statuses {
+		t.Run(id, func(t *testing.T) {
+			got, err := client.GetOutboundStatus(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+			assert.Equal(t, want != uexecutortypes.UniversalTxStatus_OUTBOUND_PENDING, IsOutboundFinalized(got))
+		})
+	}
+
+	t.Run("0x prefix is stripped", func(t *testing.T) {
+		got, err := client.GetOutboundStatus(ctx, "0xutx-success")
+		require.NoError(t, err)
+		assert.Equal(t, uexecutortypes.UniversalTxStatus_OUTBOUND_SUCCESS, got)
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		got, err := client.GetOutboundStatus(ctx, "utx-unknown")
+		require.ErrorIs(t, err, ErrUniversalTxNotFound)
+		assert.Equal(t, uexecutortypes.UniversalTxStatus_UNIVERSAL_TX_STATUS_UNSPECIFIED, got)
+	})
+
+	t.Run("all endpoints fail", func(t *testing.T) {
+		failing := &Client{
+			logger:           logger,
+			uexecutorClients: []uexecutortypes.QueryClient{&mockUExecutorQueryClient{err: assert.AnError}},
+		}
+		_, err := failing.GetOutboundStatus(ctx, "utx-success")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrUniversalTxNotFound)
+	})
 }
 
 func TestClient_GetGasPrice_NilResponse(t *testing.T) {
@@ -967,6 +1080,8 @@ type mockUTSSQueryClient struct {
 	utsstypes.QueryClient
 	currentKeyResp              *utsstypes.QueryCurrentKeyResponse
 	pendingTssEventsResp        *utsstypes.QueryAllPendingTssEventsResponse
+	pendingTssEvents            []*utsstypes.TssEvent // when set, served page by page via NextKey
+	pendingTssEventsCalls       int
 	pendingFundMigrationsResp   *utsstypes.QueryPendingFundMigrationsResponse
 	err                         error
 }
@@ -982,7 +1097,23 @@ func (m *mockUTSSQueryClient) AllPendingTssEvents(ctx context.Context, req *utss
 	if m.err != nil {
 		return nil, m.err
 	}
-	return m.pendingTssEventsResp, nil
+	m.pendingTssEventsCalls++
+	if m.pendingTssEvents == nil {
+		return m.pendingTssEventsResp, nil
+	}
+	start := 0
+	if len(req.Pagination.Key) > 0 {
+		start = int(binary.BigEndian.Uint64(req.Pagination.Key))
+	}
+	end := min(start+int(req.Pagination.Limit), len(m.pendingTssEvents))
+	resp := &utsstypes.QueryAllPendingTssEventsResponse{
+		Events:     m.pendingTssEvents[start:end],
+		Pagination: &query.PageResponse{},
+	}
+	if end < len(m.pendingTssEvents) {
+		resp.Pagination.NextKey = binary.BigEndian.AppendUint64(nil, uint64(end))
+	}
+	return resp, nil
 }
 
 func (m *mockUTSSQueryClient) PendingFundMigrations(ctx context.Context, req *utsstypes.QueryPendingFundMigrationsRequest, opts ...grpc.CallOption) (*utsstypes.QueryPendingFundMigrationsResponse, error) {
@@ -1022,6 +1153,9 @@ type mockUExecutorQueryClient struct {
 	uexecutortypes.QueryClient
 	gasPriceResp            *uexecutortypes.QueryGasPriceResponse
 	allPendingOutboundsResp *uexecutortypes.QueryAllPendingOutboundsResponse
+	pendingOutbounds        []*uexecutortypes.PendingOutboundEntry // when set, served page by page via Offset
+	allPendingOutboundsCalls int
+	universalTxs            map[string]*uexecutortypes.UniversalTxLegacy
 	err                     error
 }
 
@@ -1041,7 +1175,14 @@ func (m *mockUExecutorQueryClient) AllPendingInbounds(ctx context.Context, req *
 }
 
 func (m *mockUExecutorQueryClient) GetUniversalTx(ctx context.Context, req *uexecutortypes.QueryGetUniversalTxRequest, opts ...grpc.CallOption) (*uexecutortypes.QueryGetUniversalTxResponse, error) {
-	return nil, nil
+	if m.err != nil {
+		return nil, m.err
+	}
+	utx, ok := m.universalTxs[req.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "UniversalTx not found")
+	}
+	return &uexecutortypes.QueryGetUniversalTxResponse{UniversalTx: utx}, nil
 }
 
 func (m *mockUExecutorQueryClient) AllUniversalTx(ctx context.Context, req *uexecutortypes.QueryAllUniversalTxRequest, opts ...grpc.CallOption) (*uexecutortypes.QueryAllUniversalTxResponse, error) {
@@ -1052,7 +1193,21 @@ func (m *mockUExecutorQueryClient) AllPendingOutbounds(ctx context.Context, req
 	if m.err != nil {
 		return nil, m.err
 	}
-	return m.allPendingOutboundsResp, nil
+	m.allPendingOutboundsCalls++
+	if m.pendingOutbounds == nil {
+		return m.allPendingOutboundsResp, nil
+	}
+	total := uint64(len(m.pendingOutbounds))
+	start := min(req.Pagination.Offset, total)
+	end := min(start+req.Pagination.Limit, total)
+	resp := &uexecutortypes.QueryAllPendingOutboundsResponse{
+		Pagination: &query.PageResponse{Total: total},
+	}
+	for _, e := range m.pendingOutbounds[start:end] {
+		resp.Entries = append(resp.Entries, e)
+		resp.Outbounds = append(resp.Outbounds, &uexecutortypes.OutboundTx{Id: e.OutboundId})
+	}
+	return resp, nil
 }
 
 func (m *mockUExecutorQueryClient) AllGasPrices(ctx context.Context, req *uexecutortypes.QueryAllGasPricesRequest, opts ...grpc.CallOption) (*uexecutortypes.QueryAllGasPricesResponse, error) {
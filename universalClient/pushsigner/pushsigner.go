@@ -34,6 +34,7 @@ type chainClient interface {
 	GetTx(ctx context.Context, txHash string) (*sdktx.GetTxResponse, error)
 	GetAccount(ctx context.Context, address string) (*authtypes.QueryAccountResponse, error)
 	GetGranteeGrants(ctx context.Context, granteeAddr string) (*cosmosauthz.QueryGranteeGrantsResponse, error)
+	GetOutboundStatus(ctx context.Context, universalTxID string) (uexecutortypes.UniversalTxStatus, error)
 }
 
 // Signer provides the main public API for signing and voting operations.
@@ -117,7 +118,8 @@ func (s *Signer) VoteChainMeta(ctx context.Context, chainID string, price uint64
 	return voteChainMeta(ctx, s, s.log, s.granter, chainID, price, chainHeight)
 }
 
-// VoteOutbound votes on an outbound transaction observation.
+// VoteOutbound votes on an outbound transaction observation. It sends nothing
+// and returns an empty hash if the outbound is already finalized.
 func (s *Signer) VoteOutbound(ctx context.Context, txID string, utxID string, observation *uexecutortypes.OutboundObservation) (string, error) {
 	return voteOutbound(ctx, s, s.log, s.granter, txID, utxID, observation)
 }
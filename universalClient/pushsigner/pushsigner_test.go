@@ -43,6 +43,7 @@ type mockChainClient struct {
 	getTxFn           func(ctx context.Context, txHash string) (*sdktx.GetTxResponse, error)
 	getAccountFn      func(ctx context.Context, address string) (*authtypes.QueryAccountResponse, error)
 	getGranteeGrantFn func(ctx context.Context, addr string) (*cosmosauthz.QueryGranteeGrantsResponse, error)
+	outboundStatusFn  func(ctx context.Context, utxID string) (uexecutortypes.UniversalTxStatus, error)
 }
 
 func (m *mockChainClient) BroadcastTx(ctx context.Context, txBytes []byte) (*sdktx.BroadcastTxResponse, error) {
@@ -74,6 +75,13 @@ func (m *mockChainClient) GetGranteeGrants(ctx context.Context, addr string) (*c
 	return nil, fmt.Errorf("GetGranteeGrants not mocked")
 }
 
+func (m *mockChainClient) GetOutboundStatus(ctx context.Context, utxID string) (uexecutortypes.UniversalTxStatus, error) {
+	if m.outboundStatusFn != nil {
+		return m.outboundStatusFn(ctx, utxID)
+	}
+	return uexecutortypes.UniversalTxStatus_UNIVERSAL_TX_STATUS_UNSPECIFIED, fmt.Errorf("GetOutboundStatus not mocked")
+}
+
 // --- helpers ---
 
 func createMockPushCoreClient() *pushcore.Client {
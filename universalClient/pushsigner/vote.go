@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/rs/zerolog"
 
+	"github.com/pushchain/push-chain-node/universalClient/logger"
+	"github.com/pushchain/push-chain-node/universalClient/pushcore"
 	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 	utsstypes "github.com/pushchain/push-chain-node/x/utss/types"
 )
@@ -141,7 +143,9 @@ func voteChainMeta(
 	return vote(ctx, signer, log, msg, memo)
 }
 
-// voteOutbound votes on an outbound transaction observation
+// voteOutbound votes on an outbound transaction observation. Once the
+// outbound is finalized uexecutor rejects further votes, so the vote is
+// skipped; if the status can't be fetched it is sent anyway.
 func voteOutbound(
 	ctx context.Context,
 	signer *Signer,
@@ -151,6 +155,16 @@ func voteOutbound(
 	utxID string,
 	observation *uexecutortypes.OutboundObservation,
 ) (string, error) {
+	log = logger.WithTraceID(log, utxID)
+	status, err := signer.pushCore.GetOutboundStatus(ctx, utxID)
+	switch {
+	case err != nil:
+		log.Debug().Err(err).Str("tx_id", txID).Msg("failed to fetch outbound status, voting anyway")
+	case pushcore.IsOutboundFinalized(status):
+		log.Info().Str("tx_id", txID).Str("status", status.String()).Msg("outbound already finalized, skipping vote")
+		return "", nil
+	}
+
 	msg := &uexecutortypes.MsgVoteOutbound{
 		Signer:     granter,
 		TxId:       txID,
@@ -158,6 +172,9 @@ func voteOutbound(
 		ObservedTx: observation,
 	}
 	memo := fmt.Sprintf("Vote outbound: %s", txID)
+	if traceID := logger.TraceID(utxID); traceID != "" {
+		memo += fmt.Sprintf(" %s=%s", logger.TraceIDField, traceID)
+	}
 	return vote(ctx, signer, log, msg, memo)
 }
 
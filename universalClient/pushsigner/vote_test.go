@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -153,3 +154,76 @@ func TestVoteAllTypes(t *testing.T) {
 		assert.Equal(t, "VOTE_OK", txHash)
 	})
 }
+
+func TestVoteOutbound_Message(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		success bool
+		reason  string
+	}{
+		{"success", true, ""},
+		{"failure", false, "tx execution reverted on destination chain"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var got *uexecutortypes.MsgVoteOutbound
+			var memo string
+			var signer *Signer
+			mock := successMock(t)
+			mock.broadcastTxFn = func(ctx context.Context, txBytes []byte) (*sdktx.BroadcastTxResponse, error) {
+				tx, err := signer.clientCtx.TxConfig.TxDecoder()(txBytes)
+				require.NoError(t, err)
+				memo = tx.(sdk.TxWithMemo).GetMemo()
+				msgs := tx.GetMsgs()
+				require.Len(t, msgs, 1)
+				exec, ok := msgs[0].(*authz.MsgExec)
+				require.True(t, ok, "expected MsgExec wrapper")
+				inner, err := exec.GetMessages()
+				require.NoError(t, err)
+				require.Len(t, inner, 1)
+				got, ok = inner[0].(*uexecutortypes.MsgVoteOutbound)
+				require.True(t, ok, "expected MsgVoteOutbound")
+				return &sdktx.BroadcastTxResponse{TxResponse: &sdk.TxResponse{Code: 0, TxHash: "VOTE_OK"}}, nil
+			}
+			signer = createTestSigner(t, mock)
+
+			obs := &uexecutortypes.OutboundObservation{
+				Success:    tc.success,
+				TxHash:     "0xdest",
+				ErrorMsg:   tc.reason,
+				GasFeeUsed: "21000",
+			}
+			txHash, err := signer.VoteOutbound(context.Background(), "tx-1", "0xABCDEF0123456789ff", obs)
+			require.NoError(t, err)
+			assert.Equal(t, "VOTE_OK", txHash)
+
+			require.NotNil(t, got)
+			assert.Equal(t, signer.granter, got.Signer)
+			assert.Equal(t, "tx-1", got.TxId)
+			assert.Equal(t, "0xABCDEF0123456789ff", got.UtxId)
+			assert.Equal(t, "Vote outbound: tx-1 trace_id=abcdef0123456789", memo)
+			require.NotNil(t, got.ObservedTx)
+			assert.Equal(t, tc.success, got.ObservedTx.Success)
+			assert.Equal(t, "0xdest", got.ObservedTx.TxHash)
+			assert.Equal(t, tc.reason, got.ObservedTx.ErrorMsg)
+			assert.Equal(t, "21000", got.ObservedTx.GasFeeUsed)
+		})
+	}
+}
+
+func TestVoteOutbound_SkipsFinalizedOutbound(t *testing.T) {
+	mock := successMock(t)
+	mock.outboundStatusFn = func(ctx context.Context, utxID string) (uexecutortypes.UniversalTxStatus, error) {
+		assert.Equal(t, "utx-1", utxID)
+		return uexecutortypes.UniversalTxStatus_OUTBOUND_SUCCESS, nil
+	}
+	mock.broadcastTxFn = func(ctx context.Context, txBytes []byte) (*sdktx.BroadcastTxResponse, error) {
+		t.Fatal("finalized outbound must not be voted again")
+		return nil, nil
+	}
+	signer := createTestSigner(t, mock)
+
+	obs := &uexecutortypes.OutboundObservation{Success: true, TxHash: "0xdest", GasFeeUsed: "21000"}
+	txHash, err := signer.VoteOutbound(context.Background(), "tx-1", "utx-1", obs)
+	require.NoError(t, err)
+	assert.Empty(t, txHash)
+}
@@ -4,19 +4,25 @@
 package store
 
 import (
+	"encoding/json"
+
 	"gorm.io/gorm"
 )
 
 // Event status values.
 const (
-	StatusPending     = "PENDING"     // Observed on external chain, awaiting confirmations
-	StatusConfirmed   = "CONFIRMED"   // Confirmed (ready for processing or voting)
-	StatusInProgress  = "IN_PROGRESS" // TSS signing in progress
-	StatusSigned      = "SIGNED"      // TSS signing done, tx not yet broadcast
-	StatusBroadcasted = "BROADCASTED" // Transaction sent to external chain
-	StatusCompleted   = "COMPLETED"   // Successfully completed
-	StatusReverted    = "REVERTED"    // Failed (expiry, receipt failed, or vote failed)
-	StatusReorged     = "REORGED"     // Removed due to chain reorganization
+	StatusPending       = "PENDING"        // Observed on external chain, awaiting confirmations
+	StatusConfirmed     = "CONFIRMED"      // Confirmed (ready for processing or voting)
+	StatusInProgress    = "IN_PROGRESS"    // TSS signing in progress
+	StatusSigned        = "SIGNED"         // TSS signing done, tx not yet broadcast
+	StatusGatewayPaused = "GATEWAY_PAUSED" // Signed, broadcast deferred while the destination gateway is paused
+	StatusBroadcasted   = "BROADCASTED"    // Transaction sent to external chain
+	StatusCompleted     = "COMPLETED"      // Successfully completed
+	StatusReverted      = "REVERTED"       // Failed (expiry, receipt failed, or vote failed)
+	StatusReorged       = "REORGED"        // Removed due to chain reorganization
+	StatusDryRun        = "DRY_RUN"        // Tx built but not broadcast (dry_run mode)
+	StatusHeld          = "HELD"           // Outbound above its chain's amount ceiling; awaits manual review
+	StatusStandby       = "STANDBY"        // Confirmed outbound another validator is due to report; voted on takeover
 )
 
 // Event type values.
@@ -42,6 +48,10 @@ const (
 type State struct {
 	gorm.Model
 	BlockHeight uint64 // Last processed block height (or slot for Solana chains)
+
+	// EnableHeight is the block (or slot) observation started from when the
+	// chain was first enabled; nil until the observer records it.
+	EnableHeight *uint64
 }
 
 // Event tracks events for a chain.
@@ -69,6 +79,7 @@ type Event struct {
 
 	// Status tracks the processing state of the event.
 	// For PC: (instant finality) "CONFIRMED", "IN_PROGRESS", "BROADCASTED" -> (terminal states) "REVERTED", "COMPLETED"
+	// PC outbounds above their destination chain's amount ceiling are stored "HELD" for manual review.
 	// For external chains: "PENDING", "CONFIRMED", -> (terminal states) "REORGED", "COMPLETED"
 	Status string `gorm:"index;not null"`
 
@@ -80,4 +91,45 @@ type Event struct {
 
 	// BroadcastedTxHash is the broadcasted txHash - only for "SIGN" PC events
 	BroadcastedTxHash string `gorm:"default:NULL"`
+
+	// ServedEndpoints is a JSON object mapping each RPC operation made for
+	// this event (nonce fetch, broadcast, verification) to the redacted
+	// endpoint that served it - only for "SIGN" PC events
+	ServedEndpoints []byte
+}
+
+// UniversalTxID returns the utx_id carried in the event payload, or "" for
+// events that aren't tied to a universal tx (TSS key events, fund migrations).
+func (e *Event) UniversalTxID() string {
+	if e == nil || len(e.EventData) == 0 {
+		return ""
+	}
+	var data struct {
+		UniversalTxID string `json:"utx_id"`
+	}
+	if err := json.Unmarshal(e.EventData, &data); err != nil {
+		return ""
+	}
+	return data.UniversalTxID
+}
+
+// RawEvent keeps the undecoded source-chain event (EVM log, SVM program log)
+// behind an inbound, so a vote can be audited or replayed later. Only
+// written when raw_event_retention_seconds is set for the chain.
+type RawEvent struct {
+	gorm.Model
+
+	// EventID matches the Event it was decoded into (TxHash:LogIndex).
+	EventID string `gorm:"uniqueIndex;not null"`
+
+	// UniversalTxID is the Push Chain utx id the inbound was voted under;
+	// empty until the event processor builds the vote.
+	UniversalTxID string `gorm:"index"`
+
+	// BlockHeight (or slot for Solana) where the event was observed.
+	BlockHeight uint64 `gorm:"index;not null"`
+
+	// Payload is the raw event as returned by the RPC: the JSON-encoded log
+	// for EVM, the program log line for SVM.
+	Payload []byte
 }
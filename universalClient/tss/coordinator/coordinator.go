@@ -9,16 +9,20 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 
 	session "go-wrapper/go-dkls/sessions"
 
 	"github.com/pushchain/push-chain-node/universalClient/chains"
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
+	"github.com/pushchain/push-chain-node/universalClient/pushcore"
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
 	"github.com/pushchain/push-chain-node/universalClient/tss/keyshare"
@@ -34,6 +38,7 @@ type PushCoreClient interface {
 	GetLatestBlock(ctx context.Context) (uint64, error)
 	GetCurrentKey(ctx context.Context) (*utsstypes.TssKey, error)
 	GetAllUniversalValidators(ctx context.Context) ([]*types.UniversalValidator, error)
+	GetOutboundStatus(ctx context.Context, universalTxID string) (uexecutortypes.UniversalTxStatus, error)
 }
 
 const (
@@ -54,6 +59,7 @@ const (
 
 // ackState tracks ACK status for an event.
 type ackState struct {
+	eventType    string
 	participants []string
 	ackedBy      map[string]bool // participant peerID -> has ACKed
 	ackCount     int
@@ -73,6 +79,7 @@ type Coordinator struct {
 	pollInterval     time.Duration
 	logger           zerolog.Logger
 	send             SendFunc
+	peerReachable    PeerReachableFunc // nil: every validator counts as reachable
 
 	// Lifecycle and cache
 	mu                      sync.RWMutex
@@ -88,6 +95,15 @@ type Coordinator struct {
 	// chainWaitMu guards consecutiveWaitPerChain (stuck nonce recovery).
 	chainWaitMu             sync.Mutex
 	consecutiveWaitPerChain map[string]int
+
+	// unsupportedMu guards unsupportedWarned, the chain/event type pairs
+	// already warned about as having no tx builder.
+	unsupportedMu     sync.Mutex
+	unsupportedWarned map[string]bool
+
+	// quorumDeferrals counts sign events held back because fewer than a
+	// signing threshold of validators were reachable.
+	quorumDeferrals atomic.Uint64
 }
 
 // NewCoordinator creates a new coordinator.
@@ -118,9 +134,34 @@ func NewCoordinator(
 		stopCh:                  make(chan struct{}),
 		ackTracking:             make(map[string]*ackState),
 		consecutiveWaitPerChain: make(map[string]int),
+		unsupportedWarned:       make(map[string]bool),
 	}
 }
 
+// SetPeerReachable installs the probe used to check, before starting a sign
+// round, that enough validators are reachable to reach the threshold.
+// Call before Start.
+func (c *Coordinator) SetPeerReachable(fn PeerReachableFunc) {
+	c.peerReachable = fn
+}
+
+// QuorumDeferrals returns how many sign events have been deferred while
+// waiting for a reachable signing quorum.
+func (c *Coordinator) QuorumDeferrals() uint64 {
+	return c.quorumDeferrals.Load()
+}
+
+// QuorumDeferralsCollector returns a counter for the metrics endpoint that
+// reads deferrals on every scrape.
+func QuorumDeferralsCollector(deferrals func() uint64) prometheus.Collector {
+	return prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: "puniversal",
+		Subsystem: "tss",
+		Name:      "quorum_deferrals_total",
+		Help:      "Sign events deferred while waiting for a reachable signing quorum.",
+	}, func() float64 { return float64(deferrals()) })
+}
+
 // validatorsSnapshot returns a read-only snapshot of the cached validator set.
 // Returns nil if the cache is stale
 func (c *Coordinator) validatorsSnapshot() []*types.UniversalValidator {
@@ -189,6 +230,23 @@ func (c *Coordinator) GetMultiAddrsFromPeerID(_ context.Context, peerID string)
 	return nil, fmt.Errorf("peerID %s not found in validators", peerID)
 }
 
+// IsAuthorizedPeer reports whether peerID belongs to a registered validator
+// that takes part in TSS (active, pending join or pending leave). Unknown and
+// inactive peers are rejected, as is everyone while the validator cache is
+// empty or stale.
+func (c *Coordinator) IsAuthorizedPeer(peerID string) bool {
+	for _, v := range c.validatorsSnapshot() {
+		if v.NetworkInfo == nil || v.NetworkInfo.PeerId != peerID || v.LifecycleInfo == nil {
+			continue
+		}
+		switch v.LifecycleInfo.CurrentStatus {
+		case types.UVStatus_UV_STATUS_ACTIVE, types.UVStatus_UV_STATUS_PENDING_JOIN, types.UVStatus_UV_STATUS_PENDING_LEAVE:
+			return true
+		}
+	}
+	return false
+}
+
 // GetLatestBlockNum gets the latest block number from pushCore.
 func (c *Coordinator) GetLatestBlockNum(ctx context.Context) (uint64, error) {
 	return c.pushCore.GetLatestBlock(ctx)
@@ -269,6 +327,12 @@ func (c *Coordinator) GetTSSAddress(ctx context.Context) (string, error) {
 	return DeriveEVMAddressFromPubkey(key.TssPubkey)
 }
 
+// GetVoters returns the validators whose votes count toward Push Chain ballots:
+// Active + Pending Join, the lifecycle filter of x/uvalidator GetEligibleVoters.
+func (c *Coordinator) GetVoters() []*types.UniversalValidator {
+	return getQuorumChangeParticipants(c.validatorsSnapshot())
+}
+
 // GetEligibleUV returns ALL eligible validators for the given protocol type (no random selection).
 // Used by the session manager to check whether a setup-message sender is eligible to participate.
 // For SIGN coordinator setup the coordinator calls getSignParticipants (random threshold subset).
@@ -341,6 +405,19 @@ func (c *Coordinator) pollLoop(ctx context.Context) {
 	}
 }
 
+// firstUnsupported records chain and eventType as lacking a tx builder and
+// reports whether this is the first time the pair was seen.
+func (c *Coordinator) firstUnsupported(chain, eventType string) bool {
+	key := chain + "|" + eventType
+	c.unsupportedMu.Lock()
+	defer c.unsupportedMu.Unlock()
+	if c.unsupportedWarned[key] {
+		return false
+	}
+	c.unsupportedWarned[key] = true
+	return true
+}
+
 // updateValidators fetches and caches all validators.
 func (c *Coordinator) updateValidators(ctx context.Context) {
 	allValidators, err := c.pushCore.GetAllUniversalValidators(ctx)
@@ -403,9 +480,27 @@ func (c *Coordinator) processConfirmedEvents(ctx context.Context) error {
 	nonceByChain := make(map[string]uint64)
 	skippedChains := make(map[string]bool)
 
+	// Reachable sign-eligible validators, probed at most once per poll and
+	// only if a sign event is pending.
+	var signOnline []*types.UniversalValidator
+	signQuorumChecked, signQuorumMet := false, false
+
 	for _, event := range events {
 		var assignedNonce *uint64
 		if event.Type == store.EventTypeSignOutbound || event.Type == store.EventTypeSignFundMigrate {
+			// Don't start a round that cannot reach the threshold. The event
+			// stays CONFIRMED and is retried next poll; checked before a nonce
+			// is assigned so deferring never leaves a gap.
+			if !signQuorumChecked {
+				signOnline, signQuorumMet = c.checkSignQuorum(ctx, allValidators)
+				signQuorumChecked = true
+			}
+			if !signQuorumMet {
+				c.quorumDeferrals.Add(1)
+				c.logger.Debug().Str("event_id", event.EventID).Msg("waiting for quorum, deferring sign event")
+				continue
+			}
+
 			var chain string
 			if event.Type == store.EventTypeSignFundMigrate {
 				chain = extractFundMigrateChain(event.EventData)
@@ -416,6 +511,22 @@ func (c *Coordinator) processConfirmedEvents(ctx context.Context) error {
 				continue
 			}
 
+			// Reject destinations no compiled-in tx builder handles before a
+			// nonce or a TSS round is spent on them.
+			if err := common.CheckChainNamespace(chain); err != nil {
+				// The event stays CONFIRMED and comes back every poll; warn
+				// once per chain and event type.
+				log := c.logger.Debug()
+				if c.firstUnsupported(chain, event.Type) {
+					log = c.logger.Warn()
+				}
+				log.Err(err).
+					Str("event_id", event.EventID).
+					Str("event_type", event.Type).
+					Msg("no tx builder for destination chain, skipping TSS signing")
+				continue
+			}
+
 			// Skip if outbound is disabled for destination chain (fund migrations are exempt)
 			if event.Type != store.EventTypeSignFundMigrate && !c.chains.IsChainOutboundEnabled(chain) {
 				c.logger.Warn().
@@ -425,6 +536,26 @@ func (c *Coordinator) processConfirmedEvents(ctx context.Context) error {
 				continue
 			}
 
+			// Don't sign an outbound so close to its lifetime that the
+			// resolver could vote it failed before the tx lands: the revert
+			// and the destination payout would both go through.
+			if event.Type == store.EventTypeSignOutbound && !c.chains.OutboundSigningOpen(chain, event.BlockHeight, currentBlock) {
+				c.logger.Warn().
+					Str("chain", chain).
+					Str("event_id", event.EventID).
+					Uint64("created_at", event.BlockHeight).
+					Uint64("current_block", currentBlock).
+					Msg("outbound lifetime nearly over, not signing")
+				continue
+			}
+
+			// Skip outbounds Push Chain has already finalized (e.g. a previous
+			// coordinator's tx landed and was voted) so we don't burn a nonce
+			// re-signing them.
+			if event.Type == store.EventTypeSignOutbound && c.skipFinalizedOutbound(ctx, event) {
+				continue
+			}
+
 			// For FUND_MIGRATE, use old TSS address for nonce lookup
 			if event.Type == store.EventTypeSignFundMigrate {
 				nonce, err := c.assignFundMigrateNonce(ctx, event, chain)
@@ -442,17 +573,18 @@ func (c *Coordinator) processConfirmedEvents(ctx context.Context) error {
 			}
 		}
 
-		c.logger.Debug().
+		log := logger.WithTraceID(c.logger, event.UniversalTxID())
+		log.Debug().
 			Str("event_id", event.EventID).
 			Str("type", event.Type).
 			Uint64("block_height", event.BlockHeight).
 			Msg("processing event as coordinator")
-		// For SIGN/FUND_MIGRATE: pick a random threshold subset (>2/3 of eligible) rather than all eligible.
+		// For SIGN/FUND_MIGRATE: pick a random threshold subset (>2/3 of eligible) of the reachable validators rather than all eligible.
 		// A threshold subset suffices for signing and is more resilient when some nodes are offline.
 		// For all other protocols (keygen, keyrefresh, quorum_change), all eligible must participate.
 		var participants []*types.UniversalValidator
 		if event.Type == store.EventTypeSignOutbound || event.Type == store.EventTypeSignFundMigrate {
-			participants = getSignParticipants(allValidators)
+			participants = selectRandomSubset(signOnline, CalculateThreshold(len(getSignEligible(allValidators))))
 		} else {
 			participants = getEligibleForProtocol(event.Type, allValidators)
 		}
@@ -466,7 +598,7 @@ func (c *Coordinator) processConfirmedEvents(ctx context.Context) error {
 		}
 
 		if err := c.processEventAsCoordinator(ctx, event, participants, assignedNonce); err != nil {
-			c.logger.Error().
+			log.Error().
 				Err(err).
 				Str("event_id", event.EventID).
 				Msg("failed to process event as coordinator")
@@ -541,9 +673,12 @@ func (c *Coordinator) processEventAsCoordinator(ctx context.Context, event store
 		return fmt.Errorf("failed to marshal setup message for event %s: %w", event.EventID, err)
 	}
 
+	log := logger.WithTraceID(c.logger, event.UniversalTxID())
+
 	// Initialize ACK tracking for this event
 	c.ackMu.Lock()
 	c.ackTracking[event.EventID] = &ackState{
+		eventType:    event.Type,
 		participants: partyIDs,
 		ackedBy:      make(map[string]bool),
 		ackCount:     0,
@@ -551,6 +686,7 @@ func (c *Coordinator) processEventAsCoordinator(ctx context.Context, event store
 	c.ackMu.Unlock()
 
 	// Send to all participants via sendFn
+	ctx = WithEventType(ctx, event.Type)
 	for _, p := range sortedParticipants {
 		if p.NetworkInfo == nil {
 			continue
@@ -560,14 +696,14 @@ func (c *Coordinator) processEventAsCoordinator(ctx context.Context, event store
 			receiverAddr = p.IdentifyInfo.CoreValidatorAddress
 		}
 		if err := c.send(ctx, p.NetworkInfo.PeerId, setupMsgBytes); err != nil {
-			c.logger.Warn().
+			log.Warn().
 				Err(err).
 				Str("event_id", event.EventID).
 				Str("receiver", receiverAddr).
 				Msg("failed to send setup message")
 			// Continue - other participants may still receive it
 		} else {
-			c.logger.Debug().
+			log.Debug().
 				Str("event_id", event.EventID).
 				Str("receiver", receiverAddr).
 				Msg("sent setup message to participant")
@@ -760,6 +896,10 @@ func (c *Coordinator) buildSignTransaction(ctx context.Context, eventData []byte
 		return nil, fmt.Errorf("chains manager not configured")
 	}
 
+	if err := common.CheckChainNamespace(data.DestinationChain); err != nil {
+		return nil, err
+	}
+
 	// Get the client for the destination chain
 	client, err := c.chains.GetClient(data.DestinationChain)
 	if err != nil {
@@ -957,6 +1097,54 @@ func getSignParticipants(allValidators []*types.UniversalValidator) []*types.Uni
 	return selectRandomThreshold(eligible)
 }
 
+// checkSignQuorum probes which sign-eligible validators are reachable and
+// reports whether at least a signing threshold of them are. Without a probe
+// every eligible validator counts as reachable.
+func (c *Coordinator) checkSignQuorum(ctx context.Context, allValidators []*types.UniversalValidator) ([]*types.UniversalValidator, bool) {
+	eligible := getSignEligible(allValidators)
+	threshold := CalculateThreshold(len(eligible))
+	online := c.reachableValidators(ctx, eligible)
+	if len(eligible) > 0 && len(online) >= threshold {
+		return online, true
+	}
+	c.logger.Warn().
+		Int("reachable", len(online)).
+		Int("eligible", len(eligible)).
+		Int("threshold", threshold).
+		Msg("waiting for quorum: too few sign participants reachable, deferring sign events")
+	return online, false
+}
+
+// reachableValidators returns the validators whose peer answers the
+// reachability probe, probing them concurrently.
+func (c *Coordinator) reachableValidators(ctx context.Context, validators []*types.UniversalValidator) []*types.UniversalValidator {
+	if c.peerReachable == nil {
+		return validators
+	}
+
+	reachable := make([]bool, len(validators))
+	var wg sync.WaitGroup
+	for i, v := range validators {
+		if v.NetworkInfo == nil || v.NetworkInfo.PeerId == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, peerID string) {
+			defer wg.Done()
+			reachable[i] = c.peerReachable(ctx, peerID)
+		}(i, v.NetworkInfo.PeerId)
+	}
+	wg.Wait()
+
+	var online []*types.UniversalValidator
+	for i, v := range validators {
+		if reachable[i] {
+			online = append(online, v)
+		}
+	}
+	return online
+}
+
 // getInFlightSignCountPerChain returns per-chain in-flight SIGN count.
 func (c *Coordinator) getInFlightSignCountPerChain() (map[string]int, error) {
 	inFlight, err := c.eventStore.GetInFlightSignEvents()
@@ -1092,6 +1280,39 @@ func extractDestinationChain(eventData []byte) string {
 	return data.DestinationChain
 }
 
+// skipFinalizedOutbound reports whether the outbound behind event is already
+// finalized on Push Chain, marking the event COMPLETED if so. Query failures
+// are not fatal: the event proceeds to signing as before.
+func (c *Coordinator) skipFinalizedOutbound(ctx context.Context, event store.Event) bool {
+	utxID := event.UniversalTxID()
+	if utxID == "" {
+		return false
+	}
+	log := logger.WithTraceID(c.logger, utxID)
+
+	status, err := c.pushCore.GetOutboundStatus(ctx, utxID)
+	if err != nil {
+		log.Debug().Err(err).
+			Str("event_id", event.EventID).
+			Str("utx_id", utxID).
+			Msg("failed to query outbound status, proceeding with signing")
+		return false
+	}
+	if !pushcore.IsOutboundFinalized(status) {
+		return false
+	}
+
+	if err := c.eventStore.Update(event.EventID, map[string]any{"status": store.StatusCompleted}); err != nil {
+		log.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to mark finalized outbound as completed")
+	}
+	log.Info().
+		Str("event_id", event.EventID).
+		Str("utx_id", utxID).
+		Str("status", status.String()).
+		Msg("outbound already finalized on Push Chain, skipping signing")
+	return true
+}
+
 // extractFundMigrateChain extracts the chain field from FundMigrationInitiatedEventData JSON.
 func extractFundMigrateChain(eventData []byte) string {
 	if len(eventData) == 0 {
@@ -58,6 +58,14 @@ func (m *coordMockTxBuilder) IsAlreadyExecuted(ctx context.Context, txID string)
 	return args.Bool(0), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *coordMockTxBuilder) RequiredConfirmations(data *uexecutortypes.OutboundCreatedEvent, base uint64) uint64 {
+	return base
+}
+
+func (m *coordMockTxBuilder) IsGatewayPaused(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
 func (m *coordMockTxBuilder) GetGasFeeUsed(ctx context.Context, txHash string) (string, error) {
 	args := m.Called(ctx, txHash)
 	return args.String(0), args.Error(1)
@@ -304,6 +312,18 @@ func TestGetEligibleUV(t *testing.T) {
 	})
 }
 
+func TestGetVoters(t *testing.T) {
+	coord, _, _ := setupTestCoordinator(t)
+
+	// Fixture: validator1=Active, validator2=Active, validator3=PendingJoin
+	voters := coord.GetVoters()
+	require.Len(t, voters, 3, "voters = active(2) + pending_join(1)")
+	addrs := validatorAddresses(voters)
+	assert.True(t, addrs["validator1"])
+	assert.True(t, addrs["validator2"])
+	assert.True(t, addrs["validator3"], "PendingJoin validators vote on Push Chain ballots")
+}
+
 func TestGetKeygenKeyrefreshParticipants(t *testing.T) {
 	validators := []*types.UniversalValidator{
 		{IdentifyInfo: &types.IdentityInfo{CoreValidatorAddress: "v1"}, LifecycleInfo: &types.LifecycleInfo{CurrentStatus: types.UVStatus_UV_STATUS_ACTIVE}},
@@ -344,6 +364,63 @@ func TestGetSignParticipants(t *testing.T) {
 	assert.False(t, addrs["v5"], "Inactive not eligible for sign")
 }
 
+func TestCheckSignQuorum(t *testing.T) {
+	// Four sign-eligible validators: threshold(4) = 3.
+	validators := make([]*types.UniversalValidator, 4)
+	for i := range validators {
+		validators[i] = &types.UniversalValidator{
+			IdentifyInfo:  &types.IdentityInfo{CoreValidatorAddress: fmt.Sprintf("v%d", i+1)},
+			NetworkInfo:   &types.NetworkInfo{PeerId: fmt.Sprintf("peer%d", i+1)},
+			LifecycleInfo: &types.LifecycleInfo{CurrentStatus: types.UVStatus_UV_STATUS_ACTIVE},
+		}
+	}
+	reachableSet := func(peers ...string) PeerReachableFunc {
+		up := make(map[string]bool, len(peers))
+		for _, p := range peers {
+			up[p] = true
+		}
+		return func(_ context.Context, peerID string) bool { return up[peerID] }
+	}
+
+	t.Run("below threshold defers", func(t *testing.T) {
+		coord, _, _ := setupTestCoordinator(t)
+		coord.SetPeerReachable(reachableSet("peer1", "peer2"))
+
+		online, ok := coord.checkSignQuorum(context.Background(), validators)
+		assert.False(t, ok)
+		assert.Len(t, online, 2)
+	})
+
+	t.Run("at threshold proceeds with reachable validators only", func(t *testing.T) {
+		coord, _, _ := setupTestCoordinator(t)
+		coord.SetPeerReachable(reachableSet("peer1", "peer2", "peer4"))
+
+		online, ok := coord.checkSignQuorum(context.Background(), validators)
+		require.True(t, ok)
+		addrs := validatorAddresses(online)
+		assert.Len(t, addrs, 3)
+		assert.False(t, addrs["v3"], "unreachable validator must not be picked")
+
+		participants := selectRandomSubset(online, CalculateThreshold(len(validators)))
+		assert.Len(t, participants, 3)
+	})
+
+	t.Run("without a probe every eligible validator counts", func(t *testing.T) {
+		coord, _, _ := setupTestCoordinator(t)
+
+		online, ok := coord.checkSignQuorum(context.Background(), validators)
+		assert.True(t, ok)
+		assert.Len(t, online, 4)
+	})
+
+	t.Run("no eligible validators defers", func(t *testing.T) {
+		coord, _, _ := setupTestCoordinator(t)
+
+		_, ok := coord.checkSignQuorum(context.Background(), nil)
+		assert.False(t, ok)
+	})
+}
+
 // --- Threshold and random selection ---
 
 func TestCalculateThreshold(t *testing.T) {
@@ -562,6 +639,19 @@ func TestBuildSignTransaction(t *testing.T) {
 		assert.Contains(t, err.Error(), "chains manager not configured")
 	})
 
+	t.Run("unsupported namespace rejected before client lookup", func(t *testing.T) {
+		coord, _, _ := setupTestCoordinator(t)
+		builder := &coordMockTxBuilder{}
+		client := &coordMockChainClient{builder: builder}
+		coord.chains = newTestChainsForCoordinator(t, "eip155:1", uregistrytypes.VmType_EVM, client)
+
+		nonce := uint64(5)
+		data := []byte(`{"tx_id":"0x1","destination_chain":"cosmos:cosmoshub-4"}`)
+		_, err := coord.buildSignTransaction(ctx, data, &nonce)
+		require.ErrorIs(t, err, common.ErrUnsupportedChainNamespace)
+		builder.AssertNotCalled(t, "GetOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("chain client not found", func(t *testing.T) {
 		coord, _, _ := setupTestCoordinator(t)
 		builder := &coordMockTxBuilder{}
@@ -635,6 +725,15 @@ func TestBuildSignTransaction(t *testing.T) {
 	})
 }
 
+func TestFirstUnsupported(t *testing.T) {
+	coord, _, _ := setupTestCoordinator(t)
+
+	assert.True(t, coord.firstUnsupported("cosmos:cosmoshub-4", store.EventTypeSignOutbound))
+	assert.False(t, coord.firstUnsupported("cosmos:cosmoshub-4", store.EventTypeSignOutbound), "repeat polls are not warned again")
+	assert.True(t, coord.firstUnsupported("cosmos:cosmoshub-4", store.EventTypeSignFundMigrate), "another event type is warned")
+	assert.True(t, coord.firstUnsupported("bip122:000000000019d6689c085ae165831e93", store.EventTypeSignOutbound), "another chain is warned")
+}
+
 func TestAssignSignNonce_SkippedChain(t *testing.T) {
 	coord, _, _ := setupTestCoordinator(t)
 	skippedChains := map[string]bool{"eip155:1": true}
@@ -813,6 +912,29 @@ func TestGetPartyIDFromPeerID(t *testing.T) {
 	})
 }
 
+func TestIsAuthorizedPeer(t *testing.T) {
+	coord, _, _ := setupTestCoordinator(t)
+
+	assert.True(t, coord.IsAuthorizedPeer("peer1"), "active validator")
+	assert.True(t, coord.IsAuthorizedPeer("peer3"), "pending-join validator")
+	assert.False(t, coord.IsAuthorizedPeer("unknown-peer"))
+
+	coord.mu.Lock()
+	coord.allValidators = append(coord.allValidators, &types.UniversalValidator{
+		IdentifyInfo:  &types.IdentityInfo{CoreValidatorAddress: "validator4"},
+		NetworkInfo:   &types.NetworkInfo{PeerId: "peer4"},
+		LifecycleInfo: &types.LifecycleInfo{CurrentStatus: types.UVStatus_UV_STATUS_INACTIVE},
+	})
+	coord.mu.Unlock()
+	assert.False(t, coord.IsAuthorizedPeer("peer4"), "inactive validator")
+
+	// Without a fresh validator set nobody is authorized.
+	coord.mu.Lock()
+	coord.lastValidatorsRefreshAt = time.Time{}
+	coord.mu.Unlock()
+	assert.False(t, coord.IsAuthorizedPeer("peer1"))
+}
+
 func TestGetPeerIDFromPartyID(t *testing.T) {
 	coord, _, _ := setupTestCoordinator(t)
 	ctx := context.Background()
@@ -1123,6 +1245,7 @@ type stalenessMockPushCore struct {
 	block      uint64
 	validators []*types.UniversalValidator
 	failGetAll bool
+	outbounds  map[string]uexecutortypes.UniversalTxStatus
 }
 
 func (m *stalenessMockPushCore) GetLatestBlock(_ context.Context) (uint64, error) {
@@ -1140,6 +1263,56 @@ func (m *stalenessMockPushCore) GetAllUniversalValidators(_ context.Context) ([]
 	return m.validators, nil
 }
 
+func (m *stalenessMockPushCore) GetOutboundStatus(_ context.Context, utxID string) (uexecutortypes.UniversalTxStatus, error) {
+	st, ok := m.outbounds[utxID]
+	if !ok {
+		return uexecutortypes.UniversalTxStatus_UNIVERSAL_TX_STATUS_UNSPECIFIED, pushcore.ErrUniversalTxNotFound
+	}
+	return st, nil
+}
+
+// TestSkipFinalizedOutbound verifies the coordinator drops outbounds Push Chain
+// already finalized and leaves pending or unknown ones for signing.
+func TestSkipFinalizedOutbound(t *testing.T) {
+	coord, evtStore, db := setupTestCoordinator(t)
+	coord.pushCore = &stalenessMockPushCore{outbounds: map[string]uexecutortypes.UniversalTxStatus{
+		"utx-pending":  uexecutortypes.UniversalTxStatus_OUTBOUND_PENDING,
+		"utx-success":  uexecutortypes.UniversalTxStatus_OUTBOUND_SUCCESS,
+		"utx-failed":   uexecutortypes.UniversalTxStatus_OUTBOUND_FAILED,
+		"utx-canceled": uexecutortypes.UniversalTxStatus_CANCELED,
+	}}
+
+	tests := []struct {
+		utxID    string
+		skip     bool
+		wantStat string
+	}{
+		{"utx-pending", false, store.StatusConfirmed},
+		{"utx-success", true, store.StatusCompleted},
+		{"utx-failed", true, store.StatusCompleted},
+		{"utx-canceled", true, store.StatusCompleted},
+		{"utx-unknown", false, store.StatusConfirmed},
+		{"", false, store.StatusConfirmed},
+	}
+	for i, tt := range tests {
+		t.Run(tt.utxID, func(t *testing.T) {
+			event := store.Event{
+				EventID:   fmt.Sprintf("e%d", i),
+				Type:      store.EventTypeSignOutbound,
+				Status:    store.StatusConfirmed,
+				EventData: []byte(fmt.Sprintf(`{"utx_id":%q,"destination_chain":"eip155:1"}`, tt.utxID)),
+			}
+			require.NoError(t, db.Create(&event).Error)
+
+			assert.Equal(t, tt.skip, coord.skipFinalizedOutbound(context.Background(), event))
+
+			got, err := evtStore.GetEvent(event.EventID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStat, got.Status)
+		})
+	}
+}
+
 // TestIsPeerCoordinator_StaleCacheHalt covers the F-2026-16874 defensive guard:
 // once the validator cache has aged past the halt threshold (10 * pollInterval),
 // validatorsSnapshot clears it and IsPeerCoordinator reports the peer as
@@ -140,6 +140,7 @@ func (c *Coordinator) handleUnsignedAck(ctx context.Context, senderPeerID string
 		}
 
 		// Send to all participants
+		ctx := WithEventType(ctx, state.eventType)
 		for _, participantPartyID := range state.participants {
 			participantPeerID, err := c.GetPeerIDFromPartyID(ctx, participantPartyID)
 			if err != nil {
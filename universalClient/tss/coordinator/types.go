@@ -10,6 +10,24 @@ import (
 // SendFunc sends `data` to `peerID` over the p2p network.
 type SendFunc func(ctx context.Context, peerID string, data []byte) error
 
+// PeerReachableFunc reports whether `peerID` can currently be dialed.
+type PeerReachableFunc func(ctx context.Context, peerID string) bool
+
+type eventTypeKey struct{}
+
+// WithEventType tags ctx with the type of the event the messages sent under
+// it belong to, so the SendFunc can apply that protocol's network timeouts
+// without decoding each message.
+func WithEventType(ctx context.Context, eventType string) context.Context {
+	return context.WithValue(ctx, eventTypeKey{}, eventType)
+}
+
+// EventTypeFromContext returns the event type set by WithEventType.
+func EventTypeFromContext(ctx context.Context) (string, bool) {
+	eventType, ok := ctx.Value(eventTypeKey{}).(string)
+	return eventType, ok && eventType != ""
+}
+
 // MessageType discriminates inter-node TSS coordination messages.
 type MessageType string
 
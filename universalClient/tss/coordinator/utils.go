@@ -73,20 +73,27 @@ func selectRandomThreshold(eligible []*types.UniversalValidator) []*types.Univer
 	}
 
 	// Calculate minimum required: >2/3 (same as threshold calculation)
-	minRequired := CalculateThreshold(len(eligible))
+	return selectRandomSubset(eligible, CalculateThreshold(len(eligible)))
+}
+
+// selectRandomSubset returns a shuffled copy of n candidates, or all of them
+// if there are no more than n.
+func selectRandomSubset(candidates []*types.UniversalValidator, n int) []*types.UniversalValidator {
+	if len(candidates) == 0 {
+		return nil
+	}
 
-	// If we have fewer than minRequired, return all
-	if len(eligible) <= minRequired {
-		return eligible
+	// If we have no more than n, return all
+	if len(candidates) <= n {
+		return candidates
 	}
 
-	// Randomly select at least minRequired participants
-	// Shuffle and take first minRequired
-	shuffled := make([]*types.UniversalValidator, len(eligible))
-	copy(shuffled, eligible)
+	// Shuffle and take first n
+	shuffled := make([]*types.UniversalValidator, len(candidates))
+	copy(shuffled, candidates)
 	rand.Shuffle(len(shuffled), func(i, j int) {
 		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
 	})
 
-	return shuffled[:minRequired]
+	return shuffled[:n]
 }
@@ -1,8 +1,10 @@
 package eventstore
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -13,6 +15,10 @@ import (
 	"github.com/pushchain/push-chain-node/universalClient/store"
 )
 
+// ErrNotHeld is returned when releasing or rejecting an outbound that is not
+// held for manual review.
+var ErrNotHeld = errors.New("outbound is not held for review")
+
 // Store provides database access for TSS events.
 type Store struct {
 	db     *gorm.DB
@@ -108,6 +114,83 @@ func (s *Store) PersistSignature(
 	return result.RowsAffected > 0, nil
 }
 
+// PersistRecoveryID caches the SVM recovery ID determined for signature on the
+// event's signing_data, so broadcast retries skip re-deriving it. The value is
+// bound to the signature it was derived from: if signing_data now carries a
+// different signature, or the event is no longer SIGNED, nothing is written.
+//
+// Returns (persisted, error), like PersistSignature.
+func (s *Store) PersistRecoveryID(eventID, signature string, recoveryID uint8) (bool, error) {
+	event, err := s.GetEvent(eventID)
+	if err != nil {
+		return false, fmt.Errorf("load event %s: %w", eventID, err)
+	}
+
+	// UseNumber keeps large integers (nonce, fund migration amount) exact.
+	var raw map[string]any
+	dec := json.NewDecoder(bytes.NewReader(event.EventData))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return false, fmt.Errorf("parse event data for recovery_id injection: %w", err)
+	}
+	signingData, ok := raw["signing_data"].(map[string]any)
+	if !ok || signingData["signature"] != signature {
+		return false, nil
+	}
+	signingData["recovery_id"] = recoveryID
+	signingData["recovery_id_signature"] = signature
+	newEventData, err := json.Marshal(raw)
+	if err != nil {
+		return false, fmt.Errorf("marshal event data with recovery_id: %w", err)
+	}
+
+	result := s.db.Model(&store.Event{}).
+		Where("event_id = ? AND status = ?", eventID, store.StatusSigned).
+		Update("event_data", newEventData)
+	if result.Error != nil {
+		return false, fmt.Errorf("persist recovery id for %s: %w", eventID, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RecordServedEndpoints merges served (RPC operation → redacted endpoint)
+// into the event's served_endpoints, overwriting earlier entries for the same
+// operation so the record reflects the endpoint that answered most recently.
+// An empty map is a no-op.
+func (s *Store) RecordServedEndpoints(eventID string, served map[string]string) error {
+	if len(served) == 0 {
+		return nil
+	}
+	event, err := s.GetEvent(eventID)
+	if err != nil {
+		return fmt.Errorf("load event %s: %w", eventID, err)
+	}
+
+	merged := make(map[string]string, len(served))
+	if len(event.ServedEndpoints) > 0 {
+		if err := json.Unmarshal(event.ServedEndpoints, &merged); err != nil {
+			s.logger.Warn().Err(err).Str("event_id", eventID).Msg("discarding unparseable served_endpoints")
+			merged = make(map[string]string, len(served))
+		}
+	}
+	for op, endpoint := range served {
+		merged[op] = endpoint
+	}
+	raw, err := json.Marshal(merged)
+	if err != nil {
+		return fmt.Errorf("marshal served endpoints: %w", err)
+	}
+	// UpdateColumn leaves updated_at alone: broadcast leader failover measures
+	// how long an event has sat in its status from it.
+	result := s.db.Model(&store.Event{}).
+		Where("event_id = ?", eventID).
+		UpdateColumn("served_endpoints", raw)
+	if result.Error != nil {
+		return fmt.Errorf("record served endpoints for %s: %w", eventID, result.Error)
+	}
+	return nil
+}
+
 // RecoverInProgressEvents repairs IN_PROGRESS rows on node startup.
 //
 // Two passes, in order:
@@ -209,27 +292,55 @@ func (s *Store) GetNonExpiredConfirmedEvents(currentBlock, minBlockConfirmation
 	return events, nil
 }
 
-// GetInFlightSignEvents returns SIGN events that are currently IN_PROGRESS or SIGNED.
+// GetHeldEvents returns outbounds held for manual review, oldest first.
+func (s *Store) GetHeldEvents() ([]store.Event, error) {
+	var events []store.Event
+	if err := s.db.Where("type = ? AND status = ?", store.EventTypeSignOutbound, store.StatusHeld).
+		Order("block_height ASC, created_at ASC").
+		Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to query held events: %w", err)
+	}
+	return events, nil
+}
+
+// ReleaseHeldEvent moves a held outbound to CONFIRMED so the coordinator
+// signs it. Returns ErrNotHeld if the event is missing or no longer HELD.
+func (s *Store) ReleaseHeldEvent(eventID string) error {
+	result := s.db.Model(&store.Event{}).
+		Where("event_id = ? AND status = ?", eventID, store.StatusHeld).
+		Update("status", store.StatusConfirmed)
+	if result.Error != nil {
+		return fmt.Errorf("failed to release event %s: %w", eventID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("event %s: %w", eventID, ErrNotHeld)
+	}
+	return nil
+}
+
+// GetInFlightSignEvents returns SIGN events that are currently IN_PROGRESS or
+// SIGNED, including signed events deferred while their gateway is paused.
 // BROADCASTED events are excluded because they are already submitted to chain;
 // the pending nonce RPC accounts for them in the mempool, and including them here
 // would make the coordinator wait for the resolver unnecessarily.
 func (s *Store) GetInFlightSignEvents() ([]store.Event, error) {
 	var events []store.Event
-	if err := s.db.Where("type IN (?, ?) AND status IN (?, ?)",
-		store.EventTypeSignOutbound, store.EventTypeSignFundMigrate, store.StatusInProgress, store.StatusSigned).
+	if err := s.db.Where("type IN (?, ?) AND status IN (?, ?, ?)",
+		store.EventTypeSignOutbound, store.EventTypeSignFundMigrate, store.StatusInProgress, store.StatusSigned, store.StatusGatewayPaused).
 		Find(&events).Error; err != nil {
 		return nil, fmt.Errorf("failed to query in-flight sign events: %w", err)
 	}
 	return events, nil
 }
 
-// GetSignedSignEvents returns SIGN events with status SIGNED (ready to be broadcast).
+// GetSignedSignEvents returns SIGN events with status SIGNED (ready to be broadcast)
+// or GATEWAY_PAUSED (rechecked for an unpaused gateway each broadcast tick).
 func (s *Store) GetSignedSignEvents(limit int) ([]store.Event, error) {
 	if limit <= 0 {
 		limit = 50
 	}
 	var events []store.Event
-	if err := s.db.Where("type IN (?, ?) AND status = ?", store.EventTypeSignOutbound, store.EventTypeSignFundMigrate, store.StatusSigned).
+	if err := s.db.Where("type IN (?, ?) AND status IN (?, ?)", store.EventTypeSignOutbound, store.EventTypeSignFundMigrate, store.StatusSigned, store.StatusGatewayPaused).
 		Order("block_height ASC, created_at ASC").
 		Limit(limit).
 		Find(&events).Error; err != nil {
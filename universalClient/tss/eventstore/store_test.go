@@ -2,6 +2,7 @@ package eventstore
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -534,6 +535,36 @@ func TestGetInFlightSignEvents(t *testing.T) {
 	}
 }
 
+func TestHeldEvents(t *testing.T) {
+	s := setupTestStore(t)
+
+	createTestEventWithType(t, s, "held-2", 20, store.StatusHeld, 0, store.EventTypeSignOutbound)
+	createTestEventWithType(t, s, "held-1", 10, store.StatusHeld, 0, store.EventTypeSignOutbound)
+	createTestEventWithType(t, s, "confirmed", 15, store.StatusConfirmed, 0, store.EventTypeSignOutbound)
+
+	events, err := s.GetHeldEvents()
+	if err != nil {
+		t.Fatalf("GetHeldEvents() error = %v", err)
+	}
+	if len(events) != 2 || events[0].EventID != "held-1" || events[1].EventID != "held-2" {
+		t.Fatalf("GetHeldEvents() = %v, want held-1, held-2", events)
+	}
+
+	if err := s.ReleaseHeldEvent("held-1"); err != nil {
+		t.Fatalf("ReleaseHeldEvent() error = %v", err)
+	}
+	event, _ := s.GetEvent("held-1")
+	if event.Status != store.StatusConfirmed {
+		t.Errorf("released event status = %s, want %s", event.Status, store.StatusConfirmed)
+	}
+
+	for _, id := range []string{"held-1", "confirmed", "missing"} {
+		if err := s.ReleaseHeldEvent(id); !errors.Is(err, ErrNotHeld) {
+			t.Errorf("ReleaseHeldEvent(%s) error = %v, want ErrNotHeld", id, err)
+		}
+	}
+}
+
 func TestGetInFlightSignEvents_IncludesFundMigrate(t *testing.T) {
 	s := setupTestStore(t)
 
@@ -835,3 +866,128 @@ func TestPersistSignature(t *testing.T) {
 		}
 	})
 }
+
+func TestPersistRecoveryID(t *testing.T) {
+	eventData := []byte(`{"tx_id":"tx-1","signing_data":{"signature":"beef","signing_hash":"dead","nonce":18446744073709551615}}`)
+	seed := func(t *testing.T, s *Store, status string) {
+		t.Helper()
+		if err := s.db.Create(&store.Event{
+			EventID:   "ev-1",
+			Type:      store.EventTypeSignOutbound,
+			Status:    status,
+			EventData: eventData,
+		}).Error; err != nil {
+			t.Fatalf("seed event: %v", err)
+		}
+	}
+
+	t.Run("caches the recovery ID bound to the signature", func(t *testing.T) {
+		s := setupTestStore(t)
+		seed(t, s, store.StatusSigned)
+
+		persisted, err := s.PersistRecoveryID("ev-1", "beef", 1)
+		if err != nil {
+			t.Fatalf("PersistRecoveryID: %v", err)
+		}
+		if !persisted {
+			t.Fatal("expected persisted=true")
+		}
+
+		got, _ := s.GetEvent("ev-1")
+		var raw struct {
+			TxID        string `json:"tx_id"`
+			SigningData struct {
+				Signature           string `json:"signature"`
+				Nonce               uint64 `json:"nonce"`
+				RecoveryID          *uint8 `json:"recovery_id"`
+				RecoveryIDSignature string `json:"recovery_id_signature"`
+			} `json:"signing_data"`
+		}
+		if err := json.Unmarshal(got.EventData, &raw); err != nil {
+			t.Fatalf("unmarshal event_data: %v", err)
+		}
+		if raw.SigningData.RecoveryID == nil || *raw.SigningData.RecoveryID != 1 {
+			t.Errorf("recovery_id = %v, want 1", raw.SigningData.RecoveryID)
+		}
+		if raw.SigningData.RecoveryIDSignature != "beef" {
+			t.Errorf("recovery_id_signature = %q, want beef", raw.SigningData.RecoveryIDSignature)
+		}
+		if raw.TxID != "tx-1" || raw.SigningData.Nonce != 18446744073709551615 {
+			t.Errorf("other fields not preserved: %+v", raw)
+		}
+	})
+
+	t.Run("skips when the signature has changed", func(t *testing.T) {
+		s := setupTestStore(t)
+		seed(t, s, store.StatusSigned)
+
+		persisted, err := s.PersistRecoveryID("ev-1", "cafe", 1)
+		if err != nil {
+			t.Fatalf("PersistRecoveryID: %v", err)
+		}
+		if persisted {
+			t.Fatal("expected persisted=false for a stale signature")
+		}
+	})
+
+	t.Run("skips when no longer SIGNED", func(t *testing.T) {
+		s := setupTestStore(t)
+		seed(t, s, store.StatusBroadcasted)
+
+		persisted, err := s.PersistRecoveryID("ev-1", "beef", 1)
+		if err != nil {
+			t.Fatalf("PersistRecoveryID: %v", err)
+		}
+		if persisted {
+			t.Fatal("expected persisted=false")
+		}
+	})
+}
+
+func TestRecordServedEndpoints(t *testing.T) {
+	s := setupTestStore(t)
+	createTestEventWithType(t, s, "ev-1", 100, store.StatusSigned, 200, store.EventTypeSignOutbound)
+	before, _ := s.GetEvent("ev-1")
+
+	if err := s.RecordServedEndpoints("ev-1", map[string]string{
+		"get_nonce":        "https://rpc-a.example",
+		"send_transaction": "https://rpc-a.example",
+	}); err != nil {
+		t.Fatalf("RecordServedEndpoints: %v", err)
+	}
+	// A later flow (e.g. the resolver) merges in, overwriting only what it re-ran.
+	if err := s.RecordServedEndpoints("ev-1", map[string]string{
+		"get_nonce":               "https://rpc-b.example",
+		"get_transaction_receipt": "https://rpc-b.example",
+	}); err != nil {
+		t.Fatalf("RecordServedEndpoints: %v", err)
+	}
+	if err := s.RecordServedEndpoints("ev-1", nil); err != nil {
+		t.Fatalf("RecordServedEndpoints with nothing to record: %v", err)
+	}
+
+	got, _ := s.GetEvent("ev-1")
+	var served map[string]string
+	if err := json.Unmarshal(got.ServedEndpoints, &served); err != nil {
+		t.Fatalf("unmarshal served_endpoints: %v", err)
+	}
+	want := map[string]string{
+		"get_nonce":               "https://rpc-b.example",
+		"send_transaction":        "https://rpc-a.example",
+		"get_transaction_receipt": "https://rpc-b.example",
+	}
+	if fmt.Sprint(served) != fmt.Sprint(want) {
+		t.Errorf("served_endpoints = %v, want %v", served, want)
+	}
+	// Leader failover times events by updated_at; recording must not reset it.
+	if !got.UpdatedAt.Equal(before.UpdatedAt) {
+		t.Errorf("updated_at changed from %v to %v", before.UpdatedAt, got.UpdatedAt)
+	}
+	if got.Status != store.StatusSigned {
+		t.Errorf("status = %s, want SIGNED", got.Status)
+	}
+
+	if err := s.RecordServedEndpoints("missing", map[string]string{"get_nonce": "x"}); err == nil {
+		t.Error("expected error for unknown event")
+	}
+}
@@ -0,0 +1,154 @@
+package tss
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pushchain/push-chain-node/x/uvalidator/types"
+)
+
+// LeaveState is this node's progress through a graceful leave.
+//
+// Leaving is driven on chain: removal moves the validator to PENDING_LEAVE,
+// which initiates a QUORUM_CHANGE reshare over the remaining eligible
+// validators (once at least two remain), and finalizing that reshare moves
+// the leaving validator to INACTIVE. Until then the node is still a key
+// holder and keeps signing; only after INACTIVE is it safe to shut down.
+type LeaveState int
+
+const (
+	// LeaveNone: not leaving.
+	LeaveNone LeaveState = iota
+	// LeavePending: PENDING_LEAVE; still signing while the quorum change
+	// that excludes this node is outstanding.
+	LeavePending
+	// LeaveComplete: INACTIVE after PENDING_LEAVE; the key no longer
+	// includes this node and it can be shut down.
+	LeaveComplete
+)
+
+func (s LeaveState) String() string {
+	switch s {
+	case LeavePending:
+		return "pending"
+	case LeaveComplete:
+		return "complete"
+	default:
+		return "none"
+	}
+}
+
+// leaveStateOf derives the leave state from a validator's lifecycle. The
+// history is consulted so a node restarted after its leave completed still
+// reports LeaveComplete, while a validator that was simply never active does not.
+func leaveStateOf(info *types.LifecycleInfo) LeaveState {
+	if info == nil {
+		return LeaveNone
+	}
+	switch info.CurrentStatus {
+	case types.UVStatus_UV_STATUS_PENDING_LEAVE:
+		return LeavePending
+	case types.UVStatus_UV_STATUS_INACTIVE:
+		// History ends with the INACTIVE transition itself.
+		if n := len(info.History); n >= 2 && info.History[n-2].GetStatus() == types.UVStatus_UV_STATUS_PENDING_LEAVE {
+			return LeaveComplete
+		}
+	}
+	return LeaveNone
+}
+
+// leaveTracker records the node's leave state.
+type leaveTracker struct {
+	mu    sync.Mutex
+	state LeaveState
+}
+
+func newLeaveTracker() *leaveTracker {
+	return &leaveTracker{}
+}
+
+// update moves the tracker to next and reports the previous state. A
+// completed leave is final.
+func (t *leaveTracker) update(next LeaveState) (prev LeaveState) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	prev = t.state
+	if prev == LeaveComplete || next == prev {
+		return prev
+	}
+	t.state = next
+	return prev
+}
+
+func (t *leaveTracker) get() LeaveState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.state
+}
+
+// LeaveState returns this node's current leave state. Operators read it from
+// GET /admin/tss/leave or the puniversal_tss_leave_state metric to know when
+// the node can be shut down.
+func (n *Node) LeaveState() LeaveState {
+	return n.leave.get()
+}
+
+// LeaveStateCollector returns a gauge for the metrics endpoint that reads
+// state on every scrape: 0 none, 1 pending, 2 complete.
+func LeaveStateCollector(state func() LeaveState) prometheus.Collector {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "puniversal",
+		Subsystem: "tss",
+		Name:      "leave_state",
+		Help:      "Graceful leave progress of this node: 0 none, 1 pending (still signing), 2 complete (safe to shut down).",
+	}, func() float64 { return float64(state()) })
+}
+
+// checkLeave refreshes the leave state from the coordinator's validator set
+// and logs transitions. A stale set (nil snapshot) leaves the state as is.
+func (n *Node) checkLeave() {
+	validators := n.coordinator.Validators()
+	if validators == nil {
+		return
+	}
+	var next LeaveState
+	for _, v := range validators {
+		if v.IdentifyInfo != nil && v.IdentifyInfo.CoreValidatorAddress == n.validatorAddress {
+			next = leaveStateOf(v.LifecycleInfo)
+			break
+		}
+	}
+
+	prev := n.leave.update(next)
+	if prev == LeaveComplete || prev == next {
+		return
+	}
+	switch next {
+	case LeavePending:
+		n.logger.Info().Msg("validator is PENDING_LEAVE; continuing to sign until the quorum change excluding this node finalizes")
+	case LeaveComplete:
+		n.logger.Info().Msg("leave complete: this node is no longer part of the TSS key and can be shut down safely")
+	case LeaveNone:
+		n.logger.Info().Str("previous", prev.String()).Msg("leave cancelled; validator is eligible again")
+	}
+}
+
+// watchLeave polls the leave state until ctx is done or the node stops.
+func (n *Node) watchLeave(ctx context.Context, interval time.Duration) {
+	defer n.processingWg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		n.checkLeave()
+		select {
+		case <-ctx.Done():
+			return
+		case <-n.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package tss
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pushchain/push-chain-node/x/uvalidator/types"
+)
+
+func lifecycle(statuses ...types.UVStatus) *types.LifecycleInfo {
+	info := &types.LifecycleInfo{CurrentStatus: statuses[len(statuses)-1]}
+	for _, s := range statuses {
+		info.History = append(info.History, &types.LifecycleEvent{Status: s})
+	}
+	return info
+}
+
+func TestLeaveStateOf(t *testing.T) {
+	tests := []struct {
+		name string
+		info *types.LifecycleInfo
+		want LeaveState
+	}{
+		{"nil", nil, LeaveNone},
+		{"active", lifecycle(types.UVStatus_UV_STATUS_PENDING_JOIN, types.UVStatus_UV_STATUS_ACTIVE), LeaveNone},
+		{"pending leave", lifecycle(types.UVStatus_UV_STATUS_ACTIVE, types.UVStatus_UV_STATUS_PENDING_LEAVE), LeavePending},
+		{"inactive after leave", lifecycle(types.UVStatus_UV_STATUS_ACTIVE, types.UVStatus_UV_STATUS_PENDING_LEAVE, types.UVStatus_UV_STATUS_INACTIVE), LeaveComplete},
+		{"inactive without leave", lifecycle(types.UVStatus_UV_STATUS_PENDING_JOIN, types.UVStatus_UV_STATUS_INACTIVE), LeaveNone},
+		{"inactive no history", &types.LifecycleInfo{CurrentStatus: types.UVStatus_UV_STATUS_INACTIVE}, LeaveNone},
+		{"leave reverted", lifecycle(types.UVStatus_UV_STATUS_ACTIVE, types.UVStatus_UV_STATUS_PENDING_LEAVE, types.UVStatus_UV_STATUS_ACTIVE), LeaveNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, leaveStateOf(tt.info))
+		})
+	}
+}
+
+func TestLeaveTracker(t *testing.T) {
+	tr := newLeaveTracker()
+	assert.Equal(t, LeaveNone, tr.get())
+
+	assert.Equal(t, LeaveNone, tr.update(LeavePending))
+	assert.Equal(t, LeavePending, tr.get())
+
+	// A cancelled leave can be started again.
+	assert.Equal(t, LeavePending, tr.update(LeaveNone))
+	assert.Equal(t, LeaveNone, tr.update(LeavePending))
+
+	assert.Equal(t, LeavePending, tr.update(LeaveComplete))
+
+	// Completion is final.
+	assert.Equal(t, LeaveComplete, tr.update(LeaveNone))
+	assert.Equal(t, LeaveComplete, tr.update(LeaveComplete))
+	assert.Equal(t, LeaveComplete, tr.get())
+}
+
+func TestNode_LeaveStateBeforeStart(t *testing.T) {
+	node, _, _ := setupTestNode(t)
+	assert.Equal(t, LeaveNone, node.LeaveState())
+}
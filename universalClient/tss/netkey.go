@@ -0,0 +1,117 @@
+package tss
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/pushchain/push-chain-node/universalClient/config"
+)
+
+// GenerateNetworkKey returns a fresh hex-encoded Ed25519 seed for use as the
+// node's libp2p identity (the tss_p2p_private_key_hex config value).
+func GenerateNetworkKey() (string, error) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return "", fmt.Errorf("failed to generate key: %w", err)
+	}
+	return hex.EncodeToString(seed), nil
+}
+
+// PeerIDFromNetworkKey derives the libp2p peer ID for a hex-encoded network key.
+func PeerIDFromNetworkKey(hexKey string) (string, error) {
+	privateKeyBase64, err := convertPrivateKeyHexToBase64(hexKey)
+	if err != nil {
+		return "", err
+	}
+	raw, err := base64.StdEncoding.DecodeString(privateKeyBase64)
+	if err != nil {
+		return "", err
+	}
+	priv, err := crypto.UnmarshalPrivateKey(raw)
+	if err != nil {
+		return "", err
+	}
+	id, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return "", fmt.Errorf("failed to derive peer ID: %w", err)
+	}
+	return id.String(), nil
+}
+
+// RotateNetworkKey replaces the libp2p network key of the node under home
+// and returns the old and new peer IDs. The new key is written where the node
+// reads it from: tss_p2p_private_key_file when set, the config otherwise. A
+// key from the environment cannot be rotated here. Only the network identity
+// changes; DKLS keyshares are not touched. The new peer ID must be
+// re-registered on chain before peers will accept connections from it.
+func RotateNetworkKey(home string) (oldPeerID, newPeerID string, err error) {
+	cfg, err := config.Load(home)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.NodeHome == "" {
+		cfg.NodeHome = home
+	}
+	keyFile := cfg.TSSPrivateKeyFilePath()
+	// An unreadable or unparseable old key is what rotation fixes, so don't
+	// fail on it.
+	oldKey, source, _ := cfg.TSSPrivateKey()
+	if keyFile == "" && source == config.TSSKeySourceEnv {
+		return "", "", fmt.Errorf("network key is read from the %s env var; rotate it there instead", config.EnvTSSPrivateKey)
+	}
+	if oldKey != "" {
+		oldPeerID, _ = PeerIDFromNetworkKey(oldKey)
+	}
+
+	newKey, err := GenerateNetworkKey()
+	if err != nil {
+		return "", "", err
+	}
+	newPeerID, err = PeerIDFromNetworkKey(newKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	if keyFile != "" {
+		if err := writeKeyFile(keyFile, newKey); err != nil {
+			return "", "", fmt.Errorf("failed to write tss_p2p_private_key_file: %w", err)
+		}
+		return oldPeerID, newPeerID, nil
+	}
+	cfg.TSSP2PPrivateKeyHex = newKey
+	if err := config.Save(&cfg, home); err != nil {
+		return "", "", fmt.Errorf("failed to save config: %w", err)
+	}
+	return oldPeerID, newPeerID, nil
+}
+
+// writeKeyFile replaces path with key, readable by the owner only. The key is
+// written to a temporary file first so a failed write never leaves the node
+// without a key.
+func writeKeyFile(path, key string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.WriteString(key + "\n"); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,122 @@
+package tss
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pushchain/push-chain-node/universalClient/config"
+	"github.com/pushchain/push-chain-node/universalClient/tss/keyshare"
+)
+
+func TestPeerIDFromNetworkKey(t *testing.T) {
+	key, err := GenerateNetworkKey()
+	require.NoError(t, err)
+
+	id1, err := PeerIDFromNetworkKey(key)
+	require.NoError(t, err)
+	id2, err := PeerIDFromNetworkKey(key)
+	require.NoError(t, err)
+	assert.Equal(t, id1, id2, "peer ID is deterministic in the key")
+
+	_, err = PeerIDFromNetworkKey("not-hex")
+	assert.Error(t, err)
+}
+
+func TestRotateNetworkKey(t *testing.T) {
+	t.Setenv(config.EnvTSSPrivateKey, "")
+	home := t.TempDir()
+	cfg, err := config.LoadDefaultConfig()
+	require.NoError(t, err)
+	cfg.TSSP2PPrivateKeyHex = generateTestPrivateKey(t)
+	require.NoError(t, config.Save(&cfg, home))
+	wantOld, err := PeerIDFromNetworkKey(cfg.TSSP2PPrivateKeyHex)
+	require.NoError(t, err)
+
+	mgr, err := keyshare.NewManager(home, "test-password")
+	require.NoError(t, err)
+	require.NoError(t, mgr.Store([]byte("keyshare-bytes"), "key-1"))
+	keyshareFiles := func() map[string][]byte {
+		files := make(map[string][]byte)
+		require.NoError(t, filepath.WalkDir(filepath.Join(home, "keyshares"), func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			files[path] = data
+			return err
+		}))
+		return files
+	}
+	before := keyshareFiles()
+	require.NotEmpty(t, before)
+
+	oldPeerID, newPeerID, err := RotateNetworkKey(home)
+	require.NoError(t, err)
+	assert.Equal(t, wantOld, oldPeerID)
+	assert.NotEqual(t, oldPeerID, newPeerID)
+
+	loaded, err := config.Load(home)
+	require.NoError(t, err)
+	assert.NotEqual(t, cfg.TSSP2PPrivateKeyHex, loaded.TSSP2PPrivateKeyHex)
+	gotPeerID, err := PeerIDFromNetworkKey(loaded.TSSP2PPrivateKeyHex)
+	require.NoError(t, err)
+	assert.Equal(t, newPeerID, gotPeerID, "saved key yields the printed peer ID")
+
+	assert.Equal(t, before, keyshareFiles(), "keyshares must be untouched")
+	got, err := mgr.Get("key-1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("keyshare-bytes"), got)
+}
+
+func TestRotateNetworkKey_KeyFile(t *testing.T) {
+	t.Setenv(config.EnvTSSPrivateKey, "")
+	home := t.TempDir()
+	cfg, err := config.LoadDefaultConfig()
+	require.NoError(t, err)
+	cfg.TSSP2PPrivateKeyHex = generateTestPrivateKey(t)
+	cfg.NodeHome = home
+	cfg.TSSP2PPrivateKeyFile = "netkey"
+	require.NoError(t, config.Save(&cfg, home))
+	fileKey := generateTestPrivateKey(t)
+	keyPath := filepath.Join(home, "netkey")
+	require.NoError(t, os.WriteFile(keyPath, []byte(fileKey+"\n"), 0o600))
+	wantOld, err := PeerIDFromNetworkKey(fileKey)
+	require.NoError(t, err)
+
+	oldPeerID, newPeerID, err := RotateNetworkKey(home)
+	require.NoError(t, err)
+	assert.Equal(t, wantOld, oldPeerID, "old peer ID comes from the key file")
+
+	data, err := os.ReadFile(keyPath)
+	require.NoError(t, err)
+	gotPeerID, err := PeerIDFromNetworkKey(strings.TrimSpace(string(data)))
+	require.NoError(t, err)
+	assert.Equal(t, newPeerID, gotPeerID, "key file holds the new key")
+	info, err := os.Stat(keyPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	loaded, err := config.Load(home)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.TSSP2PPrivateKeyHex, loaded.TSSP2PPrivateKeyHex, "config key must be left alone")
+}
+
+func TestRotateNetworkKey_RefusesShadowedKey(t *testing.T) {
+	home := t.TempDir()
+	cfg, err := config.LoadDefaultConfig()
+	require.NoError(t, err)
+	require.NoError(t, config.Save(&cfg, home))
+
+	t.Setenv(config.EnvTSSPrivateKey, generateTestPrivateKey(t))
+	_, _, err = RotateNetworkKey(home)
+	assert.ErrorContains(t, err, "env")
+
+	loaded, err := config.Load(home)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.TSSP2PPrivateKeyHex, loaded.TSSP2PPrivateKeyHex, "config key must be left alone")
+}
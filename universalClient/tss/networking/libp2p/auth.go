@@ -0,0 +1,41 @@
+package libp2p
+
+import (
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/rs/zerolog"
+)
+
+// PeerAuthorizer reports whether the peer with the given ID may exchange TSS
+// messages with this node.
+type PeerAuthorizer func(peerID string) bool
+
+// authGater is a libp2p connection gater that rejects inbound connections
+// from unauthorized peers once the security handshake has proven their peer
+// ID. Outbound dials are left alone: the node only dials peers it looked up
+// in the validator set.
+type authGater struct {
+	authorize PeerAuthorizer
+	logger    zerolog.Logger
+}
+
+func (g *authGater) InterceptPeerDial(peer.ID) bool               { return true }
+func (g *authGater) InterceptAddrDial(peer.ID, ma.Multiaddr) bool { return true }
+func (g *authGater) InterceptAccept(network.ConnMultiaddrs) bool  { return true }
+
+func (g *authGater) InterceptSecured(dir network.Direction, id peer.ID, addrs network.ConnMultiaddrs) bool {
+	if dir != network.DirInbound || g.authorize(id.String()) {
+		return true
+	}
+	g.logger.Warn().
+		Str("peer_id", id.String()).
+		Str("remote_addr", addrs.RemoteMultiaddr().String()).
+		Msg("rejecting connection from peer not in the validator set")
+	return false
+}
+
+func (g *authGater) InterceptUpgraded(network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
@@ -0,0 +1,38 @@
+package libp2p
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorize_AcceptsRegisteredRejectsUnknown(t *testing.T) {
+	registered, _ := newTestNetwork(t, Config{})
+	unknown, _ := newTestNetwork(t, Config{})
+
+	var allowed atomic.Value
+	allowed.Store(registered.ID())
+	receiver, received := newTestNetwork(t, Config{
+		Authorize: func(peerID string) bool { return peerID == allowed.Load().(string) },
+	})
+	connect(t, registered, receiver)
+	connect(t, unknown, receiver)
+
+	require.NoError(t, registered.Send(context.Background(), receiver.ID(), []byte("hello")))
+	require.Eventually(t, func() bool { return received()[registered.ID()] == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	// The unknown peer's connection is refused at the handshake.
+	err := unknown.Send(context.Background(), receiver.ID(), []byte("hello"))
+	require.Error(t, err)
+	assert.Zero(t, received()[unknown.ID()])
+
+	// A peer removed from the set is cut off on its existing connection.
+	allowed.Store("")
+	_ = registered.Send(context.Background(), receiver.ID(), []byte("again"))
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, received()[registered.ID()])
+}
@@ -1,13 +1,41 @@
 package libp2p
 
-import "time"
+import (
+	"context"
+	"time"
+)
+
+// DefaultProtocolID is the stream protocol used when neither a protocol ID nor
+// a network namespace is configured.
+const DefaultProtocolID = "/push/tss/1.0.0"
+
+// ProtocolIDForNetwork returns the stream protocol for the TSS network
+// namespace ns, typically the Push Chain ID. Nodes only open streams to peers
+// speaking the same protocol, so nodes of different networks never exchange
+// TSS messages even when they can reach each other.
+func ProtocolIDForNetwork(ns string) string {
+	if ns == "" {
+		return DefaultProtocolID
+	}
+	return "/push/tss/" + ns + "/1.0.0"
+}
+
+// Default timeouts applied when Config leaves them unset.
+const (
+	DefaultDialTimeout = 10 * time.Second
+	DefaultIOTimeout   = 15 * time.Second
+)
 
 // Config controls the libp2p network behaviour.
 type Config struct {
 	// ListenAddrs is the list of multiaddrs to bind to. Defaults to /ip4/0.0.0.0/tcp/0.
 	ListenAddrs []string
-	// ProtocolID is the stream protocol identifier. Defaults to /push/tss/1.0.0.
+	// ProtocolID is the stream protocol identifier. Defaults to DefaultProtocolID.
 	ProtocolID string
+	// FallbackProtocolIDs are also served, and tried in order after ProtocolID
+	// when dialing, so a node stays reachable to and from peers that have not
+	// upgraded to ProtocolID yet.
+	FallbackProtocolIDs []string
 	// PrivateKeyBase64 optionally contains a base64-encoded libp2p private key.
 	// If empty, a fresh Ed25519 keypair is generated.
 	PrivateKeyBase64 string
@@ -15,6 +43,18 @@ type Config struct {
 	DialTimeout time.Duration
 	// IOTimeout bounds stream read/write operations.
 	IOTimeout time.Duration
+	// InboundIOTimeout bounds reading an inbound frame, whose protocol is not
+	// known until it has been read. Defaults to IOTimeout.
+	InboundIOTimeout time.Duration
+	// PeerMessageRate and PeerMessageBurst bound the inbound messages accepted
+	// from each peer per second; excess messages are dropped. Default to
+	// DefaultPeerMessageRate and DefaultPeerMessageBurst; a negative rate
+	// disables the limit.
+	PeerMessageRate  float64
+	PeerMessageBurst int
+	// Authorize, when set, restricts inbound connections and messages to the
+	// peers it accepts. Nil accepts every peer.
+	Authorize PeerAuthorizer
 }
 
 // setDefaults sets default values for unset fields.
@@ -23,13 +63,47 @@ func (c *Config) setDefaults() {
 		c.ListenAddrs = []string{"/ip4/0.0.0.0/tcp/0"}
 	}
 	if c.ProtocolID == "" {
-		c.ProtocolID = "/push/tss/1.0.0"
+		c.ProtocolID = DefaultProtocolID
 	}
 	if c.DialTimeout == 0 {
-		c.DialTimeout = 10 * time.Second
+		c.DialTimeout = DefaultDialTimeout
 	}
 	if c.IOTimeout == 0 {
-		c.IOTimeout = 15 * time.Second
+		c.IOTimeout = DefaultIOTimeout
+	}
+	if c.InboundIOTimeout == 0 {
+		c.InboundIOTimeout = c.IOTimeout
+	}
+	if c.PeerMessageRate == 0 {
+		c.PeerMessageRate = DefaultPeerMessageRate
+	}
+	if c.PeerMessageBurst == 0 {
+		c.PeerMessageBurst = DefaultPeerMessageBurst
 	}
 }
 
+type timeoutsKey struct{}
+
+type timeoutOverride struct {
+	dial, io time.Duration
+}
+
+// WithTimeouts returns a context under which Send uses dial and io in place of
+// Config.DialTimeout and Config.IOTimeout. A zero value keeps the configured one.
+func WithTimeouts(ctx context.Context, dial, io time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutsKey{}, timeoutOverride{dial: dial, io: io})
+}
+
+// sendTimeouts returns the dial and IO timeouts for a Send under ctx.
+func (c *Config) sendTimeouts(ctx context.Context) (dial, io time.Duration) {
+	dial, io = c.DialTimeout, c.IOTimeout
+	if t, ok := ctx.Value(timeoutsKey{}).(timeoutOverride); ok {
+		if t.dial > 0 {
+			dial = t.dial
+		}
+		if t.io > 0 {
+			io = t.io
+		}
+	}
+	return dial, io
+}
@@ -0,0 +1,110 @@
+package libp2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+)
+
+// Default inbound flood guard: sustained messages per second and burst per
+// peer. A DKLS round fans out one message per participant, so honest peers
+// stay far below this even for large committees.
+const (
+	DefaultPeerMessageRate  = 50
+	DefaultPeerMessageBurst = 200
+)
+
+// maxTrackedPeers bounds the limiter table; beyond it, peers idle for longer
+// than peerLimiterIdle are evicted on the next insert.
+const (
+	maxTrackedPeers = 1024
+	peerLimiterIdle = 5 * time.Minute
+	dropLogInterval = 10 * time.Second
+)
+
+type peerLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+	dropped  uint64
+	lastLog  time.Time
+}
+
+// peerGuard rate limits inbound messages per remote peer so one flooding peer
+// is dropped without affecting the others.
+type peerGuard struct {
+	rate  rate.Limit
+	burst int
+
+	mu    sync.Mutex
+	peers map[peer.ID]*peerLimiter
+}
+
+// newPeerGuard returns a guard admitting messagesPerSecond per peer with the
+// given burst. A non-positive rate disables the guard.
+func newPeerGuard(messagesPerSecond float64, burst int) *peerGuard {
+	if messagesPerSecond <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = 1
+	}
+	return &peerGuard{
+		rate:  rate.Limit(messagesPerSecond),
+		burst: burst,
+		peers: make(map[peer.ID]*peerLimiter),
+	}
+}
+
+// allow reports whether a message from id is admitted. When it is not, it
+// also returns the peer's running drop count and whether the caller should
+// log this drop (at most once per dropLogInterval per peer).
+func (g *peerGuard) allow(id peer.ID, now time.Time) (ok bool, dropped uint64, logDrop bool) {
+	if g == nil {
+		return true, 0, false
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	pl, found := g.peers[id]
+	if !found {
+		if len(g.peers) >= maxTrackedPeers {
+			g.evictIdle(now)
+		}
+		pl = &peerLimiter{limiter: rate.NewLimiter(g.rate, g.burst)}
+		g.peers[id] = pl
+	}
+	pl.lastSeen = now
+
+	if pl.limiter.AllowN(now, 1) {
+		return true, pl.dropped, false
+	}
+	pl.dropped++
+	if now.Sub(pl.lastLog) >= dropLogInterval {
+		pl.lastLog = now
+		logDrop = true
+	}
+	return false, pl.dropped, logDrop
+}
+
+// droppedFrom returns how many messages from id have been dropped.
+func (g *peerGuard) droppedFrom(id peer.ID) uint64 {
+	if g == nil {
+		return 0
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if pl, ok := g.peers[id]; ok {
+		return pl.dropped
+	}
+	return 0
+}
+
+func (g *peerGuard) evictIdle(now time.Time) {
+	for id, pl := range g.peers {
+		if now.Sub(pl.lastSeen) > peerLimiterIdle {
+			delete(g.peers, id)
+		}
+	}
+}
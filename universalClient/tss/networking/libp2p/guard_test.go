@@ -0,0 +1,144 @@
+package libp2p
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerGuard_FloodingPeerDoesNotAffectOthers(t *testing.T) {
+	g := newPeerGuard(1, 3)
+	now := time.Unix(1_700_000_000, 0)
+	flooder, honest := peer.ID("flooder"), peer.ID("honest")
+
+	admitted := 0
+	for i := 0; i < 100; i++ {
+		if ok, _, _ := g.allow(flooder, now); ok {
+			admitted++
+		}
+	}
+	assert.Equal(t, 3, admitted, "only the burst is admitted")
+	assert.Equal(t, uint64(97), g.droppedFrom(flooder))
+
+	ok, _, _ := g.allow(honest, now)
+	assert.True(t, ok, "another peer keeps its own budget")
+	assert.Zero(t, g.droppedFrom(honest))
+
+	// The flooder recovers as its bucket refills.
+	ok, _, _ = g.allow(flooder, now.Add(time.Second))
+	assert.True(t, ok)
+}
+
+func TestPeerGuard_LogsDropsAtIntervals(t *testing.T) {
+	g := newPeerGuard(1, 1)
+	now := time.Unix(1_700_000_000, 0)
+	id := peer.ID("flooder")
+
+	_, _, _ = g.allow(id, now)
+	_, _, logDrop := g.allow(id, now)
+	assert.True(t, logDrop, "first drop is logged")
+	_, _, logDrop = g.allow(id, now)
+	assert.False(t, logDrop, "later drops inside the interval are not")
+	_, _, _ = g.allow(id, now.Add(dropLogInterval))
+	_, _, logDrop = g.allow(id, now.Add(dropLogInterval))
+	assert.True(t, logDrop)
+}
+
+func TestPeerGuard_Disabled(t *testing.T) {
+	g := newPeerGuard(-1, 0)
+	assert.Nil(t, g)
+	ok, _, _ := g.allow(peer.ID("any"), time.Now())
+	assert.True(t, ok)
+}
+
+// newTestNetwork starts a loopback network that records delivered messages.
+func newTestNetwork(t *testing.T, cfg Config) (*Network, func() map[string]int) {
+	t.Helper()
+	cfg.ListenAddrs = []string{"/ip4/127.0.0.1/tcp/0"}
+	n, err := New(context.Background(), cfg, zerolog.Nop())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = n.Close() })
+
+	var mu sync.Mutex
+	received := make(map[string]int)
+	require.NoError(t, n.RegisterHandler(func(peerID string, data []byte) {
+		mu.Lock()
+		received[peerID]++
+		mu.Unlock()
+	}))
+	return n, func() map[string]int {
+		mu.Lock()
+		defer mu.Unlock()
+		out := make(map[string]int, len(received))
+		for k, v := range received {
+			out[k] = v
+		}
+		return out
+	}
+}
+
+func connect(t *testing.T, from, to *Network) {
+	t.Helper()
+	require.NoError(t, from.EnsurePeer(to.ID(), to.ListenAddrs()))
+}
+
+func TestHandleStream_RejectsOversizedMessage(t *testing.T) {
+	receiver, received := newTestNetwork(t, Config{})
+	sender, _ := newTestNetwork(t, Config{})
+	connect(t, sender, receiver)
+
+	// Send refuses oversized payloads, so write a raw frame that claims one.
+	info, err := sender.lookupPeer(receiver.ID())
+	require.NoError(t, err)
+	require.NoError(t, sender.host.Connect(context.Background(), info))
+	stream, err := sender.host.NewStream(context.Background(), info.ID, sender.protocolIDs...)
+	require.NoError(t, err)
+	var prefix [4]byte
+	binary.BigEndian.PutUint32(prefix[:], MaxFrameSize+1)
+	_, _ = stream.Write(prefix[:])
+	_ = stream.Close()
+
+	// A well-formed message afterwards is still delivered.
+	require.NoError(t, sender.Send(context.Background(), receiver.ID(), []byte("ok")))
+	require.Eventually(t, func() bool { return received()[sender.ID()] == 1 }, 2*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 1, received()[sender.ID()], "oversized frame must not reach the handler")
+}
+
+func TestHandleStream_RateLimitsFloodingPeer(t *testing.T) {
+	// A negligible refill rate makes the burst the whole budget for the test.
+	receiver, received := newTestNetwork(t, Config{PeerMessageRate: 0.001, PeerMessageBurst: 5})
+	flooder, _ := newTestNetwork(t, Config{})
+	honest, _ := newTestNetwork(t, Config{})
+	connect(t, flooder, receiver)
+	connect(t, honest, receiver)
+
+	for i := 0; i < 20; i++ {
+		_ = flooder.Send(context.Background(), receiver.ID(), []byte("flood"))
+	}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, honest.Send(context.Background(), receiver.ID(), []byte("hello")))
+	}
+
+	flooderID := mustDecode(t, flooder.ID())
+	require.Eventually(t, func() bool {
+		got := received()
+		return got[flooder.ID()] == 5 && got[honest.ID()] == 3 &&
+			receiver.guard.droppedFrom(flooderID) == 15
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Zero(t, receiver.guard.droppedFrom(mustDecode(t, honest.ID())))
+}
+
+func mustDecode(t *testing.T, id string) peer.ID {
+	t.Helper()
+	pid, err := peer.Decode(id)
+	require.NoError(t, err)
+	return pid
+}
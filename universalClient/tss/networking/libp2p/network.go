@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -31,11 +32,14 @@ import (
 // observed DKLS Step() + coordinator.Message wrapping for our committee sizes.
 const MaxFrameSize = 1 * 1024 * 1024 // 1 MiB
 
+// errFrameTooLarge is returned by readFramed for a length prefix above MaxFrameSize.
+var errFrameTooLarge = errors.New("frame size too large")
+
 // Network implements networking.Network using libp2p.
 type Network struct {
-	cfg        Config
-	host       host.Host
-	protocolID protocol.ID
+	cfg         Config
+	host        host.Host
+	protocolIDs []protocol.ID // ProtocolID first, then the fallbacks
 
 	handlerMu sync.RWMutex
 	handler   networking.MessageHandler
@@ -43,6 +47,8 @@ type Network struct {
 	peerMu sync.RWMutex
 	peers  map[string]peer.AddrInfo
 
+	guard *peerGuard // per-peer inbound rate limit; nil when disabled
+
 	logger zerolog.Logger
 }
 
@@ -58,23 +64,36 @@ func New(ctx context.Context, cfg Config, logger zerolog.Logger) (*Network, erro
 		return nil, err
 	}
 
-	host, err := libp2p.New(
+	log := logger.With().Str("component", "networking_libp2p").Logger()
+	opts := []libp2p.Option{
 		libp2p.Identity(priv),
 		libp2p.ListenAddrStrings(cfg.ListenAddrs...),
-	)
+	}
+	if cfg.Authorize != nil {
+		opts = append(opts, libp2p.ConnectionGater(&authGater{authorize: cfg.Authorize, logger: log}))
+	}
+	host, err := libp2p.New(opts...)
 	if err != nil {
 		return nil, err
 	}
 
 	n := &Network{
-		cfg:        cfg,
-		host:       host,
-		protocolID: protocol.ID(cfg.ProtocolID),
-		peers:      make(map[string]peer.AddrInfo),
-		logger:     logger.With().Str("component", "networking_libp2p").Logger(),
+		cfg:    cfg,
+		host:   host,
+		peers:  make(map[string]peer.AddrInfo),
+		guard:  newPeerGuard(cfg.PeerMessageRate, cfg.PeerMessageBurst),
+		logger: log,
+	}
+	n.protocolIDs = append(n.protocolIDs, protocol.ID(cfg.ProtocolID))
+	for _, id := range cfg.FallbackProtocolIDs {
+		if id != "" && id != cfg.ProtocolID {
+			n.protocolIDs = append(n.protocolIDs, protocol.ID(id))
+		}
 	}
 
-	host.SetStreamHandler(n.protocolID, n.handleStream)
+	for _, id := range n.protocolIDs {
+		host.SetStreamHandler(id, n.handleStream)
+	}
 	return n, nil
 }
 
@@ -142,7 +161,9 @@ func (n *Network) Send(ctx context.Context, peerID string, data []byte) error {
 		return err
 	}
 
-	dialCtx, cancel := context.WithTimeout(ctx, n.cfg.DialTimeout)
+	dialTimeout, ioTimeout := n.cfg.sendTimeouts(ctx)
+
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
 	defer cancel()
 
 	// Try to connect (libp2p will reuse existing connections)
@@ -151,17 +172,17 @@ func (n *Network) Send(ctx context.Context, peerID string, data []byte) error {
 	}
 
 	// Create stream with timeout
-	streamCtx, streamCancel := context.WithTimeout(ctx, n.cfg.DialTimeout)
+	streamCtx, streamCancel := context.WithTimeout(ctx, dialTimeout)
 	defer streamCancel()
 
-	stream, err := n.host.NewStream(streamCtx, info.ID, n.protocolID)
+	stream, err := n.host.NewStream(streamCtx, info.ID, n.protocolIDs...)
 	if err != nil {
 		return fmt.Errorf("failed to create stream to peer %s: %w", peerID, err)
 	}
 	defer stream.Close()
 
 	// Set write deadline
-	deadline := time.Now().Add(n.cfg.IOTimeout)
+	deadline := time.Now().Add(ioTimeout)
 	if err := stream.SetWriteDeadline(deadline); err != nil {
 		return fmt.Errorf("failed to set write deadline: %w", err)
 	}
@@ -179,6 +200,23 @@ func (n *Network) Send(ctx context.Context, peerID string, data []byte) error {
 	return nil
 }
 
+// Connect implements networking.Network.
+func (n *Network) Connect(ctx context.Context, peerID string) error {
+	info, err := n.lookupPeer(peerID)
+	if err != nil {
+		return err
+	}
+
+	dialTimeout, _ := n.cfg.sendTimeouts(ctx)
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	if err := n.host.Connect(dialCtx, info); err != nil {
+		return fmt.Errorf("failed to connect to peer %s: %w", peerID, err)
+	}
+	return nil
+}
+
 func (n *Network) Close() error {
 	return n.host.Close()
 }
@@ -196,12 +234,39 @@ func (n *Network) lookupPeer(peerID string) (peer.AddrInfo, error) {
 func (n *Network) handleStream(stream network.Stream) {
 	defer stream.Close()
 
-	if deadline := time.Now().Add(n.cfg.IOTimeout); true {
+	remote := stream.Conn().RemotePeer()
+
+	// The connection gate only runs at handshake; re-check so a peer that left
+	// the validator set over a live connection is cut off too.
+	if n.cfg.Authorize != nil && !n.cfg.Authorize(remote.String()) {
+		_ = stream.Reset()
+		n.logger.Warn().Str("peer_id", remote.String()).Msg("dropping message from peer not in the validator set")
+		return
+	}
+
+	// Drop floods before reading, so an over-limit peer costs no allocation.
+	if ok, dropped, logDrop := n.guard.allow(remote, time.Now()); !ok {
+		_ = stream.Reset()
+		if logDrop {
+			n.logger.Warn().
+				Str("peer_id", remote.String()).
+				Uint64("dropped_total", dropped).
+				Msg("peer exceeded inbound message rate, dropping messages")
+		}
+		return
+	}
+
+	if deadline := time.Now().Add(n.cfg.InboundIOTimeout); true {
 		_ = stream.SetReadDeadline(deadline)
 	}
 
 	data, err := readFramed(stream)
 	if err != nil {
+		if errors.Is(err, errFrameTooLarge) {
+			_ = stream.Reset()
+			n.logger.Warn().Err(err).Str("peer_id", remote.String()).Msg("dropping oversized message from peer")
+			return
+		}
 		n.logger.Warn().Err(err).Msg("read failed")
 		return
 	}
@@ -214,7 +279,7 @@ func (n *Network) handleStream(stream network.Stream) {
 	}
 
 	// Call handler in a goroutine to avoid blocking
-	go handler(stream.Conn().RemotePeer().String(), data)
+	go handler(remote.String(), data)
 }
 
 func loadIdentity(base64Key string) (crypto.PrivKey, error) {
@@ -254,7 +319,7 @@ func readFramed(r io.Reader) ([]byte, error) {
 		return nil, err
 	}
 	if length > MaxFrameSize {
-		return nil, fmt.Errorf("frame size %d exceeds maximum %d", length, MaxFrameSize)
+		return nil, fmt.Errorf("%w: %d exceeds maximum %d", errFrameTooLarge, length, MaxFrameSize)
 	}
 	buf := make([]byte, length)
 	if _, err := io.ReadFull(br, buf); err != nil {
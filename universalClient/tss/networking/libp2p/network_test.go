@@ -2,9 +2,11 @@ package libp2p
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -78,3 +80,88 @@ func TestWriteFramed_AcceptsAtMaxFrameSize(t *testing.T) {
 	assert.Equal(t, payload[len(payload)-1], got[len(got)-1])
 }
 
+func TestConfig_SendTimeouts(t *testing.T) {
+	cfg := Config{DialTimeout: 5 * time.Second, IOTimeout: 20 * time.Second}
+	cfg.setDefaults()
+	assert.Equal(t, 20*time.Second, cfg.InboundIOTimeout)
+
+	dial, ioTimeout := cfg.sendTimeouts(context.Background())
+	assert.Equal(t, 5*time.Second, dial)
+	assert.Equal(t, 20*time.Second, ioTimeout)
+
+	dial, ioTimeout = cfg.sendTimeouts(WithTimeouts(context.Background(), 30*time.Second, 2*time.Minute))
+	assert.Equal(t, 30*time.Second, dial)
+	assert.Equal(t, 2*time.Minute, ioTimeout)
+
+	// Zero overrides keep the configured values.
+	dial, ioTimeout = cfg.sendTimeouts(WithTimeouts(context.Background(), 0, time.Minute))
+	assert.Equal(t, 5*time.Second, dial)
+	assert.Equal(t, time.Minute, ioTimeout)
+}
+
+func TestConnect(t *testing.T) {
+	a, _ := newTestNetwork(t, Config{})
+	b, _ := newTestNetwork(t, Config{})
+
+	// Unregistered peers can't be dialed.
+	require.Error(t, a.Connect(context.Background(), b.ID()))
+
+	connect(t, a, b)
+	require.NoError(t, a.Connect(context.Background(), b.ID()))
+
+	// A peer that went away is reported unreachable within the dial timeout.
+	require.NoError(t, b.Close())
+	require.NoError(t, a.host.Network().ClosePeer(b.host.ID()))
+	start := time.Now()
+	require.Error(t, a.Connect(WithTimeouts(context.Background(), 500*time.Millisecond, 0), b.ID()))
+	require.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestProtocolIDForNetwork(t *testing.T) {
+	assert.Equal(t, "/push/tss/push_42101-1/1.0.0", ProtocolIDForNetwork("push_42101-1"))
+	assert.Equal(t, DefaultProtocolID, ProtocolIDForNetwork(""))
+}
+
+func TestSend_DifferentProtocolIDsDoNotConnect(t *testing.T) {
+	testnet, _ := newTestNetwork(t, Config{ProtocolID: ProtocolIDForNetwork("push_42101-1")})
+	mainnet, received := newTestNetwork(t, Config{ProtocolID: ProtocolIDForNetwork("push_9-1")})
+	connect(t, testnet, mainnet)
+
+	// The hosts can reach each other, but no TSS stream is negotiated.
+	require.Error(t, testnet.Send(context.Background(), mainnet.ID(), []byte("hello")))
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, received()[testnet.ID()])
+
+	// A node on the same network is reachable.
+	sibling, _ := newTestNetwork(t, Config{ProtocolID: ProtocolIDForNetwork("push_9-1")})
+	connect(t, sibling, mainnet)
+	require.NoError(t, sibling.Send(context.Background(), mainnet.ID(), []byte("hello")))
+	require.Eventually(t, func() bool { return received()[sibling.ID()] == 1 }, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestSend_FallbackProtocolReachesLegacyPeers(t *testing.T) {
+	upgraded, fromLegacy := newTestNetwork(t, Config{
+		ProtocolID:          ProtocolIDForNetwork("push_9-1"),
+		FallbackProtocolIDs: []string{DefaultProtocolID},
+	})
+	legacy, fromUpgraded := newTestNetwork(t, Config{ProtocolID: DefaultProtocolID})
+	connect(t, upgraded, legacy)
+	connect(t, legacy, upgraded)
+
+	// Both directions negotiate the legacy protocol during a rolling upgrade.
+	require.NoError(t, upgraded.Send(context.Background(), legacy.ID(), []byte("hello")))
+	require.Eventually(t, func() bool { return fromUpgraded()[upgraded.ID()] == 1 }, 2*time.Second, 10*time.Millisecond)
+	require.NoError(t, legacy.Send(context.Background(), upgraded.ID(), []byte("hello")))
+	require.Eventually(t, func() bool { return fromLegacy()[legacy.ID()] == 1 }, 2*time.Second, 10*time.Millisecond)
+
+	// Upgraded peers prefer the network protocol.
+	sibling, _ := newTestNetwork(t, Config{
+		ProtocolID:          ProtocolIDForNetwork("push_9-1"),
+		FallbackProtocolIDs: []string{DefaultProtocolID},
+	})
+	connect(t, sibling, upgraded)
+	require.NoError(t, sibling.Send(context.Background(), upgraded.ID(), []byte("hello")))
+	protos, err := sibling.host.Peerstore().SupportsProtocols(upgraded.host.ID(), sibling.protocolIDs[0])
+	require.NoError(t, err)
+	assert.Len(t, protos, 1)
+}
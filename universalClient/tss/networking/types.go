@@ -30,6 +30,11 @@ type Network interface {
 	// data: The raw data to send
 	Send(ctx context.Context, peerID string, data []byte) error
 
+	// Connect dials a peer unless a connection is already open, so callers
+	// can probe reachability without sending a message.
+	// peerID: The target peer's identifier
+	Connect(ctx context.Context, peerID string) error
+
 	// Close releases all resources.
 	Close() error
 }
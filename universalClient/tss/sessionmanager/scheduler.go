@@ -0,0 +1,129 @@
+package sessionmanager
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+)
+
+// errAlreadyScheduled is returned when a session for the event is already
+// running or waiting for its turn.
+var errAlreadyScheduled = errors.New("session already scheduled")
+
+// isKeyMutating reports whether protocolType produces or replaces the TSS key.
+func isKeyMutating(protocolType string) bool {
+	switch protocolType {
+	case store.EventTypeKeygen, store.EventTypeKeyrefresh, store.EventTypeQuorumChange:
+		return true
+	}
+	return false
+}
+
+// scheduler orders sessions by protocol type. Key-mutating sessions (keygen,
+// key refresh, quorum change) run alone: they wait for running signs to
+// finish, and while one runs or waits no new sign starts, so a sign never
+// starts against a key that is being replaced. Signs run concurrently with
+// each other, up to maxSigns when it is positive.
+type scheduler struct {
+	mu         sync.Mutex
+	maxSigns   int
+	signs      int             // running sign sessions
+	keyOp      bool            // a key-mutating session is running
+	keyWaiting int             // key-mutating sessions waiting for their turn
+	running    map[string]bool // eventID -> key-mutating
+	waiting    map[string]bool // eventIDs waiting for their turn
+	changed    chan struct{}   // closed and replaced whenever a slot may have freed up
+}
+
+func newScheduler(maxSigns int) *scheduler {
+	return &scheduler{
+		maxSigns: maxSigns,
+		running:  make(map[string]bool),
+		waiting:  make(map[string]bool),
+		changed:  make(chan struct{}),
+	}
+}
+
+// acquire blocks until a session of protocolType for eventID may run, or ctx
+// is done. Each successful acquire must be paired with release(eventID).
+func (s *scheduler) acquire(ctx context.Context, eventID, protocolType string) error {
+	mutating := isKeyMutating(protocolType)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.running[eventID]; ok || s.waiting[eventID] {
+		return errAlreadyScheduled
+	}
+	s.waiting[eventID] = true
+	if mutating {
+		s.keyWaiting++
+	}
+	defer func() {
+		delete(s.waiting, eventID)
+		if mutating {
+			s.keyWaiting--
+		}
+		// A departing key-mutating waiter may unblock signs.
+		s.notify()
+	}()
+
+	for !s.canRun(mutating) {
+		changed := s.changed
+		s.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			return ctx.Err()
+		case <-changed:
+		}
+		s.mu.Lock()
+	}
+
+	s.running[eventID] = mutating
+	if mutating {
+		s.keyOp = true
+	} else {
+		s.signs++
+	}
+	return nil
+}
+
+// canRun must be called with s.mu held.
+func (s *scheduler) canRun(mutating bool) bool {
+	if s.keyOp {
+		return false
+	}
+	if mutating {
+		return s.signs == 0
+	}
+	if s.keyWaiting > 0 {
+		return false
+	}
+	return s.maxSigns <= 0 || s.signs < s.maxSigns
+}
+
+// release frees the slot held by eventID. Releasing an event that holds no
+// slot is a no-op.
+func (s *scheduler) release(eventID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	mutating, ok := s.running[eventID]
+	if !ok {
+		return
+	}
+	delete(s.running, eventID)
+	if mutating {
+		s.keyOp = false
+	} else {
+		s.signs--
+	}
+	s.notify()
+}
+
+// notify wakes all waiters; must be called with s.mu held.
+func (s *scheduler) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
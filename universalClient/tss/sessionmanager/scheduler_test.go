@@ -0,0 +1,133 @@
+package sessionmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+)
+
+// acquireAsync starts acquire in a goroutine and returns a channel that
+// receives its result.
+func acquireAsync(ctx context.Context, s *scheduler, eventID, protocolType string) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- s.acquire(ctx, eventID, protocolType) }()
+	return done
+}
+
+func requireBlocked(t *testing.T, done <-chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Fatalf("acquire returned early: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func requireAcquired(t *testing.T, done <-chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("acquire did not return")
+	}
+}
+
+func TestScheduler_SignWaitsForKeyrefresh(t *testing.T) {
+	s := newScheduler(0)
+	ctx := context.Background()
+
+	require.NoError(t, s.acquire(ctx, "refresh", store.EventTypeKeyrefresh))
+
+	sign := acquireAsync(ctx, s, "sign", store.EventTypeSignOutbound)
+	requireBlocked(t, sign)
+
+	s.release("refresh")
+	requireAcquired(t, sign)
+}
+
+func TestScheduler_SignsRunConcurrently(t *testing.T) {
+	s := newScheduler(0)
+	ctx := context.Background()
+
+	require.NoError(t, s.acquire(ctx, "sign-1", store.EventTypeSignOutbound))
+	requireAcquired(t, acquireAsync(ctx, s, "sign-2", store.EventTypeSignFundMigrate))
+	assert.Equal(t, 2, s.signs)
+}
+
+func TestScheduler_KeyMutationWaitsForSignsAndBlocksNewOnes(t *testing.T) {
+	s := newScheduler(0)
+	ctx := context.Background()
+
+	require.NoError(t, s.acquire(ctx, "sign-1", store.EventTypeSignOutbound))
+
+	qc := acquireAsync(ctx, s, "qc", store.EventTypeQuorumChange)
+	requireBlocked(t, qc)
+
+	// A sign arriving while the quorum change waits queues behind it.
+	sign2 := acquireAsync(ctx, s, "sign-2", store.EventTypeSignOutbound)
+	requireBlocked(t, sign2)
+
+	s.release("sign-1")
+	requireAcquired(t, qc)
+	requireBlocked(t, sign2)
+
+	s.release("qc")
+	requireAcquired(t, sign2)
+}
+
+func TestScheduler_KeyMutationsSerialized(t *testing.T) {
+	s := newScheduler(0)
+	ctx := context.Background()
+
+	require.NoError(t, s.acquire(ctx, "keygen", store.EventTypeKeygen))
+	refresh := acquireAsync(ctx, s, "refresh", store.EventTypeKeyrefresh)
+	requireBlocked(t, refresh)
+
+	s.release("keygen")
+	requireAcquired(t, refresh)
+}
+
+func TestScheduler_MaxConcurrentSigns(t *testing.T) {
+	s := newScheduler(1)
+	ctx := context.Background()
+
+	require.NoError(t, s.acquire(ctx, "sign-1", store.EventTypeSignOutbound))
+	sign2 := acquireAsync(ctx, s, "sign-2", store.EventTypeSignOutbound)
+	requireBlocked(t, sign2)
+
+	s.release("sign-1")
+	requireAcquired(t, sign2)
+}
+
+func TestScheduler_Duplicate(t *testing.T) {
+	s := newScheduler(0)
+	ctx := context.Background()
+
+	require.NoError(t, s.acquire(ctx, "sign", store.EventTypeSignOutbound))
+	assert.ErrorIs(t, s.acquire(ctx, "sign", store.EventTypeSignOutbound), errAlreadyScheduled)
+
+	// Releasing an unknown event is a no-op.
+	s.release("unknown")
+	assert.Equal(t, 1, s.signs)
+}
+
+func TestScheduler_WaitCancelled(t *testing.T) {
+	s := newScheduler(0)
+
+	require.NoError(t, s.acquire(context.Background(), "sign", store.EventTypeSignOutbound))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := s.acquire(ctx, "keygen", store.EventTypeKeygen)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The abandoned key mutation no longer holds back signs.
+	assert.Equal(t, 0, s.keyWaiting)
+	requireAcquired(t, acquireAsync(context.Background(), s, "sign-2", store.EventTypeSignOutbound))
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/pushchain/push-chain-node/universalClient/chains"
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/pushcore"
 	"github.com/pushchain/push-chain-node/universalClient/pushsigner"
 	"github.com/pushchain/push-chain-node/universalClient/store"
@@ -22,6 +24,7 @@ import (
 	"github.com/pushchain/push-chain-node/universalClient/tss/dkls"
 	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
 	"github.com/pushchain/push-chain-node/universalClient/tss/keyshare"
+	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
 	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 	utsstypes "github.com/pushchain/push-chain-node/x/utss/types"
 )
@@ -58,6 +61,9 @@ type SessionManager struct {
 	// Session storage
 	mu       sync.RWMutex
 	sessions map[string]*sessionState // eventID -> sessionState
+
+	// Orders key-mutating sessions against signs
+	scheduler *scheduler
 }
 
 // NewSessionManager creates a new session manager.
@@ -89,9 +95,16 @@ func NewSessionManager(
 		logger:                     logger,
 		pushSigner:                 pushSigner,
 		sessions:                   make(map[string]*sessionState),
+		scheduler:                  newScheduler(0),
 	}
 }
 
+// SetMaxConcurrentSigns caps how many sign sessions may run at once on this
+// node. Zero or negative means no cap. Must be called before Start.
+func (sm *SessionManager) SetMaxConcurrentSigns(n int) {
+	sm.scheduler = newScheduler(n)
+}
+
 // Start starts the session manager's background goroutines (e.g. expiry checker).
 func (sm *SessionManager) Start(ctx context.Context) {
 	go sm.startExpiryChecker(ctx)
@@ -145,6 +158,8 @@ func (sm *SessionManager) handleSetupMessage(ctx context.Context, senderPeerID s
 	if err != nil {
 		return fmt.Errorf("event %s not found in database: %w", msg.EventID, err)
 	}
+	log := logger.WithTraceID(sm.logger, event.UniversalTxID())
+	ctx = coordinator.WithEventType(ctx, event.Type)
 
 	// 3b. Short-circuit: if this event already has signing data persisted from
 	// a prior successful session, respond to setup with an ACK carrying the
@@ -152,11 +167,11 @@ func (sm *SessionManager) handleSetupMessage(ctx context.Context, senderPeerID s
 	// fresh DKLS run
 	signed, signedErr := extractSignedDataFromEvent(event)
 	if signedErr != nil {
-		sm.logger.Warn().Err(signedErr).Str("event_id", msg.EventID).
+		log.Warn().Err(signedErr).Str("event_id", msg.EventID).
 			Msg("signing_data on event is corrupt; falling back to normal setup")
 	}
 	if signed != nil {
-		sm.logger.Info().
+		log.Info().
 			Str("event_id", msg.EventID).
 			Msg("event already has signing data, responding to setup with existing signature")
 		if err := sm.sendACK(ctx, senderPeerID, msg.EventID, signed); err != nil {
@@ -177,6 +192,17 @@ func (sm *SessionManager) handleSetupMessage(ctx context.Context, senderPeerID s
 		return fmt.Errorf("event %s has expired (expiry_block_height %d <= current_block %d)", msg.EventID, event.ExpiryBlockHeight, currentBlock)
 	}
 
+	// 4b. Refuse outbounds close to the end of their lifetime, whatever the
+	// coordinator decided: the resolver may vote them failed before the tx lands.
+	if event.Type == store.EventTypeSignOutbound && sm.chains != nil {
+		var outbound uexecutortypes.OutboundCreatedEvent
+		if err := json.Unmarshal(event.EventData, &outbound); err == nil &&
+			!sm.chains.OutboundSigningOpen(outbound.DestinationChain, event.BlockHeight, currentBlock) {
+			return fmt.Errorf("event %s: outbound lifetime on %s nearly over (created at %d, current block %d), refusing to sign",
+				msg.EventID, outbound.DestinationChain, event.BlockHeight, currentBlock)
+		}
+	}
+
 	// 5. Validate participants list matches event protocol requirements
 	if err := sm.validateParticipants(msg.Participants, event); err != nil {
 		return fmt.Errorf("participants validation failed: %w", err)
@@ -196,13 +222,28 @@ func (sm *SessionManager) handleSetupMessage(ctx context.Context, senderPeerID s
 		}
 	}
 
-	// 7. Create session based on protocol type
+	// 7. Wait for our turn: key-mutating sessions run alone, signs wait for
+	// them. Bounded by the session expiry, after which the coordinator
+	// retries anyway.
+	waitCtx, cancel := context.WithTimeout(ctx, sm.sessionExpiryTime)
+	err = sm.scheduler.acquire(waitCtx, msg.EventID, event.Type)
+	cancel()
+	if errors.Is(err, errAlreadyScheduled) {
+		log.Warn().Str("event_id", msg.EventID).Msg("session already scheduled, ignoring setup")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("timed out waiting to schedule %s session for event %s: %w", event.Type, msg.EventID, err)
+	}
+
+	// 8. Create session based on protocol type
 	session, err := sm.createSession(ctx, event, msg)
 	if err != nil {
+		sm.scheduler.release(msg.EventID)
 		return fmt.Errorf("failed to create session for event %s: %w", msg.EventID, err)
 	}
 
-	// 8. Store session state
+	// 9. Store session state
 	sm.mu.Lock()
 	sm.sessions[msg.EventID] = &sessionState{
 		session:      session,
@@ -214,19 +255,19 @@ func (sm *SessionManager) handleSetupMessage(ctx context.Context, senderPeerID s
 	}
 	sm.mu.Unlock()
 
-	// 9. Update event status to IN_PROGRESS
+	// 10. Update event status to IN_PROGRESS
 	if err := sm.eventStore.Update(msg.EventID, map[string]any{"status": store.StatusInProgress}); err != nil {
-		sm.logger.Warn().Err(err).Str("event_id", msg.EventID).Msg("failed to update event status")
+		log.Warn().Err(err).Str("event_id", msg.EventID).Msg("failed to update event status")
 	}
 
-	sm.logger.Info().
+	log.Info().
 		Str("event_id", msg.EventID).
 		Str("protocol", event.Type).
 		Msg("created session from setup message")
 
-	// 10. Send ACK to coordinator (no signed data — fresh session)
+	// 11. Send ACK to coordinator (no signed data — fresh session)
 	if err := sm.sendACK(ctx, senderPeerID, msg.EventID, nil); err != nil {
-		sm.logger.Warn().
+		log.Warn().
 			Err(err).
 			Str("event_id", msg.EventID).
 			Msg("failed to send ACK to coordinator")
@@ -294,6 +335,7 @@ func (sm *SessionManager) processSessionStep(ctx context.Context, eventID string
 	state.stepMu.Lock()
 	messages, finished, err := state.session.Step()
 	state.stepMu.Unlock()
+	ctx = coordinator.WithEventType(ctx, state.protocolType)
 
 	if err != nil {
 		return fmt.Errorf("failed to step session %s: %w", eventID, err)
@@ -347,6 +389,7 @@ func (sm *SessionManager) cleanSession(eventID string, state *sessionState) {
 	sm.mu.Lock()
 	delete(sm.sessions, eventID)
 	sm.mu.Unlock()
+	sm.scheduler.release(eventID)
 	state.session.Close()
 	sm.logger.Info().Str("event_id", eventID).Msg("session cleaned up")
 }
@@ -394,7 +437,7 @@ func (sm *SessionManager) handleSignatureBroadcast(ctx context.Context, senderPe
 	// either already persisted locally (we were a signer or got an earlier
 	// broadcast) or the tx flow has progressed past it.
 	switch event.Status {
-	case store.StatusSigned, store.StatusBroadcasted, store.StatusCompleted, store.StatusReverted:
+	case store.StatusSigned, store.StatusGatewayPaused, store.StatusBroadcasted, store.StatusCompleted, store.StatusReverted, store.StatusDryRun:
 		sm.logger.Debug().Str("event_id", msg.EventID).Str("status", event.Status).
 			Msg("signature_broadcast for event already past CONFIRMED, skipping")
 		if sm.coordinator != nil {
@@ -492,9 +535,11 @@ func (sm *SessionManager) handleSignFinished(ctx context.Context, eventID string
 	if err != nil {
 		return fmt.Errorf("failed to get event %s for broadcasting: %w", eventID, err)
 	}
+	log := logger.WithTraceID(sm.logger, event.UniversalTxID())
+	ctx = coordinator.WithEventType(ctx, event.Type)
 
 	if err := sm.handleSigningComplete(ctx, eventID, event.EventData, result.Signature, signingReq); err != nil {
-		sm.logger.Error().Err(err).Str("event_id", eventID).Msg("failed to complete signing process")
+		log.Error().Err(err).Str("event_id", eventID).Msg("failed to complete signing process")
 		return err
 	}
 
@@ -508,7 +553,7 @@ func (sm *SessionManager) handleSignFinished(ctx context.Context, eventID string
 		TSSFundMigrationAmount: signingReq.TSSFundMigrationAmount,
 	})
 
-	sm.logger.Info().Str("event_id", eventID).Msg("sign session finished successfully")
+	log.Info().Str("event_id", eventID).Msg("sign session finished successfully")
 	return nil
 }
 
@@ -940,6 +985,10 @@ func (sm *SessionManager) verifyOutboundSigningRequest(ctx context.Context, even
 		return nil
 	}
 
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, sm.eventStore, event.EventID,
+		logger.WithTraceID(sm.logger, event.UniversalTxID()).With().Str("event_id", event.EventID).Logger())
+	defer finishEndpoints()
+
 	// Guard against stale / replayed nonces: reject if coordinator's nonce is below the
 	// last finalized nonce on chain (i.e. that nonce has already been committed).
 	// We only hard-reject on a definitive answer — warn and skip if we can't determine it.
@@ -1020,6 +1069,10 @@ func (sm *SessionManager) verifyFundMigrationSigningRequest(ctx context.Context,
 		return nil
 	}
 
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, sm.eventStore, event.EventID,
+		logger.WithTraceID(sm.logger, event.UniversalTxID()).With().Str("event_id", event.EventID).Logger())
+	defer finishEndpoints()
+
 	// Guard against stale / replayed nonces: reject if coordinator's nonce is below the
 	// last finalized nonce on chain for the old TSS address.
 	if finalizedNonce, nonceErr := builder.GetNextNonce(ctx, oldTSSAddr, true /* useFinalized */); nonceErr != nil {
@@ -1109,13 +1162,14 @@ func (sm *SessionManager) handleSigningComplete(_ context.Context, eventID strin
 	if err != nil {
 		return fmt.Errorf("failed to persist signing data: %w", err)
 	}
+	log := logger.WithTraceID(sm.logger, (&store.Event{EventData: eventData}).UniversalTxID())
 	if !persisted {
-		sm.logger.Debug().Str("event_id", eventID).
+		log.Debug().Str("event_id", eventID).
 			Msg("signing data not persisted — event already past CONFIRMED/IN_PROGRESS")
 		return nil
 	}
 
-	sm.logger.Info().
+	log.Info().
 		Str("event_id", eventID).
 		Msg("signing complete — event marked SIGNED with signing_data for txBroadcaster")
 	return nil
@@ -63,6 +63,10 @@ func (m *mockPushCore) GetAllUniversalValidators(_ context.Context) ([]*types.Un
 	return nil, nil
 }
 
+func (m *mockPushCore) GetOutboundStatus(_ context.Context, _ string) (uexecutortypes.UniversalTxStatus, error) {
+	return uexecutortypes.UniversalTxStatus_OUTBOUND_PENDING, nil
+}
+
 // mockSession is a mock implementation of dkls.Session for testing.
 type mockSession struct {
 	mock.Mock
@@ -998,6 +1002,26 @@ func TestCleanSession(t *testing.T) {
 	mockSess.AssertCalled(t, "Close")
 }
 
+func TestCleanSession_ReleasesSchedulerSlot(t *testing.T) {
+	sm, _, _, _, _, _ := setupTestSessionManager(t)
+
+	require.NoError(t, sm.scheduler.acquire(context.Background(), "keygen-evt", store.EventTypeKeygen))
+
+	mockSess := new(mockSession)
+	mockSess.On("Close").Return()
+	state := &sessionState{session: mockSess, protocolType: store.EventTypeKeygen}
+	sm.mu.Lock()
+	sm.sessions["keygen-evt"] = state
+	sm.mu.Unlock()
+
+	sm.cleanSession("keygen-evt", state)
+
+	// A sign can start once the keygen session is gone.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, sm.scheduler.acquire(ctx, "sign-evt", store.EventTypeSignOutbound))
+}
+
 func TestStart_ContextCancellation(t *testing.T) {
 	sm, _, _, _, _, _ := setupTestSessionManager(t)
 	// Use a very short check interval so the goroutine ticks quickly.
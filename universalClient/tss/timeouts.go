@@ -0,0 +1,61 @@
+package tss
+
+import (
+	"context"
+	"time"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/coordinator"
+)
+
+// Timeouts overrides the network dial and IO timeouts for one protocol type.
+// Zero fields fall back to Config.DialTimeout and Config.IOTimeout.
+type Timeouts struct {
+	Dial time.Duration
+	IO   time.Duration
+}
+
+// protocolTimeouts maps each TSS event type to the overrides configured for
+// its protocol: keygen, reshare (key refresh and quorum change) or sign.
+func protocolTimeouts(cfg Config) map[string]Timeouts {
+	return map[string]Timeouts{
+		store.EventTypeKeygen:          cfg.KeygenTimeouts,
+		store.EventTypeKeyrefresh:      cfg.ReshareTimeouts,
+		store.EventTypeQuorumChange:    cfg.ReshareTimeouts,
+		store.EventTypeSignOutbound:    cfg.SignTimeouts,
+		store.EventTypeSignFundMigrate: cfg.SignTimeouts,
+	}
+}
+
+// longestIO returns the longest IO timeout among global and the overrides.
+// Inbound frames are read before their protocol is known, so the read
+// deadline has to accommodate the slowest protocol.
+func longestIO(global time.Duration, overrides map[string]Timeouts) time.Duration {
+	longest := global
+	for _, t := range overrides {
+		if t.IO > longest {
+			longest = t.IO
+		}
+	}
+	return longest
+}
+
+// sendTimeouts returns the dial and IO timeouts for a Send under ctx: the
+// overrides for the protocol of the event ctx is tagged with (see
+// coordinator.WithEventType), falling back per field to the global values.
+// Zero means the network's own default.
+func (n *Node) sendTimeouts(ctx context.Context) Timeouts {
+	t := Timeouts{Dial: n.networkCfg.DialTimeout, IO: n.networkCfg.IOTimeout}
+	eventType, ok := coordinator.EventTypeFromContext(ctx)
+	if !ok {
+		return t
+	}
+	override := n.protocolTimeouts[eventType]
+	if override.Dial > 0 {
+		t.Dial = override.Dial
+	}
+	if override.IO > 0 {
+		t.IO = override.IO
+	}
+	return t
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/pushchain/push-chain-node/universalClient/db"
 	"github.com/pushchain/push-chain-node/universalClient/pushcore"
 	"github.com/pushchain/push-chain-node/universalClient/pushsigner"
+	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/coordinator"
 	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
 	"github.com/pushchain/push-chain-node/universalClient/tss/expirysweeper"
@@ -27,6 +28,8 @@ import (
 	"github.com/pushchain/push-chain-node/universalClient/tss/sessionmanager"
 	"github.com/pushchain/push-chain-node/universalClient/tss/txbroadcaster"
 	"github.com/pushchain/push-chain-node/universalClient/tss/txresolver"
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+	"github.com/pushchain/push-chain-node/x/uvalidator/types"
 )
 
 // Config holds configuration for initializing a TSS node.
@@ -36,16 +39,35 @@ type Config struct {
 	LibP2PListen     string
 	HomeDir          string
 	Password         string
-	Database         *db.DB
+	Database         db.Store
 	PushCore         *pushcore.Client
 	Logger           zerolog.Logger
 
 	// Optional configuration
 	PollInterval     time.Duration
 	CoordinatorRange uint64
+	// ProtocolID overrides the libp2p stream protocol. When empty it is
+	// derived from NetworkNamespace (normally the Push Chain ID), so nodes of
+	// different networks can't join each other's sessions.
 	ProtocolID       string
-	DialTimeout      time.Duration
-	IOTimeout        time.Duration
+	NetworkNamespace string
+	// LegacyProtocolFallback also serves and dials the pre-namespace
+	// DefaultProtocolID, to reach nodes that have not upgraded yet. Every
+	// node enabling it shares that protocol regardless of namespace, so it
+	// is off by default and only meant for the duration of an upgrade.
+	LegacyProtocolFallback bool
+	DialTimeout            time.Duration
+	IOTimeout              time.Duration
+
+	// Per-protocol overrides of DialTimeout and IOTimeout; unset fields fall
+	// back to the global values. Keygen runs more and heavier rounds than sign.
+	KeygenTimeouts  Timeouts
+	ReshareTimeouts Timeouts // key refresh and quorum change
+	SignTimeouts    Timeouts
+
+	// Caps concurrently running sign sessions; 0 means no cap. Key-mutating
+	// sessions (keygen, key refresh, quorum change) always run alone.
+	MaxConcurrentSigns int
 
 	// Chains manager (required for sign operations to get txBuilders)
 	Chains *chains.Chains
@@ -95,7 +117,7 @@ type Node struct {
 	validatorAddress string
 	network          networking.Network
 	keyshareManager  *keyshare.Manager
-	database         *db.DB
+	database         db.Store
 	pushCore         *pushcore.Client
 	chains           *chains.Chains
 	logger           zerolog.Logger
@@ -107,7 +129,8 @@ type Node struct {
 	expirySweeper    *expirysweeper.Sweeper
 
 	// Network configuration (used during Start)
-	networkCfg libp2pnet.Config
+	networkCfg       libp2pnet.Config
+	protocolTimeouts map[string]Timeouts // event type -> overrides
 
 	// Coordinator configuration
 	coordinatorRange        uint64
@@ -117,6 +140,7 @@ type Node struct {
 	sessionExpiryTime          time.Duration
 	sessionExpiryCheckInterval time.Duration
 	sessionExpiryBlockDelay    uint64
+	maxConcurrentSigns         int
 
 	// Voting configuration
 	pushSigner *pushsigner.Signer // Optional - nil if voting disabled
@@ -127,6 +151,7 @@ type Node struct {
 	running      bool
 	stopCh       chan struct{}
 	processingWg sync.WaitGroup
+	leave        *leaveTracker
 
 	// Registered peers tracking
 	registeredPeersMu sync.RWMutex
@@ -178,6 +203,11 @@ func NewNode(ctx context.Context, cfg Config) (*Node, error) {
 	}
 	if cfg.ProtocolID != "" {
 		networkCfg.ProtocolID = cfg.ProtocolID
+	} else {
+		networkCfg.ProtocolID = libp2pnet.ProtocolIDForNetwork(cfg.NetworkNamespace)
+	}
+	if cfg.LegacyProtocolFallback {
+		networkCfg.FallbackProtocolIDs = []string{libp2pnet.DefaultProtocolID}
 	}
 	if cfg.DialTimeout > 0 {
 		networkCfg.DialTimeout = cfg.DialTimeout
@@ -185,6 +215,12 @@ func NewNode(ctx context.Context, cfg Config) (*Node, error) {
 	if cfg.IOTimeout > 0 {
 		networkCfg.IOTimeout = cfg.IOTimeout
 	}
+	timeouts := protocolTimeouts(cfg)
+	globalIO := networkCfg.IOTimeout
+	if globalIO == 0 {
+		globalIO = libp2pnet.DefaultIOTimeout
+	}
+	networkCfg.InboundIOTimeout = longestIO(globalIO, timeouts)
 
 	// Use provided database
 	database := cfg.Database
@@ -228,13 +264,16 @@ func NewNode(ctx context.Context, cfg Config) (*Node, error) {
 		logger:                     logger,
 		eventStore:                 evtStore,
 		networkCfg:                 networkCfg,
+		protocolTimeouts:           timeouts,
 		coordinatorRange:           coordinatorRange,
 		coordinatorPollInterval:    pollInterval,
 		sessionExpiryTime:          sessionExpiryTime,
 		sessionExpiryCheckInterval: sessionExpiryCheckInterval,
 		sessionExpiryBlockDelay:    sessionExpiryBlockDelay,
+		maxConcurrentSigns:         cfg.MaxConcurrentSigns,
 		pushSigner:                 cfg.PushSigner,
 		stopCh:                     make(chan struct{}),
+		leave:                      newLeaveTracker(),
 		registeredPeers:            make(map[string]bool),
 	}
 
@@ -245,6 +284,34 @@ func NewNode(ctx context.Context, cfg Config) (*Node, error) {
 		return node.coordinator.GetTSSAddress(ctx)
 	}
 
+	// Broadcasting and result reporting share one per-event leader order
+	// (txflow.BroadcastRank). Broadcasts rank the validators eligible to sign
+	// outbounds; reports rank the Push Chain voters, since only their votes
+	// count toward the ballot quorum. Rendezvous hashing keeps the relative
+	// order of the validators in both sets, so the broadcaster still reports
+	// first when it is a voter.
+	validatorAddrs := func(vals []*types.UniversalValidator) []string {
+		addrs := make([]string, 0, len(vals))
+		for _, v := range vals {
+			if v.IdentifyInfo != nil {
+				addrs = append(addrs, v.IdentifyInfo.CoreValidatorAddress)
+			}
+		}
+		return addrs
+	}
+	getOutboundValidators := func() []string {
+		if node.coordinator == nil {
+			return nil
+		}
+		return validatorAddrs(node.coordinator.GetEligibleUV(store.EventTypeSignOutbound))
+	}
+	getOutboundVoters := func() []string {
+		if node.coordinator == nil {
+			return nil
+		}
+		return validatorAddrs(node.coordinator.GetVoters())
+	}
+
 	node.txResolver = txresolver.NewResolver(txresolver.Config{
 		EventStore:    evtStore,
 		Chains:        cfg.Chains,
@@ -252,6 +319,11 @@ func NewNode(ctx context.Context, cfg Config) (*Node, error) {
 		CheckInterval: sessionExpiryCheckInterval,
 		Logger:        logger,
 		GetTSSAddress: getTSSAddress,
+
+		GetLatestBlock: cfg.PushCore.GetLatestBlock,
+
+		ValidatorAddress: cfg.ValidatorAddress,
+		GetValidators:    getOutboundVoters,
 	})
 
 	node.txBroadcaster = txbroadcaster.NewBroadcaster(txbroadcaster.Config{
@@ -260,6 +332,11 @@ func NewNode(ctx context.Context, cfg Config) (*Node, error) {
 		CheckInterval: sessionExpiryCheckInterval,
 		Logger:        logger,
 		GetTSSAddress: getTSSAddress,
+
+		ValidatorAddress: cfg.ValidatorAddress,
+		GetValidators:    getOutboundValidators,
+
+		GetOutboundStatus: cfg.PushCore.GetOutboundStatus,
 	})
 
 	node.expirySweeper = expirysweeper.NewSweeper(expirysweeper.Config{
@@ -285,19 +362,6 @@ func (n *Node) Start(ctx context.Context) error {
 
 	n.logger.Debug().Msg("starting TSS node")
 
-	// Start libp2p network
-	net, err := libp2pnet.New(ctx, n.networkCfg, n.logger)
-	if err != nil {
-		return fmt.Errorf("failed to start libp2p network: %w", err)
-	}
-	n.network = net
-
-	// Register global message handler
-	if err := net.RegisterHandler(n.onReceive); err != nil {
-		net.Close()
-		return fmt.Errorf("failed to register message handler: %w", err)
-	}
-
 	// Recover IN_PROGRESS events on startup. Two-pass:
 	//   1. Rows whose event_data already carries signing_data → SIGNED
 	//      (signature was persisted but status got clobbered by a race).
@@ -348,9 +412,29 @@ func (n *Node) Start(ctx context.Context) error {
 			n.logger,
 			n.pushSigner,
 		)
+		sessionMgr.SetMaxConcurrentSigns(n.maxConcurrentSigns)
 		n.sessionManager = sessionMgr
 	}
 
+	// Start libp2p network. Created after the coordinator so the connection
+	// gate can check peers against its validator set from the first dial.
+	networkCfg := n.networkCfg
+	networkCfg.Authorize = n.coordinator.IsAuthorizedPeer
+	net, err := libp2pnet.New(ctx, networkCfg, n.logger)
+	if err != nil {
+		return fmt.Errorf("failed to start libp2p network: %w", err)
+	}
+	n.network = net
+
+	// Register global message handler
+	if err := net.RegisterHandler(n.onReceive); err != nil {
+		net.Close()
+		return fmt.Errorf("failed to register message handler: %w", err)
+	}
+
+	// Defer sign events while too few signers are reachable
+	n.coordinator.SetPeerReachable(n.peerReachable)
+
 	// Start coordinator
 	n.coordinator.Start(ctx)
 
@@ -366,6 +450,10 @@ func (n *Node) Start(ctx context.Context) error {
 	// Start expiry sweeper (CONFIRMED past expiry → REVERTED)
 	n.expirySweeper.Start(ctx)
 
+	// Track graceful leave (PENDING_LEAVE → INACTIVE)
+	n.processingWg.Add(1)
+	go n.watchLeave(ctx, n.coordinatorPollInterval)
+
 	n.logger.Info().
 		Str("peer_id", net.ID()).
 		Strs("addrs", net.ListenAddrs()).
@@ -428,43 +516,78 @@ func (n *Node) Send(ctx context.Context, peerID string, data []byte) error {
 		return nil
 	}
 
+	if err := n.ensurePeer(ctx, peerID); err != nil {
+		return err
+	}
+
+	// Send message
+	t := n.sendTimeouts(ctx)
+	return n.network.Send(libp2pnet.WithTimeouts(ctx, t.Dial, t.IO), peerID, data)
+}
+
+// ensurePeer registers a peer's addresses with the network on first use,
+// looking them up from the validator set through the coordinator.
+func (n *Node) ensurePeer(ctx context.Context, peerID string) error {
 	// Check if peer is registered
 	n.registeredPeersMu.RLock()
 	isRegistered := n.registeredPeers[peerID]
 	n.registeredPeersMu.RUnlock()
+	if isRegistered {
+		return nil
+	}
 
 	// If not registered, register it using coordinator
-	if !isRegistered {
-		if n.coordinator == nil {
-			return fmt.Errorf("coordinator not initialized")
-		}
+	if n.coordinator == nil {
+		return fmt.Errorf("coordinator not initialized")
+	}
 
-		multiaddrs, err := n.coordinator.GetMultiAddrsFromPeerID(ctx, peerID)
-		if err != nil {
-			return fmt.Errorf("failed to get multiaddrs for peer %s: %w", peerID, err)
-		}
+	multiaddrs, err := n.coordinator.GetMultiAddrsFromPeerID(ctx, peerID)
+	if err != nil {
+		return fmt.Errorf("failed to get multiaddrs for peer %s: %w", peerID, err)
+	}
 
-		if len(multiaddrs) == 0 {
-			return fmt.Errorf("peer %s has no addresses", peerID)
-		}
+	if len(multiaddrs) == 0 {
+		return fmt.Errorf("peer %s has no addresses", peerID)
+	}
 
-		if err := n.network.EnsurePeer(peerID, multiaddrs); err != nil {
-			return fmt.Errorf("failed to register peer %s: %w", peerID, err)
-		}
+	if err := n.network.EnsurePeer(peerID, multiaddrs); err != nil {
+		return fmt.Errorf("failed to register peer %s: %w", peerID, err)
+	}
 
-		// Mark as registered
-		n.registeredPeersMu.Lock()
-		n.registeredPeers[peerID] = true
-		n.registeredPeersMu.Unlock()
+	// Mark as registered
+	n.registeredPeersMu.Lock()
+	n.registeredPeers[peerID] = true
+	n.registeredPeersMu.Unlock()
+
+	n.logger.Debug().
+		Str("peer_id", peerID).
+		Strs("addrs", multiaddrs).
+		Msg("registered peer on-demand")
+	return nil
+}
 
-		n.logger.Debug().
-			Str("peer_id", peerID).
-			Strs("addrs", multiaddrs).
-			Msg("registered peer on-demand")
+// peerReachable reports whether a peer can be dialed within the sign dial
+// timeout. The node itself is always reachable.
+func (n *Node) peerReachable(ctx context.Context, peerID string) bool {
+	if n.network == nil {
+		return false
+	}
+	if peerID == n.network.ID() {
+		return true
+	}
+	if err := n.ensurePeer(ctx, peerID); err != nil {
+		return false
 	}
 
-	// Send message
-	return n.network.Send(ctx, peerID, data)
+	dial := n.networkCfg.DialTimeout
+	if t := n.protocolTimeouts[store.EventTypeSignOutbound]; t.Dial > 0 {
+		dial = t.Dial
+	}
+	if err := n.network.Connect(libp2pnet.WithTimeouts(ctx, dial, 0), peerID); err != nil {
+		n.logger.Debug().Err(err).Str("peer_id", peerID).Msg("peer unreachable")
+		return false
+	}
+	return true
 }
 
 // onReceive routes an incoming p2p message
@@ -488,6 +611,54 @@ func (n *Node) onReceive(peerID string, data []byte) {
 	}
 }
 
+// IsOutboundReportTurn reports whether this node is due to vote the result of
+// the outbound observed in eventID, in the same order the resolver reports
+// failures (see txresolver.Resolver.IsReportTurn).
+func (n *Node) IsOutboundReportTurn(eventID string, pendingSince time.Time) bool {
+	return n.txResolver.IsReportTurn(eventID, pendingSince)
+}
+
+// QuorumDeferrals returns how many sign events the coordinator has deferred
+// for lack of a reachable signing quorum, or 0 before Start.
+func (n *Node) QuorumDeferrals() uint64 {
+	if n.coordinator == nil {
+		return 0
+	}
+	return n.coordinator.QuorumDeferrals()
+}
+
+// HeldOutbounds returns the outbounds held for manual review, oldest first.
+func (n *Node) HeldOutbounds() ([]uexecutortypes.OutboundCreatedEvent, error) {
+	events, err := n.eventStore.GetHeldEvents()
+	if err != nil {
+		return nil, err
+	}
+	held := make([]uexecutortypes.OutboundCreatedEvent, 0, len(events))
+	for _, event := range events {
+		var data uexecutortypes.OutboundCreatedEvent
+		if err := json.Unmarshal(event.EventData, &data); err != nil {
+			return nil, fmt.Errorf("failed to decode held outbound %s: %w", event.EventID, err)
+		}
+		held = append(held, data)
+	}
+	return held, nil
+}
+
+// ReleaseHeldOutbound approves a held outbound; the coordinator then signs it
+// like any other confirmed outbound.
+func (n *Node) ReleaseHeldOutbound(eventID string) error {
+	if err := n.eventStore.ReleaseHeldEvent(eventID); err != nil {
+		return err
+	}
+	n.logger.Info().Str("event_id", eventID).Msg("held outbound released for signing")
+	return nil
+}
+
+// RejectHeldOutbound votes a held outbound failed so Push Chain reverts it.
+func (n *Node) RejectHeldOutbound(ctx context.Context, eventID string) error {
+	return n.txResolver.RejectHeldOutbound(ctx, eventID)
+}
+
 // PeerID returns the libp2p peer ID (helper function).
 func (n *Node) PeerID() string {
 	if n.network == nil {
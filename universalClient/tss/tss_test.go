@@ -5,6 +5,7 @@ import (
 	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/hex"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -14,6 +15,9 @@ import (
 
 	"github.com/pushchain/push-chain-node/universalClient/db"
 	"github.com/pushchain/push-chain-node/universalClient/pushcore"
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/coordinator"
+	libp2pnet "github.com/pushchain/push-chain-node/universalClient/tss/networking/libp2p"
 )
 
 // generateTestPrivateKey generates a random Ed25519 private key for testing.
@@ -319,3 +323,114 @@ func TestNode_SendToUnknownPeer(t *testing.T) {
 	err := node.Send(ctx, "12D3KooWFakeUnknownPeerIDxxxxxxxxxxxxxxxxx", []byte("hello"))
 	require.Error(t, err)
 }
+
+func TestNode_ProtocolTimeouts(t *testing.T) {
+	database, err := db.OpenInMemoryDB(true)
+	require.NoError(t, err)
+
+	node, err := NewNode(context.Background(), Config{
+		ValidatorAddress: "validator1",
+		P2PPrivateKeyHex: generateTestPrivateKey(t),
+		LibP2PListen:     "/ip4/127.0.0.1/tcp/0",
+		HomeDir:          t.TempDir(),
+		Password:         "test-password",
+		Database:         database,
+		PushCore:         &pushcore.Client{},
+		Logger:           zerolog.Nop(),
+		DialTimeout:      5 * time.Second,
+		IOTimeout:        20 * time.Second,
+		KeygenTimeouts:   Timeouts{Dial: 30 * time.Second, IO: 2 * time.Minute},
+		ReshareTimeouts:  Timeouts{IO: 90 * time.Second},
+	})
+	require.NoError(t, err)
+
+	under := func(eventType string) context.Context {
+		return coordinator.WithEventType(context.Background(), eventType)
+	}
+
+	t.Run("keygen uses its override", func(t *testing.T) {
+		assert.Equal(t, Timeouts{Dial: 30 * time.Second, IO: 2 * time.Minute}, node.sendTimeouts(under(store.EventTypeKeygen)))
+	})
+	t.Run("reshare falls back per field", func(t *testing.T) {
+		assert.Equal(t, Timeouts{Dial: 5 * time.Second, IO: 90 * time.Second}, node.sendTimeouts(under(store.EventTypeQuorumChange)))
+	})
+	t.Run("sign uses the global values when unset", func(t *testing.T) {
+		assert.Equal(t, Timeouts{Dial: 5 * time.Second, IO: 20 * time.Second}, node.sendTimeouts(under(store.EventTypeSignOutbound)))
+	})
+	t.Run("untagged sends use the global values", func(t *testing.T) {
+		assert.Equal(t, Timeouts{Dial: 5 * time.Second, IO: 20 * time.Second}, node.sendTimeouts(context.Background()))
+	})
+	t.Run("inbound reads allow for the slowest protocol", func(t *testing.T) {
+		assert.Equal(t, 2*time.Minute, node.networkCfg.InboundIOTimeout)
+	})
+}
+
+func TestNewNode_NetworkNamespaceIsolation(t *testing.T) {
+	database, err := db.OpenInMemoryDB(true)
+	require.NoError(t, err)
+
+	// newNetwork starts the libp2p network NewNode configured for namespace,
+	// as Start does, and counts the messages it receives per sender.
+	newNetwork := func(t *testing.T, namespace string, legacyFallback bool) (*libp2pnet.Network, func() int) {
+		t.Helper()
+		node, err := NewNode(context.Background(), Config{
+			ValidatorAddress:       "validator1",
+			P2PPrivateKeyHex:       generateTestPrivateKey(t),
+			LibP2PListen:           "/ip4/127.0.0.1/tcp/0",
+			NetworkNamespace:       namespace,
+			LegacyProtocolFallback: legacyFallback,
+			HomeDir:                t.TempDir(),
+			Password:               "test-password",
+			Database:               database,
+			PushCore:               &pushcore.Client{},
+			Logger:                 zerolog.Nop(),
+		})
+		require.NoError(t, err)
+		net, err := libp2pnet.New(context.Background(), node.networkCfg, zerolog.Nop())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = net.Close() })
+
+		var received atomic.Int32
+		require.NoError(t, net.RegisterHandler(func(string, []byte) { received.Add(1) }))
+		return net, func() int { return int(received.Load()) }
+	}
+	send := func(from, to *libp2pnet.Network) error {
+		require.NoError(t, from.EnsurePeer(to.ID(), to.ListenAddrs()))
+		return from.Send(context.Background(), to.ID(), []byte("hello"))
+	}
+
+	t.Run("different namespaces cannot exchange messages", func(t *testing.T) {
+		testnet, fromMainnet := newNetwork(t, "push_42101-1", false)
+		mainnet, fromTestnet := newNetwork(t, "push_9-1", false)
+
+		assert.Error(t, send(testnet, mainnet))
+		assert.Error(t, send(mainnet, testnet))
+		time.Sleep(50 * time.Millisecond)
+		assert.Zero(t, fromTestnet())
+		assert.Zero(t, fromMainnet())
+	})
+
+	t.Run("same namespace exchanges messages", func(t *testing.T) {
+		a, _ := newNetwork(t, "push_9-1", false)
+		b, received := newNetwork(t, "push_9-1", false)
+
+		require.NoError(t, send(a, b))
+		require.Eventually(t, func() bool { return received() == 1 }, 2*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("legacy fallback is opt-in", func(t *testing.T) {
+		legacy, err := libp2pnet.New(context.Background(), libp2pnet.Config{
+			ListenAddrs: []string{"/ip4/127.0.0.1/tcp/0"},
+			ProtocolID:  libp2pnet.DefaultProtocolID,
+		}, zerolog.Nop())
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = legacy.Close() })
+		require.NoError(t, legacy.RegisterHandler(func(string, []byte) {}))
+
+		upgraded, _ := newNetwork(t, "push_9-1", false)
+		assert.Error(t, send(upgraded, legacy))
+
+		migrating, _ := newNetwork(t, "push_9-1", true)
+		assert.NoError(t, send(migrating, legacy))
+	})
+}
@@ -8,9 +8,13 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/pushchain/push-chain-node/universalClient/chains"
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
+	"github.com/pushchain/push-chain-node/universalClient/pushcore"
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
 	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
 )
 
 type Config struct {
@@ -19,14 +23,33 @@ type Config struct {
 	CheckInterval time.Duration
 	Logger        zerolog.Logger
 	GetTSSAddress func(ctx context.Context) (string, error)
+
+	// ValidatorAddress and GetValidators enable per-event broadcast leader
+	// election; when either is unset every node broadcasts every signed tx.
+	// GetValidators returns the addresses of the validators that hold signed txs.
+	ValidatorAddress string
+	GetValidators    func() []string
+	// LeaderTimeout is how long each ranked broadcaster waits for the one
+	// ahead of it before taking over (default DefaultLeaderTimeout).
+	LeaderTimeout time.Duration
+
+	// GetOutboundStatus reads an outbound's status on Push Chain. When set,
+	// outbounds Push Chain has already finalized (e.g. reverted on expiry)
+	// are never broadcast.
+	GetOutboundStatus func(ctx context.Context, utxID string) (uexecutortypes.UniversalTxStatus, error)
 }
 
 type Broadcaster struct {
-	eventStore    *eventstore.Store
-	chains        *chains.Chains
-	checkInterval time.Duration
-	logger        zerolog.Logger
-	getTSSAddress func(ctx context.Context) (string, error)
+	eventStore       *eventstore.Store
+	chains           *chains.Chains
+	checkInterval    time.Duration
+	logger           zerolog.Logger
+	getTSSAddress    func(ctx context.Context) (string, error)
+	validatorAddress string
+	getValidators    func() []string
+	leaderTimeout    time.Duration
+
+	getOutboundStatus func(ctx context.Context, utxID string) (uexecutortypes.UniversalTxStatus, error)
 }
 
 func NewBroadcaster(cfg Config) *Broadcaster {
@@ -34,12 +57,21 @@ func NewBroadcaster(cfg Config) *Broadcaster {
 	if interval == 0 {
 		interval = 15 * time.Second
 	}
+	leaderTimeout := cfg.LeaderTimeout
+	if leaderTimeout == 0 {
+		leaderTimeout = DefaultLeaderTimeout
+	}
 	return &Broadcaster{
-		eventStore:    cfg.EventStore,
-		chains:        cfg.Chains,
-		checkInterval: interval,
-		logger:        cfg.Logger.With().Str("component", "txbroadcaster").Logger(),
-		getTSSAddress: cfg.GetTSSAddress,
+		eventStore:       cfg.EventStore,
+		chains:           cfg.Chains,
+		checkInterval:    interval,
+		logger:           cfg.Logger.With().Str("component", "txbroadcaster").Logger(),
+		getTSSAddress:    cfg.GetTSSAddress,
+		validatorAddress: cfg.ValidatorAddress,
+		getValidators:    cfg.GetValidators,
+		leaderTimeout:    leaderTimeout,
+
+		getOutboundStatus: cfg.GetOutboundStatus,
 	}
 }
 
@@ -88,7 +120,11 @@ func (b *Broadcaster) processSigned(ctx context.Context) {
 }
 
 // broadcastEvent dispatches to the appropriate handler based on event type.
+// Only the event's elected broadcaster sends it; the rest stand by (see isBroadcastTurn).
 func (b *Broadcaster) broadcastEvent(ctx context.Context, event *store.Event) {
+	if !b.isBroadcastTurn(event, time.Now()) {
+		return
+	}
 	switch event.Type {
 	case store.EventTypeSignOutbound:
 		b.broadcastOutbound(ctx, event)
@@ -123,6 +159,10 @@ func (b *Broadcaster) broadcastOutbound(ctx context.Context, event *store.Event)
 		return
 	}
 
+	if b.skipFinalizedOutbound(ctx, event) {
+		return
+	}
+
 	if b.chains.IsEVMChain(chainID) {
 		b.broadcastOutboundEVM(ctx, event, &data, chainID)
 	} else {
@@ -130,6 +170,33 @@ func (b *Broadcaster) broadcastOutbound(ctx context.Context, event *store.Event)
 	}
 }
 
+// skipFinalizedOutbound reports whether the outbound must not be broadcast
+// because Push Chain has finalized it, e.g. reverted it after its lifetime
+// ran out: paying it out now would pay twice. Such events are marked
+// COMPLETED. If the status can't be read the broadcast waits for the next
+// tick rather than risk it.
+func (b *Broadcaster) skipFinalizedOutbound(ctx context.Context, event *store.Event) bool {
+	utxID := event.UniversalTxID()
+	if b.getOutboundStatus == nil || utxID == "" {
+		return false
+	}
+	log := logger.WithTraceID(b.logger, utxID).With().Str("event_id", event.EventID).Logger()
+
+	status, err := b.getOutboundStatus(ctx, utxID)
+	if err != nil {
+		log.Warn().Err(err).Msg("failed to query outbound status, deferring broadcast")
+		return true
+	}
+	if !pushcore.IsOutboundFinalized(status) {
+		return false
+	}
+	if err := b.eventStore.Update(event.EventID, map[string]any{"status": store.StatusCompleted}); err != nil {
+		log.Warn().Err(err).Msg("failed to mark finalized outbound as completed")
+	}
+	log.Warn().Str("status", status.String()).Msg("outbound already finalized on Push Chain, not broadcasting")
+	return true
+}
+
 // ---------------------------------------------------------------------------
 // Fund migration broadcast (parsing + chain dispatch)
 // ---------------------------------------------------------------------------
@@ -160,19 +227,71 @@ func (b *Broadcaster) broadcastFundMigration(ctx context.Context, event *store.E
 // Helpers
 // ---------------------------------------------------------------------------
 
+// deferIfGatewayPaused reports whether the outbound must wait because the
+// destination gateway is paused, where broadcasting would only revert and
+// burn fees. A deferred event is parked as GATEWAY_PAUSED, which the
+// broadcaster keeps rechecking; once the gateway is unpaused it goes back to
+// SIGNED and the broadcast proceeds. If the paused flag can't be read the
+// broadcast proceeds as before.
+func (b *Broadcaster) deferIfGatewayPaused(ctx context.Context, event *store.Event, builder common.TxBuilder, log zerolog.Logger) bool {
+	paused, err := builder.IsGatewayPaused(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("failed to read gateway paused flag, broadcasting anyway")
+		return false
+	}
+
+	switch {
+	case paused && event.Status != store.StatusGatewayPaused:
+		if err := b.eventStore.Update(event.EventID, map[string]any{"status": store.StatusGatewayPaused}); err != nil {
+			log.Warn().Err(err).Msg("failed to update event to GATEWAY_PAUSED")
+			return true
+		}
+		log.Warn().Msg("gateway paused, deferring broadcast")
+	case !paused && event.Status == store.StatusGatewayPaused:
+		if err := b.eventStore.Update(event.EventID, map[string]any{"status": store.StatusSigned}); err != nil {
+			log.Warn().Err(err).Msg("failed to update event to SIGNED")
+			return true
+		}
+		event.Status = store.StatusSigned
+		log.Info().Msg("gateway unpaused, resuming broadcast")
+	}
+	return paused
+}
+
 // markBroadcasted updates the event status to BROADCASTED with the given tx hash.
 func (b *Broadcaster) markBroadcasted(event *store.Event, chainID, txHash string) {
+	log := logger.WithTraceID(b.logger, event.UniversalTxID())
 	caipTxHash := chainID + ":" + txHash
 	if err := b.eventStore.Update(event.EventID, map[string]any{
 		"broadcasted_tx_hash": caipTxHash,
 		"status":              store.StatusBroadcasted,
 	}); err != nil {
-		b.logger.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to update event to BROADCASTED")
+		log.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to update event to BROADCASTED")
 		return
 	}
-	b.logger.Info().
+	log.Info().
 		Str("event_id", event.EventID).
 		Str("type", event.Type).
 		Str("chain", chainID).
 		Msg("event marked as BROADCASTED")
 }
+
+// markDryRun records the hash of a tx that was built but, in dry_run mode,
+// not broadcast. DRY_RUN is terminal: the event is never retried or resolved.
+func (b *Broadcaster) markDryRun(event *store.Event, chainID, txHash string) {
+	log := logger.WithTraceID(b.logger, event.UniversalTxID())
+	caipTxHash := chainID + ":" + txHash
+	if err := b.eventStore.Update(event.EventID, map[string]any{
+		"broadcasted_tx_hash": caipTxHash,
+		"status":              store.StatusDryRun,
+	}); err != nil {
+		log.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to update event to DRY_RUN")
+		return
+	}
+	log.Info().
+		Str("event_id", event.EventID).
+		Str("type", event.Type).
+		Str("chain", chainID).
+		Str("tx_hash", txHash).
+		Msg("dry run: event marked as would-broadcast")
+}
@@ -29,7 +29,10 @@ import (
 	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
 )
 
-type mockTxBuilder struct{ mock.Mock }
+type mockTxBuilder struct {
+	mock.Mock
+	paused bool // reported by IsGatewayPaused
+}
 
 func (m *mockTxBuilder) GetOutboundSigningRequest(ctx context.Context, data *uexecutortypes.OutboundCreatedEvent, nonce uint64) (*common.UnsignedSigningReq, error) {
 	args := m.Called(ctx, data, nonce)
@@ -59,6 +62,14 @@ func (m *mockTxBuilder) IsAlreadyExecuted(ctx context.Context, txID string) (boo
 	return args.Bool(0), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *mockTxBuilder) RequiredConfirmations(data *uexecutortypes.OutboundCreatedEvent, base uint64) uint64 {
+	return base
+}
+
+func (m *mockTxBuilder) IsGatewayPaused(ctx context.Context) (bool, error) {
+	return m.paused, nil
+}
+
 func (m *mockTxBuilder) GetGasFeeUsed(ctx context.Context, txHash string) (string, error) {
 	args := m.Called(ctx, txHash)
 	return args.String(0), args.Error(1)
@@ -88,6 +99,11 @@ func setupTestDB(t *testing.T) (*eventstore.Store, *gorm.DB) {
 	t.Helper()
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
+	// Each connection to ":memory:" opens its own empty database; pin the pool
+	// to one so the resolver goroutine in the pipeline tests sees the schema.
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
 	require.NoError(t, db.AutoMigrate(&store.Event{}))
 	return eventstore.NewStore(db, zerolog.Nop()), db
 }
@@ -278,6 +294,111 @@ func TestEVM_BroadcastSuccess_MarksBroadcasted(t *testing.T) {
 	builder.AssertNotCalled(t, "GetNextNonce", mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestBroadcast_SkipsOutboundFinalizedOnPushChain(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     uexecutortypes.UniversalTxStatus
+		err        error
+		wantStatus string
+		broadcasts bool
+	}{
+		{"reverted on expiry", uexecutortypes.UniversalTxStatus_OUTBOUND_FAILED, nil, store.StatusCompleted, false},
+		{"already succeeded", uexecutortypes.UniversalTxStatus_OUTBOUND_SUCCESS, nil, store.StatusCompleted, false},
+		{"status unknown", 0, fmt.Errorf("rpc down"), store.StatusSigned, false},
+		{"still pending", uexecutortypes.UniversalTxStatus_OUTBOUND_PENDING, nil, store.StatusBroadcasted, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evtStore, db := setupTestDB(t)
+			builder := &mockTxBuilder{}
+			ch := newTestChains(t, "eip155:1", uregistrytypes.VmType_EVM, &mockChainClient{builder: builder})
+			insertSignedEvent(t, db, "ev-1", "eip155:1", 10)
+			builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return("0xabc", nil)
+
+			b := newBroadcaster(evtStore, ch, "0xTSS")
+			var queried string
+			b.getOutboundStatus = func(_ context.Context, utxID string) (uexecutortypes.UniversalTxStatus, error) {
+				queried = utxID
+				return tt.status, tt.err
+			}
+			b.processSigned(context.Background())
+
+			require.Equal(t, "utx-456", queried)
+			require.Equal(t, tt.wantStatus, getEvent(t, db, "ev-1").Status)
+			if tt.broadcasts {
+				builder.AssertCalled(t, "BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				builder.AssertNotCalled(t, "BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestBroadcast_GatewayPaused(t *testing.T) {
+	for _, tc := range []struct {
+		chainID string
+		vmType  uregistrytypes.VmType
+	}{
+		{"eip155:1", uregistrytypes.VmType_EVM},
+		{"solana:devnet", uregistrytypes.VmType_SVM},
+	} {
+		t.Run(tc.vmType.String(), func(t *testing.T) {
+			evtStore, db := setupTestDB(t)
+			builder := &mockTxBuilder{paused: true}
+			client := &mockChainClient{builder: builder}
+			ch := newTestChains(t, tc.chainID, tc.vmType, client)
+
+			insertSignedSVMEventWithDeadline(t, db, "ev-1", tc.chainID, 10, time.Now().Add(time.Hour).Unix())
+			builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return("0xabc123", nil)
+
+			b := newBroadcaster(evtStore, ch, "0xTSS")
+
+			// Paused: deferred, nothing sent.
+			b.processSigned(context.Background())
+			require.Equal(t, store.StatusGatewayPaused, getEvent(t, db, "ev-1").Status)
+			b.processSigned(context.Background())
+			require.Equal(t, store.StatusGatewayPaused, getEvent(t, db, "ev-1").Status)
+			builder.AssertNotCalled(t, "BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+			// Unpaused: the deferred event is picked up again and broadcast.
+			builder.paused = false
+			b.processSigned(context.Background())
+			ev := getEvent(t, db, "ev-1")
+			require.Equal(t, store.StatusBroadcasted, ev.Status)
+			require.Equal(t, tc.chainID+":0xabc123", ev.BroadcastedTxHash)
+		})
+	}
+}
+
+func TestEVM_DryRun_MarksDryRun(t *testing.T) {
+	// dry_run: the builder assembled the tx but the RPC client skipped the
+	// send → DRY_RUN with the would-be hash, no on-chain checks.
+	evtStore, db := setupTestDB(t)
+	builder := &mockTxBuilder{}
+	client := &mockChainClient{builder: builder}
+	ch := newTestChains(t, "eip155:1", uregistrytypes.VmType_EVM, client)
+
+	insertSignedEvent(t, db, "ev-1", "eip155:1", 10)
+
+	builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return("0xabc123", fmt.Errorf("failed to broadcast transaction: %w", common.ErrBroadcastSkipped))
+
+	b := newBroadcaster(evtStore, ch, "0xTSS")
+	b.processSigned(context.Background())
+
+	ev := getEvent(t, db, "ev-1")
+	require.Equal(t, store.StatusDryRun, ev.Status)
+	require.Equal(t, "eip155:1:0xabc123", ev.BroadcastedTxHash)
+	builder.AssertNotCalled(t, "VerifyBroadcastedTx", mock.Anything, mock.Anything)
+	builder.AssertNotCalled(t, "GetNextNonce", mock.Anything, mock.Anything, mock.Anything)
+
+	// DRY_RUN is terminal: later ticks leave the event alone.
+	b.processSigned(context.Background())
+	builder.AssertNumberOfCalls(t, "BroadcastOutboundSigningRequest", 1)
+}
+
 func TestEVM_BroadcastAssemblyFails_StaysSigned(t *testing.T) {
 	// Broadcast returns empty txHash (assembly/encode failure before sending) →
 	// nonce check is never reached; stay SIGNED for retry.
@@ -393,6 +514,26 @@ func TestSVM_BroadcastSuccess_MarksBroadcasted(t *testing.T) {
 	require.Equal(t, "solana:mainnet:solTxSig123", ev.BroadcastedTxHash)
 }
 
+func TestSVM_DryRun_MarksDryRun(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	builder := &mockTxBuilder{}
+	client := &mockChainClient{builder: builder}
+	ch := newTestChains(t, "solana:mainnet", uregistrytypes.VmType_SVM, client)
+
+	insertSignedSVMEventWithDeadline(t, db, "ev-1", "solana:mainnet", 0, time.Now().Unix()+600)
+
+	builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return("solTxSig123", fmt.Errorf("failed to broadcast transaction: %w", common.ErrBroadcastSkipped))
+
+	b := newBroadcaster(evtStore, ch, "")
+	b.processSigned(context.Background())
+
+	ev := getEvent(t, db, "ev-1")
+	require.Equal(t, store.StatusDryRun, ev.Status)
+	require.Equal(t, "solana:mainnet:solTxSig123", ev.BroadcastedTxHash)
+	builder.AssertNotCalled(t, "IsAlreadyExecuted", mock.Anything, mock.Anything)
+}
+
 func TestSVM_BroadcastFails_PDAExists_MarksBroadcasted(t *testing.T) {
 	// Broadcast fails, but ExecutedTx PDA exists → another relayer processed it → BROADCASTED.
 	// Future deadline so the broadcaster goes to broadcast attempt (not cluster check).
@@ -823,3 +964,81 @@ func TestFundMigrationEVM_BroadcastFails_NonceNotConsumed_StaysSigned(t *testing
 	require.Equal(t, store.StatusSigned, ev.Status) // stays SIGNED for retry
 }
 
+
+func TestSVM_RecoveryIDCachedAcrossRetries(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	builder := &mockTxBuilder{}
+	client := &mockChainClient{builder: builder}
+	ch := newTestChains(t, "solana:mainnet", uregistrytypes.VmType_SVM, client)
+
+	insertSignedSVMEventWithDeadline(t, db, "ev-1", "solana:mainnet", 0, time.Now().Unix()+600)
+
+	// The builder determines recovery ID 1 on the first attempt; every attempt fails.
+	var seen []*uint8
+	builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			req := args.Get(1).(*common.UnsignedSigningReq)
+			seen = append(seen, req.RecoveryID)
+			if req.RecoveryID == nil {
+				v := uint8(1)
+				req.RecoveryID = &v
+			}
+		}).
+		Return("", fmt.Errorf("simulation failed"))
+	builder.On("IsAlreadyExecuted", mock.Anything, "tx-123").Return(false, int64(0), nil)
+
+	b := newBroadcaster(evtStore, ch, "")
+	b.processSigned(context.Background())
+	b.processSigned(context.Background())
+
+	require.Len(t, seen, 2)
+	require.Nil(t, seen[0], "first attempt has nothing cached")
+	require.NotNil(t, seen[1], "retry must reuse the persisted recovery ID")
+	require.Equal(t, uint8(1), *seen[1])
+
+	var data txflow.SignedOutboundData
+	require.NoError(t, json.Unmarshal(getEvent(t, db, "ev-1").EventData, &data))
+	require.NotNil(t, data.SigningData.RecoveryID)
+	require.Equal(t, data.SigningData.Signature, data.SigningData.RecoveryIDSignature)
+}
+
+func TestSVM_RecoveryIDInvalidatedBySignatureChange(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	builder := &mockTxBuilder{}
+	client := &mockChainClient{builder: builder}
+	ch := newTestChains(t, "solana:mainnet", uregistrytypes.VmType_SVM, client)
+
+	// A recovery ID cached for an earlier signature must not be reused.
+	v := uint8(1)
+	data := txflow.SignedOutboundData{
+		OutboundCreatedEvent: uexecutortypes.OutboundCreatedEvent{
+			TxID:             "tx-123",
+			DestinationChain: "solana:mainnet",
+			SigningDeadline:  time.Now().Unix() + 600,
+		},
+		SigningData: &txflow.SigningData{
+			Signature:           hex.EncodeToString(make([]byte, 65)),
+			SigningHash:         hex.EncodeToString(make([]byte, 32)),
+			RecoveryID:          &v,
+			RecoveryIDSignature: hex.EncodeToString([]byte("previous signature")),
+		},
+	}
+	body, err := json.Marshal(data)
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&store.Event{
+		EventID:           "ev-1",
+		ExpiryBlockHeight: 99999,
+		Type:              "SIGN_OUTBOUND",
+		Status:            store.StatusSigned,
+		EventData:         body,
+	}).Error)
+
+	builder.On("BroadcastOutboundSigningRequest", mock.Anything,
+		mock.MatchedBy(func(req *common.UnsignedSigningReq) bool { return req.RecoveryID == nil }),
+		mock.Anything, mock.Anything).
+		Return("sig-abc", nil).Once()
+
+	newBroadcaster(evtStore, ch, "").processSigned(context.Background())
+	builder.AssertExpectations(t)
+	require.Equal(t, store.StatusBroadcasted, getEvent(t, db, "ev-1").Status)
+}
@@ -2,9 +2,11 @@ package txbroadcaster
 
 import (
 	"context"
+	"errors"
 	"math/big"
 
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/coordinator"
 	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
@@ -16,6 +18,7 @@ import (
 // tx hash is known before broadcasting (computed from the assembled signed tx).
 //
 // Flow:
+//  0. Gateway or vault paused → GATEWAY_PAUSED, retry next tick (see deferIfGatewayPaused)
 //  1. Build and broadcast the signed tx (tx hash is always returned, even on error)
 //  2. Success → BROADCASTED with tx hash
 //  3. Error: tx already on chain (mined by another node, or "already known") → BROADCASTED
@@ -23,7 +26,9 @@ import (
 //     - nonce consumed → BROADCASTED with tx hash (resolver will REVERT)
 //     - nonce NOT consumed → keep SIGNED, retry next tick
 func (b *Broadcaster) broadcastOutboundEVM(ctx context.Context, event *store.Event, data *txflow.SignedOutboundData, chainID string) {
-	log := b.logger.With().Str("event_id", event.EventID).Str("chain", chainID).Logger()
+	log := logger.WithTraceID(b.logger, data.UniversalTxId).With().Str("event_id", event.EventID).Str("chain", chainID).Logger()
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, b.eventStore, event.EventID, log)
+	defer finishEndpoints()
 
 	client, err := b.chains.GetClient(chainID)
 	if err != nil {
@@ -42,6 +47,10 @@ func (b *Broadcaster) broadcastOutboundEVM(ctx context.Context, event *store.Eve
 		return
 	}
 
+	if b.deferIfGatewayPaused(ctx, event, builder, log) {
+		return
+	}
+
 	// Broadcast — tx hash is computed before sending, so it's returned even on RPC error
 	outboundData := data.OutboundCreatedEvent
 	txHash, broadcastErr := builder.BroadcastOutboundSigningRequest(ctx, signingReq, &outboundData, signature)
@@ -50,6 +59,10 @@ func (b *Broadcaster) broadcastOutboundEVM(ctx context.Context, event *store.Eve
 		b.markBroadcasted(event, chainID, txHash)
 		return
 	}
+	if errors.Is(broadcastErr, common.ErrBroadcastSkipped) {
+		b.markDryRun(event, chainID, txHash)
+		return
+	}
 
 	// Broadcast failed — check if the tx landed on chain anyway (another node, or "already known")
 	if txHash == "" {
@@ -85,6 +98,8 @@ func (b *Broadcaster) broadcastOutboundEVM(ctx context.Context, event *store.Eve
 // Same nonce-consumed recovery pattern as outbound, but uses old TSS address for nonce check.
 func (b *Broadcaster) broadcastFundMigrationEVM(ctx context.Context, event *store.Event, data *txflow.SignedFundMigrationData, chainID string) {
 	log := b.logger.With().Str("event_id", event.EventID).Str("chain", chainID).Logger()
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, b.eventStore, event.EventID, log)
+	defer finishEndpoints()
 
 	oldTSSAddr, err := coordinator.DeriveEVMAddressFromPubkey(data.OldTssPubkey)
 	if err != nil {
@@ -134,6 +149,10 @@ func (b *Broadcaster) broadcastFundMigrationEVM(ctx context.Context, event *stor
 		b.markBroadcasted(event, chainID, txHash)
 		return
 	}
+	if errors.Is(broadcastErr, common.ErrBroadcastSkipped) {
+		b.markDryRun(event, chainID, txHash)
+		return
+	}
 
 	if txHash == "" {
 		log.Warn().Err(broadcastErr).Msg("failed to assemble fund migration tx, will retry next tick")
@@ -173,5 +192,9 @@ func (b *Broadcaster) checkNonceAndMarkBroadcasted(
 		return
 	}
 
+	if errors.Is(broadcastErr, common.ErrInsufficientRelayerBalance) {
+		log.Warn().Err(broadcastErr).Msg("relayer cannot pay for tx, will retry next tick")
+		return
+	}
 	log.Debug().Err(broadcastErr).Msg("broadcast failed, will retry next tick")
 }
@@ -0,0 +1,45 @@
+package txbroadcaster
+
+import (
+	"time"
+
+	"github.com/pushchain/push-chain-node/universalClient/logger"
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
+)
+
+// DefaultLeaderTimeout is how long each broadcaster in the ranking gets to land
+// a signed tx before the next one takes over.
+const DefaultLeaderTimeout = 60 * time.Second
+
+// isBroadcastTurn reports whether this node should broadcast the event now.
+// The leader (rank 0) broadcasts immediately; rank n takes over once the event
+// has been SIGNED for n leader timeouts without leaving that state. Followers
+// that take over hit the existing "already on chain" paths if the leader did
+// land the tx but this node's resolver has not caught up yet.
+//
+// Without a validator source, or when this node is not in the set, every node
+// broadcasts as before — the signed tx is identical, so duplicates are safe.
+func (b *Broadcaster) isBroadcastTurn(event *store.Event, now time.Time) bool {
+	if b.getValidators == nil || b.validatorAddress == "" {
+		return true
+	}
+	rank, ok := txflow.BroadcastRank(event.EventID, b.validatorAddress, b.getValidators())
+	if !ok {
+		return true
+	}
+	if rank == 0 {
+		return true
+	}
+	waited := now.Sub(event.UpdatedAt)
+	if waited >= time.Duration(rank)*b.leaderTimeout {
+		return true
+	}
+	log := logger.WithTraceID(b.logger, event.UniversalTxID())
+	log.Debug().
+		Str("event_id", event.EventID).
+		Int("rank", rank).
+		Dur("waited", waited).
+		Msg("not this node's turn to broadcast, standing by")
+	return false
+}
@@ -0,0 +1,139 @@
+package txbroadcaster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
+)
+
+var leaderTestValidators = []string{"pushvaloper1a", "pushvaloper1b", "pushvaloper1c", "pushvaloper1d", "pushvaloper1e"}
+
+// rankedValidators returns the validators in broadcast order for eventID.
+func rankedValidators(t *testing.T, eventID string) []string {
+	t.Helper()
+	order := make([]string, len(leaderTestValidators))
+	for _, v := range leaderTestValidators {
+		rank, ok := txflow.BroadcastRank(eventID, v, leaderTestValidators)
+		require.True(t, ok)
+		require.Empty(t, order[rank], "two validators share rank %d", rank)
+		order[rank] = v
+	}
+	return order
+}
+
+func TestBroadcastRank_ExactlyOneLeader(t *testing.T) {
+	leaders := make(map[string]int)
+	for i := 0; i < 200; i++ {
+		eventID := fmt.Sprintf("0x%064x", i)
+		order := rankedValidators(t, eventID)
+		leaders[order[0]]++
+
+		// Every node must derive the same order regardless of how its
+		// validator list happens to be ordered.
+		reversed := make([]string, len(leaderTestValidators))
+		for j, v := range leaderTestValidators {
+			reversed[len(reversed)-1-j] = v
+		}
+		for rank, v := range order {
+			got, ok := txflow.BroadcastRank(eventID, v, reversed)
+			require.True(t, ok)
+			assert.Equal(t, rank, got)
+		}
+	}
+
+	// Leadership is spread across the set rather than pinned to one node.
+	assert.Len(t, leaders, len(leaderTestValidators))
+}
+
+func TestBroadcastRank_NotInSet(t *testing.T) {
+	_, ok := txflow.BroadcastRank("ev-1", "pushvaloper1z", leaderTestValidators)
+	assert.False(t, ok)
+	_, ok = txflow.BroadcastRank("ev-1", "pushvaloper1a", nil)
+	assert.False(t, ok)
+}
+
+func TestIsBroadcastTurn_Failover(t *testing.T) {
+	const timeout = 30 * time.Second
+	signedAt := time.Unix(1_700_000_000, 0)
+	event := &store.Event{EventID: "ev-failover"}
+	event.UpdatedAt = signedAt
+	order := rankedValidators(t, event.EventID)
+
+	turn := func(self string, now time.Time) bool {
+		b := NewBroadcaster(Config{
+			ValidatorAddress: self,
+			GetValidators:    func() []string { return leaderTestValidators },
+			LeaderTimeout:    timeout,
+		})
+		return b.isBroadcastTurn(event, now)
+	}
+
+	t.Run("only the leader broadcasts at first", func(t *testing.T) {
+		var elected []string
+		for _, v := range leaderTestValidators {
+			if turn(v, signedAt) {
+				elected = append(elected, v)
+			}
+		}
+		assert.Equal(t, []string{order[0]}, elected)
+	})
+
+	t.Run("next in line takes over after each timeout", func(t *testing.T) {
+		assert.False(t, turn(order[1], signedAt.Add(timeout-time.Second)))
+		assert.True(t, turn(order[1], signedAt.Add(timeout)))
+		assert.False(t, turn(order[2], signedAt.Add(timeout)))
+		assert.True(t, turn(order[2], signedAt.Add(2*timeout)))
+	})
+
+	t.Run("falls back to broadcasting without a validator set", func(t *testing.T) {
+		b := NewBroadcaster(Config{ValidatorAddress: order[4]})
+		assert.True(t, b.isBroadcastTurn(event, signedAt))
+
+		b = NewBroadcaster(Config{
+			ValidatorAddress: "pushvaloper1z",
+			GetValidators:    func() []string { return leaderTestValidators },
+		})
+		assert.True(t, b.isBroadcastTurn(event, signedAt))
+	})
+}
+
+func TestProcessSigned_FollowerStandsBy(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	builder := &mockTxBuilder{}
+	client := &mockChainClient{builder: builder}
+	ch := newTestChains(t, "eip155:1", uregistrytypes.VmType_EVM, client)
+
+	insertSignedEvent(t, db, "ev-follower", "eip155:1", 5)
+	order := rankedValidators(t, "ev-follower")
+
+	newNode := func(self string) *Broadcaster {
+		b := newBroadcaster(evtStore, ch, "0xTSS")
+		b.validatorAddress = self
+		b.getValidators = func() []string { return leaderTestValidators }
+		return b
+	}
+
+	// A freshly signed event: the follower must not touch the chain.
+	newNode(order[1]).processSigned(context.Background())
+	builder.AssertNotCalled(t, "BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	require.Equal(t, store.StatusSigned, getEvent(t, db, "ev-follower").Status)
+
+	// The leader broadcasts straight away.
+	builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return("0xabc", nil).Once()
+	newNode(order[0]).processSigned(context.Background())
+	ev := getEvent(t, db, "ev-follower")
+	require.Equal(t, store.StatusBroadcasted, ev.Status)
+	require.Equal(t, "eip155:1:0xabc", ev.BroadcastedTxHash)
+	builder.AssertExpectations(t)
+}
@@ -2,8 +2,13 @@ package txbroadcaster
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/rs/zerolog"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
 )
@@ -17,7 +22,7 @@ import (
 //     clock (latest finalized block time) before giving up. The cluster
 //     clock — not the host clock — is what the gateway program enforces
 //     against, so it's the authoritative cutoff.
-//  2. Broadcast.
+//  2. Broadcast, unless the gateway is paused (see deferIfGatewayPaused).
 //  3. On broadcast error, check whether a peer landed the same signed tx.
 //
 // The give-up cutoff is exactly `clusterTime > deadline`. The finalized block
@@ -29,10 +34,14 @@ import (
 //
 // Outcomes:
 //   - BROADCASTED(real-hash)  → broadcast succeeded
+//   - DRY_RUN(real-hash)      → dry_run mode, tx built but not sent
 //   - BROADCASTED("")         → peer landed it, or cluster confirmed expiry
+//   - GATEWAY_PAUSED          → gateway paused, retry next tick
 //   - stay SIGNED             → retry next tick
 func (b *Broadcaster) broadcastOutboundSVM(ctx context.Context, event *store.Event, data *txflow.SignedOutboundData, chainID string) {
-	log := b.logger.With().Str("event_id", event.EventID).Str("chain", chainID).Logger()
+	log := logger.WithTraceID(b.logger, data.UniversalTxId).With().Str("event_id", event.EventID).Str("chain", chainID).Logger()
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, b.eventStore, event.EventID, log)
+	defer finishEndpoints()
 
 	client, err := b.chains.GetClient(chainID)
 	if err != nil {
@@ -76,12 +85,22 @@ func (b *Broadcaster) broadcastOutboundSVM(ctx context.Context, event *store.Eve
 		// Cluster says still inside the window (or freshness unknown) — broadcast.
 	}
 
+	if b.deferIfGatewayPaused(ctx, event, builder, log) {
+		return
+	}
+
 	// Broadcast attempt.
 	txHash, broadcastErr := builder.BroadcastOutboundSigningRequest(ctx, signingReq, &outboundData, signature)
 	if broadcastErr == nil {
 		b.markBroadcasted(event, chainID, txHash)
 		return
 	}
+	if errors.Is(broadcastErr, common.ErrBroadcastSkipped) {
+		b.markDryRun(event, chainID, txHash)
+		return
+	}
+
+	b.cacheRecoveryID(event, data.SigningData, signingReq, log)
 
 	// Race: a peer may have landed the same signed tx in the meantime.
 	if executed, _, _ := builder.IsAlreadyExecuted(ctx, txID); executed {
@@ -90,6 +109,22 @@ func (b *Broadcaster) broadcastOutboundSVM(ctx context.Context, event *store.Eve
 		return
 	}
 
+	if errors.Is(broadcastErr, common.ErrInsufficientRelayerBalance) {
+		log.Warn().Err(broadcastErr).Int64("signing_deadline", deadline).
+			Msg("SVM relayer cannot pay for tx, staying SIGNED for next tick")
+		return
+	}
 	log.Debug().Err(broadcastErr).Int64("signing_deadline", deadline).
 		Msg("SVM broadcast failed, staying SIGNED for next tick")
 }
+
+// cacheRecoveryID persists the recovery ID the builder determined on this
+// attempt so the next retry skips the on-chain TSS address lookup.
+func (b *Broadcaster) cacheRecoveryID(event *store.Event, sd *txflow.SigningData, req *common.UnsignedSigningReq, log zerolog.Logger) {
+	if req.RecoveryID == nil || (sd.RecoveryID != nil && sd.RecoveryIDSignature == sd.Signature) {
+		return
+	}
+	if _, err := b.eventStore.PersistRecoveryID(event.EventID, sd.Signature, *req.RecoveryID); err != nil {
+		log.Warn().Err(err).Msg("failed to cache SVM recovery ID")
+	}
+}
@@ -0,0 +1,209 @@
+package txbroadcaster
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+	uregistrytypes "github.com/pushchain/push-chain-node/x/uregistry/types"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
+	"github.com/pushchain/push-chain-node/universalClient/tss/txresolver"
+)
+
+// syncBuffer is a goroutine-safe log sink; the resolver logs from its own loop.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) lines(t *testing.T) []map[string]any {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var out []map[string]any
+	sc := bufio.NewScanner(bytes.NewReader(b.buf.Bytes()))
+	for sc.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &line))
+		out = append(out, line)
+	}
+	return out
+}
+
+// TestTraceID_ConsistentAcrossPipeline runs one outbound through broadcast and
+// resolution and checks every log line about it carries the same trace ID.
+func TestTraceID_ConsistentAcrossPipeline(t *testing.T) {
+	const (
+		eventID = "ev-trace"
+		utxID   = "0xABCDEF0123456789ABCDEF0123456789ABCDEF0123456789ABCDEF0123456789"
+	)
+	wantTrace := logger.TraceID(utxID)
+	require.Equal(t, "abcdef0123456789", wantTrace)
+
+	sink := &syncBuffer{}
+	log := zerolog.New(sink).Level(zerolog.DebugLevel)
+
+	evtStore, db := setupTestDB(t)
+	builder := &mockTxBuilder{}
+	client := &mockChainClient{builder: builder}
+	ch := newTestChains(t, "eip155:1", uregistrytypes.VmType_EVM, client)
+
+	data, err := json.Marshal(txflow.SignedOutboundData{
+		OutboundCreatedEvent: uexecutortypes.OutboundCreatedEvent{
+			TxID:             eventID,
+			UniversalTxId:    utxID,
+			DestinationChain: "eip155:1",
+		},
+		SigningData: &txflow.SigningData{
+			Signature:   "00",
+			SigningHash: "00",
+			Nonce:       1,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&store.Event{
+		EventID:          eventID,
+		Type:             store.EventTypeSignOutbound,
+		ConfirmationType: store.ConfirmationStandard,
+		Status:           store.StatusSigned,
+		EventData:        data,
+	}).Error)
+
+	builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return("0xabc", nil)
+	builder.On("VerifyBroadcastedTx", mock.Anything, "0xabc").
+		Return(true, uint64(10), uint64(100), uint8(1), nil)
+
+	// Broadcast stage.
+	NewBroadcaster(Config{EventStore: evtStore, Chains: ch, Logger: log}).processSigned(context.Background())
+	require.Equal(t, store.StatusBroadcasted, getEvent(t, db, eventID).Status)
+
+	// Resolve stage.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txresolver.NewResolver(txresolver.Config{
+		EventStore:    evtStore,
+		Chains:        ch,
+		CheckInterval: 10 * time.Millisecond,
+		Logger:        log,
+	}).Start(ctx)
+	require.Eventually(t, func() bool {
+		return getEvent(t, db, eventID).Status == store.StatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+	cancel()
+
+	components := make(map[string]bool)
+	for _, line := range sink.lines(t) {
+		if line["event_id"] != eventID {
+			continue
+		}
+		components[line["component"].(string)] = true
+		assert.Equal(t, wantTrace, line[logger.TraceIDField], "log line %q missing trace ID", line["message"])
+	}
+	assert.True(t, components["txbroadcaster"], "no broadcaster log lines for the outbound")
+	assert.True(t, components["txresolver"], "no resolver log lines for the outbound")
+}
+
+// TestServedEndpoints_RecordedForOutbound simulates an outbound whose broadcast
+// and verification are served by different endpoints, and checks both are
+// persisted on the event and logged under its trace ID.
+func TestServedEndpoints_RecordedForOutbound(t *testing.T) {
+	const (
+		eventID = "ev-endpoints"
+		utxID   = "0x1111111111111111111111111111111111111111111111111111111111111111"
+	)
+	sink := &syncBuffer{}
+	log := zerolog.New(sink).Level(zerolog.DebugLevel)
+
+	evtStore, db := setupTestDB(t)
+	builder := &mockTxBuilder{}
+	client := &mockChainClient{builder: builder}
+	ch := newTestChains(t, "eip155:1", uregistrytypes.VmType_EVM, client)
+
+	data, err := json.Marshal(txflow.SignedOutboundData{
+		OutboundCreatedEvent: uexecutortypes.OutboundCreatedEvent{
+			TxID:             eventID,
+			UniversalTxId:    utxID,
+			DestinationChain: "eip155:1",
+		},
+		SigningData: &txflow.SigningData{Signature: "00", SigningHash: "00", Nonce: 1},
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&store.Event{
+		EventID:          eventID,
+		Type:             store.EventTypeSignOutbound,
+		ConfirmationType: store.ConfirmationStandard,
+		Status:           store.StatusSigned,
+		EventData:        data,
+	}).Error)
+
+	// Stand in for the RPC client's failover loop reporting who answered.
+	builder.On("BroadcastOutboundSigningRequest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			common.RecordEndpoint(args.Get(0).(context.Context), "send_transaction", "https://rpc-a.example")
+		}).
+		Return("0xabc", nil)
+	builder.On("VerifyBroadcastedTx", mock.Anything, "0xabc").
+		Run(func(args mock.Arguments) {
+			common.RecordEndpoint(args.Get(0).(context.Context), "get_transaction_receipt", "https://rpc-b.example")
+		}).
+		Return(true, uint64(10), uint64(100), uint8(1), nil)
+
+	NewBroadcaster(Config{EventStore: evtStore, Chains: ch, Logger: log}).processSigned(context.Background())
+	require.Equal(t, store.StatusBroadcasted, getEvent(t, db, eventID).Status)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	txresolver.NewResolver(txresolver.Config{
+		EventStore:    evtStore,
+		Chains:        ch,
+		CheckInterval: 10 * time.Millisecond,
+		Logger:        log,
+	}).Start(ctx)
+	require.Eventually(t, func() bool {
+		return getEvent(t, db, eventID).Status == store.StatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+	cancel()
+
+	var served map[string]string
+	require.NoError(t, json.Unmarshal(getEvent(t, db, eventID).ServedEndpoints, &served))
+	assert.Equal(t, map[string]string{
+		"send_transaction":        "https://rpc-a.example",
+		"get_transaction_receipt": "https://rpc-b.example",
+	}, served)
+
+	logged := make(map[string]any)
+	for _, line := range sink.lines(t) {
+		if line["event_id"] != eventID || line["served_endpoints"] == nil {
+			continue
+		}
+		assert.Equal(t, logger.TraceID(utxID), line[logger.TraceIDField])
+		for op, endpoint := range line["served_endpoints"].(map[string]any) {
+			logged[op] = endpoint
+		}
+	}
+	assert.Equal(t, map[string]any{
+		"send_transaction":        "https://rpc-a.example",
+		"get_transaction_receipt": "https://rpc-b.example",
+	}, logged)
+}
@@ -0,0 +1,36 @@
+package txflow
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
+)
+
+// TrackEndpoints returns a context that records which RPC endpoint serves
+// each call made under it, and a finish func that logs the endpoints on log
+// (which should carry the event's trace ID) and merges them into the event's
+// served_endpoints. Call finish once the flow for the event is done, typically
+// via defer; calls that all failed record nothing.
+func TrackEndpoints(ctx context.Context, es *eventstore.Store, eventID string, log zerolog.Logger) (context.Context, func()) {
+	ctx, rec := common.WithEndpointRecorder(ctx)
+	return ctx, func() {
+		served := rec.Served()
+		if len(served) == 0 {
+			return
+		}
+		dict := zerolog.Dict()
+		for op, endpoint := range served {
+			dict = dict.Str(op, endpoint)
+		}
+		log.Debug().Dict("served_endpoints", dict).Msg("RPC endpoints used for event")
+		if es == nil {
+			return
+		}
+		if err := es.RecordServedEndpoints(eventID, served); err != nil {
+			log.Warn().Err(err).Msg("failed to record served endpoints")
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package txflow
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+)
+
+// BroadcastRank returns self's position in the broadcast order for an event.
+// Validators are ranked by sha256(eventID || address) (rendezvous hashing):
+// every node derives the same order from the same validator set, each event
+// gets an independent leader, and adding or removing a validator only shifts
+// the events that validator ranked first. ok is false if self isn't in the set.
+func BroadcastRank(eventID, self string, validators []string) (rank int, ok bool) {
+	type scored struct {
+		addr  string
+		score [sha256.Size]byte
+	}
+	ranked := make([]scored, 0, len(validators))
+	for _, v := range validators {
+		ranked = append(ranked, scored{addr: v, score: sha256.Sum256([]byte(eventID + v))})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if c := bytes.Compare(ranked[i].score[:], ranked[j].score[:]); c != 0 {
+			return c < 0
+		}
+		return ranked[i].addr < ranked[j].addr
+	})
+	for i, r := range ranked {
+		if r.addr == self {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// VoteQuorum returns how many of n validators must vote for a Push Chain
+// ballot to finalize (>2/3, as uexecutor counts it).
+func VoteQuorum(n int) int {
+	return (uexecutortypes.VotesThresholdNumerator*n)/uexecutortypes.VotesThresholdDenominator + 1
+}
@@ -11,7 +11,8 @@ import (
 )
 
 // DecodeSigningData converts the persisted hex-encoded signature + signing
-// hash into the byte forms the chain-specific tx builders consume.
+// hash into the byte forms the chain-specific tx builders consume, carrying
+// over a cached recovery ID if it still matches the signature.
 func DecodeSigningData(sd *SigningData) (*common.UnsignedSigningReq, []byte, error) {
 	signingHash, err := hex.DecodeString(sd.SigningHash)
 	if err != nil {
@@ -21,11 +22,17 @@ func DecodeSigningData(sd *SigningData) (*common.UnsignedSigningReq, []byte, err
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to decode signature: %w", err)
 	}
-	return &common.UnsignedSigningReq{
+	req := &common.UnsignedSigningReq{
 		SigningHash:            signingHash,
 		Nonce:                  sd.Nonce,
 		TSSFundMigrationAmount: sd.TSSFundMigrationAmount,
-	}, signature, nil
+	}
+	// A cached recovery ID only applies to the signature it was derived for.
+	if sd.RecoveryID != nil && sd.RecoveryIDSignature == sd.Signature {
+		v := *sd.RecoveryID
+		req.RecoveryID = &v
+	}
+	return req, signature, nil
 }
 
 // ReadSignedNonce extracts the signed nonce from any signed outbound event
@@ -23,6 +23,12 @@ type SigningData struct {
 	SigningHash            string   `json:"signing_hash"` // hex-encoded signing hash
 	Nonce                  uint64   `json:"nonce"`
 	TSSFundMigrationAmount *big.Int `json:"tss_fund_migration_amount,omitempty"`
+
+	// RecoveryID caches the SVM recovery ID once the builder has determined
+	// it; RecoveryIDSignature is the signature it was derived for, so a
+	// re-sign invalidates the cache.
+	RecoveryID          *uint8 `json:"recovery_id,omitempty"`
+	RecoveryIDSignature string `json:"recovery_id_signature,omitempty"`
 }
 
 // SignedOutboundData wraps OutboundCreatedEvent with the signing data the
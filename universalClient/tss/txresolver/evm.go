@@ -3,6 +3,7 @@ package txresolver
 import (
 	"context"
 
+	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
 )
@@ -11,7 +12,9 @@ import (
 // follow this shape):
 //
 //   - VerifyBroadcastedTx error                      → stay BROADCASTED (retry)
-//   - Tx found, insufficient confirmations           → stay BROADCASTED (retry)
+//   - Tx found, insufficient confirmations           → stay BROADCASTED (retry);
+//                                                      high-value outbounds may
+//                                                      need more (RequiredConfirmations)
 //   - Tx found, status=1 (success)                   → COMPLETED / vote success
 //   - Tx found, status=0 (reverted on chain)         → REVERT  / vote failure with tx hash
 //   - Tx not found, signed nonce < finalized nonce   → REVERT  / vote failure (another tx
@@ -31,11 +34,13 @@ import (
 
 // resolveOutboundEVM resolves a BROADCASTED outbound on an EVM chain.
 func (r *Resolver) resolveOutboundEVM(ctx context.Context, event *store.Event, chainID, rawTxHash string) {
-	log := r.logger.With().
+	log := logger.WithTraceID(r.logger, event.UniversalTxID()).With().
 		Str("event_id", event.EventID).
 		Str("type", event.Type).
 		Str("chain", chainID).
 		Str("tx_hash", rawTxHash).Logger()
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, r.eventStore, event.EventID, log)
+	defer finishEndpoints()
 
 	// Empty hash → rewind so broadcaster recomputes deterministically.
 	if rawTxHash == "" {
@@ -44,11 +49,12 @@ func (r *Resolver) resolveOutboundEVM(ctx context.Context, event *store.Event, c
 		return
 	}
 
-	txID, utxID, err := extractOutboundIDs(event)
+	data, err := parseOutboundEvent(event)
 	if err != nil {
 		log.Warn().Err(err).Msg("failed to extract outbound IDs")
 		return
 	}
+	txID, utxID := data.TxID, data.UniversalTxId
 
 	builder, err := r.getBuilder(chainID)
 	if err != nil {
@@ -63,7 +69,7 @@ func (r *Resolver) resolveOutboundEVM(ctx context.Context, event *store.Event, c
 	}
 
 	if found {
-		if confirmations < r.chains.GetStandardConfirmations(chainID) {
+		if confirmations < builder.RequiredConfirmations(data, r.chains.GetStandardConfirmations(chainID)) {
 			return
 		}
 		if status == 0 {
@@ -112,6 +118,8 @@ func (r *Resolver) resolveFundMigrationEVM(ctx context.Context, event *store.Eve
 		Str("type", event.Type).
 		Str("chain", chainID).
 		Str("tx_hash", rawTxHash).Logger()
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, r.eventStore, event.EventID, log)
+	defer finishEndpoints()
 
 	// Empty hash → rewind so broadcaster recomputes deterministically.
 	if rawTxHash == "" {
@@ -0,0 +1,69 @@
+package txresolver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+)
+
+// expireStaleOutbounds votes failure for outbounds still unsigned
+// OutboundMaxLifetimeBlocks Push Chain blocks after they were created, so
+// uexecutor reverts them instead of leaving them pending while the
+// destination chain stays unreachable. Within its lifetime an outbound stays
+// CONFIRMED and the coordinator keeps retrying it.
+//
+// Only CONFIRMED and HELD outbounds expire: a signed EVM tx can still land
+// with its nonce once the chain is back, so failing it here could pay out
+// twice. Expiry also clears held outbounds nobody reviewed in time.
+// Lifetimes count Push Chain blocks from the outbound's creation height, so
+// every validator agrees on when an outbound expires.
+func (r *Resolver) expireStaleOutbounds(ctx context.Context) {
+	if r.chains == nil || r.getLatestBlock == nil {
+		return
+	}
+	currentBlock, err := r.getLatestBlock(ctx)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("failed to get current block, skipping outbound expiry")
+		return
+	}
+
+	events, err := r.eventStore.GetNonExpiredConfirmedEvents(currentBlock, 0, 0)
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("failed to get confirmed events for outbound expiry")
+		return
+	}
+	held, err := r.eventStore.GetHeldEvents()
+	if err != nil {
+		r.logger.Warn().Err(err).Msg("failed to get held events for outbound expiry")
+		return
+	}
+	events = append(events, held...)
+	for i := range events {
+		event := &events[i]
+		if event.Type != store.EventTypeSignOutbound {
+			continue
+		}
+		data, err := parseOutboundEvent(event)
+		if err != nil {
+			r.logger.Warn().Err(err).Str("event_id", event.EventID).Msg("outbound expiry: invalid event data, skipping")
+			continue
+		}
+		lifetime := r.chains.OutboundMaxLifetimeBlocks(data.DestinationChain)
+		if lifetime == 0 || currentBlock < event.BlockHeight+lifetime {
+			continue
+		}
+
+		// The coordinator may have picked the event up, or an operator
+		// released it, since the query.
+		current, err := r.eventStore.GetEvent(event.EventID)
+		if err != nil || current.Status != event.Status {
+			continue
+		}
+
+		errorMsg := fmt.Sprintf("outbound expired: not signed within %d blocks", lifetime)
+		if err := r.voteOutboundFailureAndMarkReverted(ctx, event, data.TxID, data.UniversalTxId, "", 0, "0", errorMsg); err != nil {
+			r.logger.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to expire outbound")
+		}
+	}
+}
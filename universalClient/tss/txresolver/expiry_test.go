@@ -0,0 +1,149 @@
+package txresolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+
+	"github.com/pushchain/push-chain-node/universalClient/chains"
+	"github.com/pushchain/push-chain-node/universalClient/config"
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
+)
+
+type mockVoter struct{ mock.Mock }
+
+func (m *mockVoter) VoteOutbound(ctx context.Context, txID string, utxID string, observation *uexecutortypes.OutboundObservation) (string, error) {
+	args := m.Called(ctx, txID, utxID, observation)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockVoter) VoteFundMigration(ctx context.Context, migrationID uint64, txHash string, success bool) (string, error) {
+	args := m.Called(ctx, migrationID, txHash, success)
+	return args.String(0), args.Error(1)
+}
+
+func newExpiryResolver(t *testing.T, evtStore *eventstore.Store, chainID string, lifetime int, currentBlock uint64, v voter) *Resolver {
+	t.Helper()
+	ch := chains.NewChains(nil, nil, &config.Config{
+		PushChainID: "test-chain",
+		ChainConfigs: map[string]config.ChainSpecificConfig{
+			chainID: {OutboundMaxLifetimeBlocks: &lifetime},
+		},
+	}, zerolog.Nop())
+	r := NewResolver(Config{
+		EventStore:     evtStore,
+		Chains:         ch,
+		Logger:         zerolog.Nop(),
+		GetLatestBlock: func(ctx context.Context) (uint64, error) { return currentBlock, nil },
+	})
+	r.pushSigner = v
+	return r
+}
+
+func insertConfirmedOutbound(t *testing.T, db *gorm.DB, eventID, destChain string, blockHeight uint64) {
+	t.Helper()
+	event := store.Event{
+		EventID:          eventID,
+		BlockHeight:      blockHeight,
+		Type:             store.EventTypeSignOutbound,
+		ConfirmationType: "STANDARD",
+		Status:           store.StatusConfirmed,
+		EventData:        makeOutboundEventData("tx-"+eventID, "utx-"+eventID, destChain),
+	}
+	require.NoError(t, db.Create(&event).Error)
+}
+
+func TestExpireStaleOutbounds_PastLifetime_VotesFailureAndReverts(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	insertConfirmedOutbound(t, db, "ev-1", "eip155:1", 100)
+
+	v := &mockVoter{}
+	v.On("VoteOutbound", mock.Anything, "tx-ev-1", "utx-ev-1", mock.MatchedBy(func(obs *uexecutortypes.OutboundObservation) bool {
+		return !obs.Success && obs.TxHash == "" && obs.GasFeeUsed == "0" && obs.ErrorMsg == "outbound expired: not signed within 50 blocks"
+	})).Return("vote-hash", nil).Once()
+
+	r := newExpiryResolver(t, evtStore, "eip155:1", 50, 150, v)
+	r.expireStaleOutbounds(context.Background())
+
+	v.AssertExpectations(t)
+	updated := getEvent(t, db, "ev-1")
+	require.Equal(t, store.StatusReverted, updated.Status)
+	require.Equal(t, "vote-hash", updated.VoteTxHash)
+}
+
+func TestExpireStaleOutbounds_WithinLifetime_StaysConfirmed(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	insertConfirmedOutbound(t, db, "ev-1", "eip155:1", 100)
+
+	v := &mockVoter{}
+	r := newExpiryResolver(t, evtStore, "eip155:1", 50, 149, v)
+	r.expireStaleOutbounds(context.Background())
+
+	// Not voted: the coordinator keeps retrying it.
+	v.AssertNotCalled(t, "VoteOutbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	require.Equal(t, store.StatusConfirmed, getEvent(t, db, "ev-1").Status)
+}
+
+func TestExpireStaleOutbounds_NoLifetimeForChain_StaysConfirmed(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	insertConfirmedOutbound(t, db, "ev-1", "eip155:97", 100)
+
+	v := &mockVoter{}
+	r := newExpiryResolver(t, evtStore, "eip155:1", 50, 100000, v)
+	r.expireStaleOutbounds(context.Background())
+
+	v.AssertNotCalled(t, "VoteOutbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	require.Equal(t, store.StatusConfirmed, getEvent(t, db, "ev-1").Status)
+}
+
+func TestExpireStaleOutbounds_SignedOutbound_NotExpired(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	insertConfirmedOutbound(t, db, "ev-1", "eip155:1", 100)
+	require.NoError(t, db.Model(&store.Event{}).Where("event_id = ?", "ev-1").Update("status", store.StatusSigned).Error)
+
+	v := &mockVoter{}
+	r := newExpiryResolver(t, evtStore, "eip155:1", 50, 1000, v)
+	r.expireStaleOutbounds(context.Background())
+
+	// A signed tx may still land once the chain is back; never fail it here.
+	v.AssertNotCalled(t, "VoteOutbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	require.Equal(t, store.StatusSigned, getEvent(t, db, "ev-1").Status)
+}
+
+func TestExpireStaleOutbounds_VoteFails_StaysConfirmed(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	insertConfirmedOutbound(t, db, "ev-1", "eip155:1", 100)
+
+	v := &mockVoter{}
+	v.On("VoteOutbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", context.DeadlineExceeded).Once()
+
+	r := newExpiryResolver(t, evtStore, "eip155:1", 50, 200, v)
+	r.expireStaleOutbounds(context.Background())
+
+	// Retried on the next tick.
+	v.AssertExpectations(t)
+	require.Equal(t, store.StatusConfirmed, getEvent(t, db, "ev-1").Status)
+}
+
+func TestExpireStaleOutbounds_HeldPastLifetime_VotesFailureAndReverts(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	insertConfirmedOutbound(t, db, "ev-1", "eip155:1", 100)
+	require.NoError(t, db.Model(&store.Event{}).Where("event_id = ?", "ev-1").Update("status", store.StatusHeld).Error)
+
+	v := &mockVoter{}
+	v.On("VoteOutbound", mock.Anything, "tx-ev-1", "utx-ev-1", mock.Anything).Return("vote-hash", nil).Once()
+
+	r := newExpiryResolver(t, evtStore, "eip155:1", 50, 150, v)
+	r.expireStaleOutbounds(context.Background())
+
+	// A held outbound nobody reviewed in time is cleared like an unsigned one.
+	v.AssertExpectations(t)
+	require.Equal(t, store.StatusReverted, getEvent(t, db, "ev-1").Status)
+}
@@ -0,0 +1,38 @@
+package txresolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
+)
+
+// heldRejectMsg is the failure reason voted for a rejected held outbound.
+const heldRejectMsg = "outbound rejected on manual review"
+
+// RejectHeldOutbound votes failure for an outbound held for manual review
+// and marks it REVERTED; Push Chain reverts the outbound once a quorum of
+// validators has rejected it. The vote is the operator's own decision, so it
+// is sent regardless of the report order. Returns eventstore.ErrNotHeld if
+// the event is missing or no longer HELD.
+func (r *Resolver) RejectHeldOutbound(ctx context.Context, eventID string) error {
+	if r.pushSigner == nil {
+		return fmt.Errorf("pushSigner not configured, cannot vote failure")
+	}
+	event, err := r.eventStore.GetEvent(eventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && event.Status != store.StatusHeld) {
+		return fmt.Errorf("event %s: %w", eventID, eventstore.ErrNotHeld)
+	}
+	if err != nil {
+		return err
+	}
+	data, err := parseOutboundEvent(event)
+	if err != nil {
+		return err
+	}
+	return r.voteOutboundFailure(ctx, event, data.TxID, data.UniversalTxId, "", 0, "0", heldRejectMsg)
+}
@@ -0,0 +1,47 @@
+package txresolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	uexecutortypes "github.com/pushchain/push-chain-node/x/uexecutor/types"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
+)
+
+func TestRejectHeldOutbound(t *testing.T) {
+	evtStore, db := setupTestDB(t)
+	insertConfirmedOutbound(t, db, "held", "eip155:1", 100)
+	insertConfirmedOutbound(t, db, "confirmed", "eip155:1", 100)
+	require.NoError(t, db.Model(&store.Event{}).Where("event_id = ?", "held").Update("status", store.StatusHeld).Error)
+
+	v := &mockVoter{}
+	v.On("VoteOutbound", mock.Anything, "tx-held", "utx-held", mock.MatchedBy(func(obs *uexecutortypes.OutboundObservation) bool {
+		return !obs.Success && obs.ErrorMsg == heldRejectMsg
+	})).Return("vote-hash", nil).Once()
+
+	r := newExpiryResolver(t, evtStore, "eip155:1", 0, 100, v)
+	// Rejection is the operator's decision: it is voted even when the
+	// election would have this node stand by.
+	r.validatorAddress = "pushvaloper1self"
+	r.getValidators = func() []string {
+		return []string{"pushvaloper1a", "pushvaloper1b", "pushvaloper1c", "pushvaloper1d", "pushvaloper1self"}
+	}
+	require.False(t, r.IsReportTurn("held", time.Now()))
+
+	require.NoError(t, r.RejectHeldOutbound(context.Background(), "held"))
+	v.AssertExpectations(t)
+	updated := getEvent(t, db, "held")
+	require.Equal(t, store.StatusReverted, updated.Status)
+	require.Equal(t, "vote-hash", updated.VoteTxHash)
+
+	for _, id := range []string{"held", "confirmed", "missing"} {
+		require.ErrorIs(t, r.RejectHeldOutbound(context.Background(), id), eventstore.ErrNotHeld, id)
+	}
+	require.Equal(t, store.StatusConfirmed, getEvent(t, db, "confirmed").Status)
+}
@@ -0,0 +1,58 @@
+package txresolver
+
+import (
+	"time"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
+)
+
+// DefaultLeaderTimeout is how long each reporter past the quorum waits before
+// reporting an outbound result in place of one that has not voted.
+const DefaultLeaderTimeout = 60 * time.Second
+
+// isReportTurn reports whether this node should vote the outbound result for
+// the event now; see IsReportTurn.
+func (r *Resolver) isReportTurn(event *store.Event, now time.Time) bool {
+	return r.reportTurn(event.EventID, event.UpdatedAt, now)
+}
+
+// IsReportTurn reports whether this node should vote the result of the
+// outbound in eventID now, the result having awaited a report since
+// pendingSince. Reporting follows the broadcast order over the Push Chain
+// voters: a ballot only finalizes with a >2/3 quorum, so the first
+// VoteQuorum voters in the order report immediately and the rest stand by.
+// Rank quorum+n takes over once the result has waited n+1 leader timeouts;
+// a late report on an already finalized outbound is not sent.
+//
+// Without a validator source, or when this node is not in the set, every node
+// reports as before.
+func (r *Resolver) IsReportTurn(eventID string, pendingSince time.Time) bool {
+	return r.reportTurn(eventID, pendingSince, time.Now())
+}
+
+func (r *Resolver) reportTurn(eventID string, pendingSince, now time.Time) bool {
+	if r.getValidators == nil || r.validatorAddress == "" {
+		return true
+	}
+	validators := r.getValidators()
+	rank, ok := txflow.BroadcastRank(eventID, r.validatorAddress, validators)
+	if !ok {
+		return true
+	}
+	quorum := txflow.VoteQuorum(len(validators))
+	if rank < quorum {
+		return true
+	}
+	waited := now.Sub(pendingSince)
+	if waited >= time.Duration(rank-quorum+1)*r.leaderTimeout {
+		return true
+	}
+	r.logger.Debug().
+		Str("event_id", eventID).
+		Int("rank", rank).
+		Int("quorum", quorum).
+		Dur("waited", waited).
+		Msg("not this node's turn to report, standing by")
+	return false
+}
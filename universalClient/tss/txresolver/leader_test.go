@@ -0,0 +1,118 @@
+package txresolver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pushchain/push-chain-node/universalClient/store"
+	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
+)
+
+var reportTestValidators = []string{
+	"pushvaloper1a", "pushvaloper1b", "pushvaloper1c", "pushvaloper1d",
+	"pushvaloper1e", "pushvaloper1f", "pushvaloper1g",
+}
+
+// reportOrder returns the validators in broadcast order for eventID.
+func reportOrder(t *testing.T, eventID string) []string {
+	t.Helper()
+	order := make([]string, len(reportTestValidators))
+	for _, v := range reportTestValidators {
+		rank, ok := txflow.BroadcastRank(eventID, v, reportTestValidators)
+		require.True(t, ok)
+		order[rank] = v
+	}
+	return order
+}
+
+func newReportResolver(self string, v voter, timeout time.Duration) *Resolver {
+	r := NewResolver(Config{
+		Logger:           zerolog.Nop(),
+		ValidatorAddress: self,
+		GetValidators:    func() []string { return reportTestValidators },
+		LeaderTimeout:    timeout,
+	})
+	r.pushSigner = v
+	return r
+}
+
+func TestVoteOutboundFailure_OnlyElectedValidatorsReport(t *testing.T) {
+	quorum := txflow.VoteQuorum(len(reportTestValidators))
+	require.Less(t, quorum, len(reportTestValidators), "test needs standby validators")
+
+	for i := 0; i < 20; i++ {
+		eventID := fmt.Sprintf("ev-%d", i)
+		order := reportOrder(t, eventID)
+
+		var reported []string
+		for _, self := range reportTestValidators {
+			evtStore, db := setupTestDB(t)
+			event := store.Event{
+				EventID:   eventID,
+				Type:      store.EventTypeSignOutbound,
+				Status:    store.StatusBroadcasted,
+				EventData: makeOutboundEventData("tx-1", "utx-1", "eip155:1"),
+			}
+			require.NoError(t, db.Create(&event).Error)
+
+			v := &mockVoter{}
+			v.On("VoteOutbound", mock.Anything, "tx-1", "utx-1", mock.Anything).Return("vote-"+self, nil).Maybe()
+			r := newReportResolver(self, v, time.Hour)
+			r.eventStore = evtStore
+
+			require.NoError(t, r.voteOutboundFailureAndMarkReverted(context.Background(), &event, "tx-1", "utx-1", "", 0, "0", "reverted"))
+			if len(v.Calls) > 0 {
+				reported = append(reported, self)
+				assert.Equal(t, store.StatusReverted, getEvent(t, db, eventID).Status)
+			} else {
+				assert.Equal(t, store.StatusBroadcasted, getEvent(t, db, eventID).Status)
+			}
+		}
+		// The broadcaster and just enough others to finalize the ballot.
+		assert.ElementsMatch(t, order[:quorum], reported, eventID)
+	}
+}
+
+func TestIsReportTurn_Failover(t *testing.T) {
+	const timeout = 30 * time.Second
+	broadcastedAt := time.Unix(1_700_000_000, 0)
+	event := &store.Event{EventID: "ev-failover"}
+	event.UpdatedAt = broadcastedAt
+	order := reportOrder(t, event.EventID)
+	quorum := txflow.VoteQuorum(len(reportTestValidators))
+
+	turn := func(self string, now time.Time) bool {
+		return newReportResolver(self, nil, timeout).isReportTurn(event, now)
+	}
+
+	t.Run("broadcaster reports immediately", func(t *testing.T) {
+		assert.True(t, turn(order[0], broadcastedAt))
+	})
+
+	t.Run("standby reporters take over after each timeout", func(t *testing.T) {
+		first, second := order[quorum], order[quorum+1]
+		assert.False(t, turn(first, broadcastedAt.Add(timeout-time.Second)))
+		assert.True(t, turn(first, broadcastedAt.Add(timeout)))
+		assert.False(t, turn(second, broadcastedAt.Add(timeout)))
+		assert.True(t, turn(second, broadcastedAt.Add(2*timeout)))
+	})
+
+	t.Run("falls back to reporting without a validator set", func(t *testing.T) {
+		r := NewResolver(Config{Logger: zerolog.Nop(), ValidatorAddress: order[quorum]})
+		assert.True(t, r.isReportTurn(event, broadcastedAt))
+
+		r = NewResolver(Config{
+			Logger:           zerolog.Nop(),
+			ValidatorAddress: "pushvaloper1z",
+			GetValidators:    func() []string { return reportTestValidators },
+		})
+		assert.True(t, r.isReportTurn(event, broadcastedAt))
+	})
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/pushchain/push-chain-node/universalClient/chains"
 	"github.com/pushchain/push-chain-node/universalClient/chains/common"
+	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/pushsigner"
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
@@ -26,15 +27,40 @@ type Config struct {
 	CheckInterval time.Duration
 	Logger        zerolog.Logger
 	GetTSSAddress func(ctx context.Context) (string, error)
+
+	// GetLatestBlock returns the current Push Chain height; outbound lifetimes
+	// are measured against it. Nil disables outbound expiry.
+	GetLatestBlock func(ctx context.Context) (uint64, error)
+
+	// ValidatorAddress and GetValidators enable per-event report leader
+	// election in the broadcaster's order; when either is unset every node
+	// reports every outbound result it resolves. GetValidators returns the
+	// validators whose votes count on Push Chain (ACTIVE and PENDING_JOIN).
+	ValidatorAddress string
+	GetValidators    func() []string
+	// LeaderTimeout is how long each standby reporter waits before taking
+	// over (default DefaultLeaderTimeout).
+	LeaderTimeout time.Duration
+}
+
+// voter casts the resolver's votes on Push Chain (*pushsigner.Signer).
+type voter interface {
+	VoteOutbound(ctx context.Context, txID string, utxID string, observation *uexecutortypes.OutboundObservation) (string, error)
+	VoteFundMigration(ctx context.Context, migrationID uint64, txHash string, success bool) (string, error)
 }
 
 type Resolver struct {
-	eventStore    *eventstore.Store
-	chains        *chains.Chains
-	pushSigner    *pushsigner.Signer
-	checkInterval time.Duration
-	logger        zerolog.Logger
-	getTSSAddress func(ctx context.Context) (string, error)
+	eventStore     *eventstore.Store
+	chains         *chains.Chains
+	pushSigner     voter
+	checkInterval  time.Duration
+	logger         zerolog.Logger
+	getTSSAddress  func(ctx context.Context) (string, error)
+	getLatestBlock func(ctx context.Context) (uint64, error)
+
+	validatorAddress string
+	getValidators    func() []string
+	leaderTimeout    time.Duration
 }
 
 func NewResolver(cfg Config) *Resolver {
@@ -42,14 +68,27 @@ func NewResolver(cfg Config) *Resolver {
 	if interval == 0 {
 		interval = 15 * time.Second
 	}
-	return &Resolver{
-		eventStore:    cfg.EventStore,
-		chains:        cfg.Chains,
-		pushSigner:    cfg.PushSigner,
-		checkInterval: interval,
-		logger:        cfg.Logger.With().Str("component", "txresolver").Logger(),
-		getTSSAddress: cfg.GetTSSAddress,
+	leaderTimeout := cfg.LeaderTimeout
+	if leaderTimeout == 0 {
+		leaderTimeout = DefaultLeaderTimeout
 	}
+	r := &Resolver{
+		eventStore:     cfg.EventStore,
+		chains:         cfg.Chains,
+		checkInterval:  interval,
+		logger:         cfg.Logger.With().Str("component", "txresolver").Logger(),
+		getTSSAddress:  cfg.GetTSSAddress,
+		getLatestBlock: cfg.GetLatestBlock,
+
+		validatorAddress: cfg.ValidatorAddress,
+		getValidators:    cfg.GetValidators,
+		leaderTimeout:    leaderTimeout,
+	}
+	// Keep pushSigner a nil interface when no signer is given.
+	if cfg.PushSigner != nil {
+		r.pushSigner = cfg.PushSigner
+	}
+	return r
 }
 
 func (r *Resolver) Start(ctx context.Context) {
@@ -65,6 +104,7 @@ func (r *Resolver) run(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
+			r.expireStaleOutbounds(ctx)
 			r.processBroadcasted(ctx)
 		}
 	}
@@ -128,7 +168,7 @@ func (r *Resolver) resolveOutbound(ctx context.Context, event *store.Event) {
 	if r.chains.IsEVMChain(chainID) {
 		r.resolveOutboundEVM(ctx, event, chainID, rawTxHash)
 	} else {
-		r.resolveSVM(ctx, event, chainID)
+		r.resolveSVM(ctx, event, chainID, rawTxHash)
 	}
 }
 
@@ -171,11 +211,19 @@ func parseCAIPTxHash(caipTxHash string) (chainID, txHash string, err error) {
 }
 
 func extractOutboundIDs(event *store.Event) (txID, utxID string, err error) {
+	data, err := parseOutboundEvent(event)
+	if err != nil {
+		return "", "", err
+	}
+	return data.TxID, data.UniversalTxId, nil
+}
+
+func parseOutboundEvent(event *store.Event) (*uexecutortypes.OutboundCreatedEvent, error) {
 	var data uexecutortypes.OutboundCreatedEvent
 	if err := json.Unmarshal(event.EventData, &data); err != nil {
-		return "", "", fmt.Errorf("failed to parse outbound event data: %w", err)
+		return nil, fmt.Errorf("failed to parse outbound event data: %w", err)
 	}
-	return data.TxID, data.UniversalTxId, nil
+	return &data, nil
 }
 
 func (r *Resolver) getBuilder(chainID string) (common.TxBuilder, error) {
@@ -187,11 +235,23 @@ func (r *Resolver) getBuilder(chainID string) (common.TxBuilder, error) {
 }
 
 // voteOutboundFailureAndMarkReverted votes failure for an outbound event and marks it REVERTED.
+// A node that is not yet due to report leaves the event untouched and returns nil.
 func (r *Resolver) voteOutboundFailureAndMarkReverted(ctx context.Context, event *store.Event, txID, utxID, txHash string, blockHeight uint64, gasFeeUsed string, errorMsg string) error {
 	if r.pushSigner == nil {
-		r.logger.Warn().Str("event_id", event.EventID).Msg("pushSigner not configured, cannot vote failure")
+		log := logger.WithTraceID(r.logger, utxID)
+		log.Warn().Str("event_id", event.EventID).Msg("pushSigner not configured, cannot vote failure")
 		return nil
 	}
+	if !r.isReportTurn(event, time.Now()) {
+		return nil
+	}
+	return r.voteOutboundFailure(ctx, event, txID, utxID, txHash, blockHeight, gasFeeUsed, errorMsg)
+}
+
+// voteOutboundFailure votes failure for an outbound event and marks it
+// REVERTED, regardless of the report order.
+func (r *Resolver) voteOutboundFailure(ctx context.Context, event *store.Event, txID, utxID, txHash string, blockHeight uint64, gasFeeUsed string, errorMsg string) error {
+	log := logger.WithTraceID(r.logger, utxID)
 	if gasFeeUsed == "" {
 		gasFeeUsed = "0"
 	}
@@ -204,13 +264,13 @@ func (r *Resolver) voteOutboundFailureAndMarkReverted(ctx context.Context, event
 	}
 	voteTxHash, err := r.pushSigner.VoteOutbound(ctx, txID, utxID, observation)
 	if err != nil {
-		r.logger.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to vote outbound failure")
+		log.Warn().Err(err).Str("event_id", event.EventID).Msg("failed to vote outbound failure")
 		return err
 	}
 	if err := r.eventStore.Update(event.EventID, map[string]any{"status": store.StatusReverted, "vote_tx_hash": voteTxHash}); err != nil {
 		return fmt.Errorf("failed to mark event %s as reverted: %w", event.EventID, err)
 	}
-	r.logger.Info().
+	log.Info().
 		Str("event_id", event.EventID).
 		Str("type", event.Type).
 		Str("vote_tx_hash", voteTxHash).
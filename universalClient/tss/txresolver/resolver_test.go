@@ -3,6 +3,7 @@ package txresolver
 import (
 	"context"
 	"encoding/json"
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
@@ -26,7 +27,10 @@ import (
 	"github.com/pushchain/push-chain-node/universalClient/tss/eventstore"
 )
 
-type mockTxBuilder struct{ mock.Mock }
+type mockTxBuilder struct {
+	mock.Mock
+	valuePolicy common.ValueConfirmationPolicy
+}
 
 func (m *mockTxBuilder) GetOutboundSigningRequest(ctx context.Context, data *uexecutortypes.OutboundCreatedEvent, nonce uint64) (*common.UnsignedSigningReq, error) {
 	args := m.Called(ctx, data, nonce)
@@ -56,6 +60,14 @@ func (m *mockTxBuilder) IsAlreadyExecuted(ctx context.Context, txID string) (boo
 	return args.Bool(0), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *mockTxBuilder) RequiredConfirmations(data *uexecutortypes.OutboundCreatedEvent, base uint64) uint64 {
+	return m.valuePolicy.RequiredConfirmations(data.AssetAddr, data.Amount, base)
+}
+
+func (m *mockTxBuilder) IsGatewayPaused(ctx context.Context) (bool, error) {
+	return false, nil
+}
+
 func (m *mockTxBuilder) GetGasFeeUsed(ctx context.Context, txHash string) (string, error) {
 	args := m.Called(ctx, txHash)
 	return args.String(0), args.Error(1)
@@ -281,12 +293,48 @@ func TestSVM_PDAExists_MarksCompleted(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	updated := getEvent(t, db, "ev-1")
 	require.Equal(t, store.StatusCompleted, updated.Status)
 }
 
+func TestSVM_PDAExists_HighValueNeedsDeeperConfirmation(t *testing.T) {
+	policy := common.ValueConfirmationPolicy{Thresholds: map[string]*big.Int{common.NativeAsset: big.NewInt(1000)}, Confirmations: 32}
+
+	tests := []struct {
+		name      string
+		amount    string
+		rawTxHash string
+		want      string
+	}{
+		{"small outbound completes on finalized PDA", "999", "solTxSig", store.StatusCompleted},
+		{"high-value outbound waits for slot depth", "1000", "solTxSig", store.StatusBroadcasted},
+		{"high-value outbound landed by a peer completes on PDA", "1000", "", store.StatusCompleted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evtStore, db := setupTestDB(t)
+			builder := &mockTxBuilder{valuePolicy: policy}
+			client := &mockChainClient{builder: builder}
+			ch := newTestChains(t, "solana:mainnet", uregistrytypes.VmType_SVM, client)
+
+			eventData := makeOutboundEventDataWithAmount("tx-123", "utx-456", "solana:mainnet", tt.amount)
+			insertBroadcastedEvent(t, db, "ev-1", "solana:mainnet", "solana:mainnet:"+tt.rawTxHash, eventData)
+			builder.On("IsAlreadyExecuted", mock.Anything, "tx-123").Return(true, int64(0), nil)
+			builder.On("VerifyBroadcastedTx", mock.Anything, "solTxSig").
+				Return(true, uint64(500), uint64(5), uint8(1), nil)
+
+			resolver := newResolver(evtStore, ch)
+			ev := getEvent(t, db, "ev-1")
+			resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", tt.rawTxHash)
+
+			updated := getEvent(t, db, "ev-1")
+			require.Equal(t, tt.want, updated.Status)
+		})
+	}
+}
+
 func TestSVM_PDAAbsent_DeadlineZero_ClusterFresh_Reverts(t *testing.T) {
 	// Legacy event with no deadline (=0). PDA absent + fresh cluster time
 	// (>> 0) satisfies `clusterTime > deadline + slack` → reaches REVERT.
@@ -304,7 +352,7 @@ func TestSVM_PDAAbsent_DeadlineZero_ClusterFresh_Reverts(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	updated := getEvent(t, db, "ev-1")
 	require.Equal(t, store.StatusBroadcasted, updated.Status) // no PushSigner → vote skipped
@@ -325,7 +373,7 @@ func TestSVM_PDACheckFails_StaysBroadcasted(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	updated := getEvent(t, db, "ev-1")
 	require.Equal(t, store.StatusBroadcasted, updated.Status) // stays BROADCASTED
@@ -359,7 +407,7 @@ func TestSVM_PDAAbsent_ClusterTimeUnknown_DefersRevert(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	updated := getEvent(t, db, "ev-1")
 	require.Equal(t, store.StatusBroadcasted, updated.Status)
@@ -380,7 +428,7 @@ func TestSVM_PDAAbsent_ClusterStale_DefersRevert(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	updated := getEvent(t, db, "ev-1")
 	require.Equal(t, store.StatusBroadcasted, updated.Status)
@@ -404,7 +452,7 @@ func TestSVM_PDAAbsent_ClusterStillInWindow_DefersRevert(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	updated := getEvent(t, db, "ev-1")
 	require.Equal(t, store.StatusBroadcasted, updated.Status)
@@ -428,7 +476,7 @@ func TestSVM_PDAAbsent_ClusterConfirmsExpiry_Reverts(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	// No PushSigner → vote returns nil early; status unchanged. The point is
 	// the resolver REACHED the vote (i.e., didn't defer); covered by absence
@@ -447,7 +495,7 @@ func TestSVM_InvalidEventData_Skips(t *testing.T) {
 
 	resolver := newResolver(evtStore, ch)
 	ev := getEvent(t, db, "ev-1")
-	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet")
+	resolver.resolveSVM(context.Background(), &ev, "solana:mainnet", "")
 
 	updated := getEvent(t, db, "ev-1")
 	require.Equal(t, store.StatusBroadcasted, updated.Status) // stays BROADCASTED
@@ -899,6 +947,52 @@ func TestResolveOutboundEVM_InsufficientConfirmations_StaysBroadcasted(t *testin
 	require.Equal(t, store.StatusBroadcasted, ev.Status)
 }
 
+func makeOutboundEventDataWithAmount(txID, utxID, destChain, amount string) []byte {
+	data := uexecutortypes.OutboundCreatedEvent{
+		TxID:             txID,
+		UniversalTxId:    utxID,
+		DestinationChain: destChain,
+		Amount:           amount,
+	}
+	b, _ := json.Marshal(data)
+	return b
+}
+
+func TestResolveOutboundEVM_HighValueNeedsDeeperConfirmation(t *testing.T) {
+	// Standard depth is 12; outbounds of 1000 or more need 32.
+	policy := common.ValueConfirmationPolicy{Thresholds: map[string]*big.Int{common.NativeAsset: big.NewInt(1000)}, Confirmations: 32}
+
+	tests := []struct {
+		name   string
+		amount string
+		confs  uint64
+		want   string
+	}{
+		{"small outbound completes at standard depth", "999", 20, store.StatusCompleted},
+		{"high-value outbound waits past standard depth", "1000", 20, store.StatusBroadcasted},
+		{"high-value outbound completes at escalated depth", "1000", 32, store.StatusCompleted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evtStore, db := setupTestDB(t)
+			builder := &mockTxBuilder{valuePolicy: policy}
+			client := &mockChainClient{builder: builder}
+			ch := newTestChains(t, "eip155:1", uregistrytypes.VmType_EVM, client)
+
+			eventData := makeOutboundEventDataWithAmount("tx-100", "utx-200", "eip155:1", tt.amount)
+			insertBroadcastedEvent(t, db, "ev-value-1", "eip155:1", "eip155:1:0xvalue", eventData)
+			builder.On("VerifyBroadcastedTx", mock.Anything, "0xvalue").
+				Return(true, uint64(500), tt.confs, uint8(1), nil)
+
+			resolver := newResolver(evtStore, ch)
+			resolver.processBroadcasted(context.Background())
+
+			ev := getEvent(t, db, "ev-value-1")
+			require.Equal(t, tt.want, ev.Status)
+		})
+	}
+}
+
 func TestResolveOutboundEVM_Reverted_NoPushSigner_StaysBroadcasted(t *testing.T) {
 	evtStore, db := setupTestDB(t)
 	builder := &mockTxBuilder{}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/pushchain/push-chain-node/universalClient/logger"
 	"github.com/pushchain/push-chain-node/universalClient/store"
 	"github.com/pushchain/push-chain-node/universalClient/tss/txflow"
 )
@@ -28,22 +29,26 @@ const svmClusterStaleSeconds int64 = 120
 // and finalization stalls (production continues but finalized lags).
 //
 //   - PDA exists                                  → COMPLETED.
+//   - PDA exists, high-value tx short of its depth → stay BROADCASTED, retry.
 //   - PDA check RPC error                         → stay BROADCASTED, retry.
 //   - PDA absent + cluster time unknown (0)       → stay BROADCASTED, retry.
 //   - PDA absent + cluster stale (>120s old)      → stay BROADCASTED, retry.
 //   - PDA absent + cluster says still in window   → stay BROADCASTED, retry.
 //   - PDA absent + cluster confirms past deadline → REVERT.
-func (r *Resolver) resolveSVM(ctx context.Context, event *store.Event, chainID string) {
-	log := r.logger.With().
+func (r *Resolver) resolveSVM(ctx context.Context, event *store.Event, chainID, rawTxHash string) {
+	log := logger.WithTraceID(r.logger, event.UniversalTxID()).With().
 		Str("event_id", event.EventID).
 		Str("type", event.Type).
 		Str("chain_id", chainID).Logger()
+	ctx, finishEndpoints := txflow.TrackEndpoints(ctx, r.eventStore, event.EventID, log)
+	defer finishEndpoints()
 
-	txID, utxID, err := extractOutboundIDs(event)
+	data, err := parseOutboundEvent(event)
 	if err != nil {
 		log.Warn().Err(err).Msg("failed to extract outbound IDs for SVM resolve")
 		return
 	}
+	txID, utxID := data.TxID, data.UniversalTxId
 	log = log.With().Str("tx_id", txID).Logger()
 
 	client, err := r.chains.GetClient(chainID)
@@ -64,6 +69,17 @@ func (r *Resolver) resolveSVM(ctx context.Context, event *store.Event, chainID s
 	}
 
 	if executed {
+		// The PDA is read at finalized commitment. High-value outbounds also
+		// wait for extra finalized slots on our tx; one landed by a peer under
+		// another signature has only the PDA to go on.
+		if required := builder.RequiredConfirmations(data, 0); required > 0 && rawTxHash != "" {
+			found, _, confirmations, _, vErr := builder.VerifyBroadcastedTx(ctx, rawTxHash)
+			if vErr == nil && found && confirmations < required {
+				log.Debug().Uint64("confirmations", confirmations).Uint64("required", required).
+					Msg("high-value SVM outbound not deep enough yet, will retry next tick")
+				return
+			}
+		}
 		if err := r.eventStore.Update(event.EventID, map[string]any{"status": store.StatusCompleted}); err != nil {
 			log.Warn().Err(err).Msg("failed to mark SVM event COMPLETED")
 			return